@@ -18,7 +18,9 @@ import (
 
 	catalog "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
 	event "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	freeze "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
 	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"github.com/bananaops/tracker/internal/config"
 	"github.com/bananaops/tracker/server"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -29,11 +31,21 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+// lockExpiryInterval is how often expired locks are released
+const lockExpiryInterval = 15 * time.Second
+
 var serv = &cobra.Command{
 	Use:   "serv",
 	Short: "Run tracker server",
 	Run: func(cmd *cobra.Command, args []string) {
 
+		switch config.ConfigDatabase.Storage {
+		case config.StorageMongo, config.StorageMemory, config.StoragePostgres, config.StorageEmbedded:
+			slog.Info("using storage backend", "storage", config.ConfigDatabase.Storage)
+		default:
+			log.Fatalf("unknown storage backend %q", config.ConfigDatabase.Storage)
+		}
+
 		// Set up gRPC server
 		grpcServerEndpoint := "localhost:8765"
 		grpcServer := grpc.NewServer()
@@ -49,6 +61,10 @@ var serv = &cobra.Command{
 		locks := server.NewLock()
 		lock.RegisterLockServiceServer(grpcServer, locks)
 
+		// register freeze service
+		freezes := server.NewFreeze()
+		freeze.RegisterFreezeServiceServer(grpcServer, freezes)
+
 		// register catalog service
 		catalogs := server.NewCatalog()
 		catalog.RegisterCatalogServiceServer(grpcServer, catalogs)
@@ -59,6 +75,19 @@ var serv = &cobra.Command{
 
 		ctx := context.TODO()
 
+		// operations used to lock their own resource, they share the deployment one now
+		if _, err := locks.MigrateOperationLocks(ctx); err != nil {
+			slog.Warn("Failed to migrate operation locks", "error", err)
+		}
+
+		// store the watched changes, so that every instance sends them and resumes their tokens
+		if err := server.PersistWatchFeeds(ctx); err != nil {
+			slog.Warn("Failed to persist the watch feeds, watches only see the changes of this instance", "error", err)
+		}
+
+		// release the locks whose ttl elapsed
+		go locks.RunExpiry(ctx, lockExpiryInterval)
+
 		// Initialiser les index MongoDB après la première connexion
 		db := server.GetDatabaseConnection()
 		if db != nil {
@@ -66,7 +95,7 @@ var serv = &cobra.Command{
 				slog.Warn("Failed to ensure database indexes", "error", err)
 			}
 		}
-		mux := runtime.NewServeMux()
+		mux := runtime.NewServeMux(server.ServeMuxOptions()...)
 
 		// Register generated routes to mux
 		err := event.RegisterEventServiceHandlerServer(ctx, mux, events)
@@ -79,6 +108,11 @@ var serv = &cobra.Command{
 			panic(err)
 		}
 
+		err = freeze.RegisterFreezeServiceHandlerServer(ctx, mux, freezes)
+		if err != nil {
+			panic(err)
+		}
+
 		err = catalog.RegisterCatalogServiceHandlerServer(ctx, mux, catalogs)
 		if err != nil {
 			panic(err)
@@ -90,6 +124,19 @@ var serv = &cobra.Command{
 		// Register custom links CRUD endpoints
 		server.RegisterLinksHandler(mux)
 
+		// Register the long-poll endpoint of AcquireLock, the gateway does not serve streams
+		if err := server.RegisterLockAcquireHandler(mux, locks); err != nil {
+			slog.Error("Failed to register POST /api/v1alpha1/lock/acquire", "error", err)
+		}
+
+		// Register the Server-Sent Events endpoints of WatchEvents and WatchLocks
+		if err := server.RegisterEventWatchHandler(mux, events); err != nil {
+			slog.Error("Failed to register GET /api/v1alpha1/events/watch", "error", err)
+		}
+		if err := server.RegisterLockWatchHandler(mux, locks); err != nil {
+			slog.Error("Failed to register GET /api/v1alpha1/locks/watch", "error", err)
+		}
+
 		// Setup Swagger documentation with go-swagger
 		opts := middleware.SwaggerUIOpts{SpecURL: "/swagger.json"}
 		sh := middleware.SwaggerUI(opts, nil)
@@ -311,4 +358,8 @@ func init() {
 	slog.SetDefault(logger)
 	rootCmd.AddCommand(serv)
 
+	serv.Flags().StringVar(&config.ConfigDatabase.Storage, "storage", config.ConfigDatabase.Storage, "storage backend: mongo, postgres, embedded or memory (env DB_STORAGE)")
+	serv.Flags().StringVar(&config.ConfigDatabase.PostgresDSN, "postgres-dsn", config.ConfigDatabase.PostgresDSN, "PostgreSQL connection string when --storage=postgres (env DB_POSTGRES_DSN)")
+	serv.Flags().StringVar(&config.ConfigDatabase.DataDir, "data-dir", config.ConfigDatabase.DataDir, "directory of the database file when --storage=embedded (env DB_DATA_DIR)")
+
 }
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.10
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: proto/catalog/v1alpha1/catalog.proto
 
@@ -633,8 +633,11 @@ type Catalog struct {
 	VulnerabilitySummary *VulnerabilitySummary `protobuf:"bytes,21,opt,name=vulnerability_summary,json=vulnerabilitySummary,proto3" json:"vulnerability_summary,omitempty"`
 	// Infrastructure resources used by this service
 	InfrastructureResources []*InfrastructureResource `protobuf:"bytes,22,rep,name=infrastructure_resources,json=infrastructureResources,proto3" json:"infrastructure_resources,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Incremented by every change of the entry, from 1 at its creation. Over
+	// HTTP, it is also the ETag of the responses holding the entry.
+	Revision      int64 `protobuf:"varint,23,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Catalog) Reset() {
@@ -821,6 +824,13 @@ func (x *Catalog) GetInfrastructureResources() []*InfrastructureResource {
 	return nil
 }
 
+func (x *Catalog) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type CreateUpdateCatalogRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -847,8 +857,14 @@ type CreateUpdateCatalogRequest struct {
 	VulnerabilitySummary *VulnerabilitySummary `protobuf:"bytes,18,opt,name=vulnerability_summary,json=vulnerabilitySummary,proto3" json:"vulnerability_summary,omitempty"`
 	// Infrastructure resources used by this service
 	InfrastructureResources []*InfrastructureResource `protobuf:"bytes,19,rep,name=infrastructure_resources,json=infrastructureResources,proto3" json:"infrastructure_resources,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Note: Version management (available_versions, latest_version, reference_version)
+	// is handled separately via UpdateVersions endpoint
+	// When set, the entry is only updated if it is still at this revision, and
+	// the update fails with ABORTED otherwise. Over HTTP, the If-Match header
+	// may carry it instead.
+	Revision      int64 `protobuf:"varint,20,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUpdateCatalogRequest) Reset() {
@@ -1014,6 +1030,13 @@ func (x *CreateUpdateCatalogRequest) GetInfrastructureResources() []*Infrastruct
 	return nil
 }
 
+func (x *CreateUpdateCatalogRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type CreateUpdateCatalogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
@@ -1243,9 +1266,13 @@ func (x *DeleteCatalogResponse) GetName() string {
 }
 
 type ListCatalogsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	PerPage       *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Page          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	PerPage *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page    *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// sort field, "-" prefixed for descending order (e.g. "-created_at")
+	Sort string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, replaces page
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1294,10 +1321,25 @@ func (x *ListCatalogsRequest) GetPage() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *ListCatalogsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListCatalogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListCatalogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Catalogs      []*Catalog             `protobuf:"bytes,1,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
 	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1346,6 +1388,13 @@ func (x *ListCatalogsResponse) GetTotalCount() uint32 {
 	return 0
 }
 
+func (x *ListCatalogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Version compliance messages
 type GetVersionComplianceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1847,6 +1896,7 @@ type UpdateVersionsRequest struct {
 	AvailableVersions []string               `protobuf:"bytes,2,rep,name=available_versions,json=availableVersions,proto3" json:"available_versions,omitempty"` // List of available versions
 	LatestVersion     string                 `protobuf:"bytes,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`             // Latest available version
 	ReferenceVersion  string                 `protobuf:"bytes,4,opt,name=reference_version,json=referenceVersion,proto3" json:"reference_version,omitempty"`    // Recommended/reference version to use
+	Revision          int64                  `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`                                           // Expected revision of the entry, see CreateUpdateCatalogRequest
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1909,6 +1959,13 @@ func (x *UpdateVersionsRequest) GetReferenceVersion() string {
 	return ""
 }
 
+func (x *UpdateVersionsRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type UpdateVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"` // Updated catalog with new versions
@@ -1959,6 +2016,7 @@ type UpdateDependenciesRequest struct {
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Service name
 	DependenciesIn  []string               `protobuf:"bytes,2,rep,name=dependencies_in,json=dependenciesIn,proto3" json:"dependencies_in,omitempty"`    // Upstream dependencies (services this service depends on)
 	DependenciesOut []string               `protobuf:"bytes,3,rep,name=dependencies_out,json=dependenciesOut,proto3" json:"dependencies_out,omitempty"` // Downstream dependencies (services that depend on this service)
+	Revision        int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`                                     // Expected revision of the entry, see CreateUpdateCatalogRequest
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2014,6 +2072,13 @@ func (x *UpdateDependenciesRequest) GetDependenciesOut() []string {
 	return nil
 }
 
+func (x *UpdateDependenciesRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type UpdateDependenciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"` // Updated catalog with new dependencies
@@ -2612,7 +2677,7 @@ var File_proto_catalog_v1alpha1_catalog_proto protoreflect.FileDescriptor
 
 const file_proto_catalog_v1alpha1_catalog_proto_rawDesc = "" +
 	"\n" +
-	"$proto/catalog/v1alpha1/catalog.proto\x12\x18tracker.catalog.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xbb\t\n" +
+	"$proto/catalog/v1alpha1/catalog.proto\x12\x18tracker.catalog.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xd7\t\n" +
 	"\aCatalog\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.tracker.catalog.v1alpha1.TypeR\x04type\x12A\n" +
@@ -2640,7 +2705,8 @@ const file_proto_catalog_v1alpha1_catalog_proto_rawDesc = "" +
 	"\x16communication_channels\x18\x13 \x03(\v2..tracker.catalog.v1alpha1.CommunicationChannelR\x15communicationChannels\x12P\n" +
 	"\x0fdashboard_links\x18\x14 \x03(\v2'.tracker.catalog.v1alpha1.DashboardLinkR\x0edashboardLinks\x12c\n" +
 	"\x15vulnerability_summary\x18\x15 \x01(\v2..tracker.catalog.v1alpha1.VulnerabilitySummaryR\x14vulnerabilitySummary\x12k\n" +
-	"\x18infrastructure_resources\x18\x16 \x03(\v20.tracker.catalog.v1alpha1.InfrastructureResourceR\x17infrastructureResources\"\xcb\b\n" +
+	"\x18infrastructure_resources\x18\x16 \x03(\v20.tracker.catalog.v1alpha1.InfrastructureResourceR\x17infrastructureResources\x12\x1a\n" +
+	"\brevision\x18\x17 \x01(\x03R\brevision\"\xe7\b\n" +
 	"\x1aCreateUpdateCatalogRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.tracker.catalog.v1alpha1.TypeR\x04type\x12A\n" +
@@ -2665,7 +2731,8 @@ const file_proto_catalog_v1alpha1_catalog_proto_rawDesc = "" +
 	"\x16communication_channels\x18\x10 \x03(\v2..tracker.catalog.v1alpha1.CommunicationChannelR\x15communicationChannels\x12P\n" +
 	"\x0fdashboard_links\x18\x11 \x03(\v2'.tracker.catalog.v1alpha1.DashboardLinkR\x0edashboardLinks\x12c\n" +
 	"\x15vulnerability_summary\x18\x12 \x01(\v2..tracker.catalog.v1alpha1.VulnerabilitySummaryR\x14vulnerabilitySummary\x12k\n" +
-	"\x18infrastructure_resources\x18\x13 \x03(\v20.tracker.catalog.v1alpha1.InfrastructureResourceR\x17infrastructureResources\"Z\n" +
+	"\x18infrastructure_resources\x18\x13 \x03(\v20.tracker.catalog.v1alpha1.InfrastructureResourceR\x17infrastructureResources\x12\x1a\n" +
+	"\brevision\x18\x14 \x01(\x03R\brevision\"Z\n" +
 	"\x1bCreateUpdateCatalogResponse\x12;\n" +
 	"\acatalog\x18\x01 \x01(\v2!.tracker.catalog.v1alpha1.CatalogR\acatalog\"'\n" +
 	"\x11GetCatalogRequest\x12\x12\n" +
@@ -2676,14 +2743,18 @@ const file_proto_catalog_v1alpha1_catalog_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
 	"\x15DeleteCatalogResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x7f\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb2\x01\n" +
 	"\x13ListCatalogsRequest\x127\n" +
 	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
-	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"v\n" +
+	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x9e\x01\n" +
 	"\x14ListCatalogsResponse\x12=\n" +
 	"\bcatalogs\x18\x01 \x03(\v2!.tracker.catalog.v1alpha1.CatalogR\bcatalogs\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount\"S\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"S\n" +
 	"\x1bGetVersionComplianceRequest\x124\n" +
 	"\x05types\x18\x01 \x03(\x0e2\x1e.tracker.catalog.v1alpha1.TypeR\x05types\"\xae\x01\n" +
 	"\x1cGetVersionComplianceResponse\x12G\n" +
@@ -2722,18 +2793,20 @@ const file_proto_catalog_v1alpha1_catalog_proto_rawDesc = "" +
 	"\x05level\x18\x01 \x01(\x0e2\".tracker.catalog.v1alpha1.SLALevelR\x05level\x12I\n" +
 	"\x11uptime_percentage\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\x10uptimePercentage\x12F\n" +
 	"\x10response_time_ms\x18\x03 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x0eresponseTimeMs\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xae\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xca\x01\n" +
 	"\x15UpdateVersionsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
 	"\x12available_versions\x18\x02 \x03(\tR\x11availableVersions\x12%\n" +
 	"\x0elatest_version\x18\x03 \x01(\tR\rlatestVersion\x12+\n" +
-	"\x11reference_version\x18\x04 \x01(\tR\x10referenceVersion\"U\n" +
+	"\x11reference_version\x18\x04 \x01(\tR\x10referenceVersion\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\"U\n" +
 	"\x16UpdateVersionsResponse\x12;\n" +
-	"\acatalog\x18\x01 \x01(\v2!.tracker.catalog.v1alpha1.CatalogR\acatalog\"\x83\x01\n" +
+	"\acatalog\x18\x01 \x01(\v2!.tracker.catalog.v1alpha1.CatalogR\acatalog\"\x9f\x01\n" +
 	"\x19UpdateDependenciesRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
 	"\x0fdependencies_in\x18\x02 \x03(\tR\x0edependenciesIn\x12)\n" +
-	"\x10dependencies_out\x18\x03 \x03(\tR\x0fdependenciesOut\"Y\n" +
+	"\x10dependencies_out\x18\x03 \x03(\tR\x0fdependenciesOut\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\"Y\n" +
 	"\x1aUpdateDependenciesResponse\x12;\n" +
 	"\acatalog\x18\x01 \x01(\v2!.tracker.catalog.v1alpha1.CatalogR\acatalog\"\x9e\x01\n" +
 	"\x0fUsedDeliverable\x12\x12\n" +
@@ -330,6 +330,8 @@ func (m *Catalog) validate(all bool) error {
 
 	}
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return CatalogMultiError(errors)
 	}
@@ -699,6 +701,8 @@ func (m *CreateUpdateCatalogRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return CreateUpdateCatalogRequestMultiError(errors)
 	}
@@ -1436,6 +1440,10 @@ func (m *ListCatalogsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Sort
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
 		return ListCatalogsRequestMultiError(errors)
 	}
@@ -1574,6 +1582,8 @@ func (m *ListCatalogsResponse) validate(all bool) error {
 
 	// no validation rules for TotalCount
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListCatalogsResponseMultiError(errors)
 	}
@@ -2627,6 +2637,8 @@ func (m *UpdateVersionsRequest) validate(all bool) error {
 
 	// no validation rules for ReferenceVersion
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return UpdateVersionsRequestMultiError(errors)
 	}
@@ -2862,6 +2874,8 @@ func (m *UpdateDependenciesRequest) validate(all bool) error {
 
 	// no validation rules for Name
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return UpdateDependenciesRequestMultiError(errors)
 	}
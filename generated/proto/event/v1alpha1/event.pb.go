@@ -1,17 +1,19 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.10
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: proto/event/v1alpha1/event.proto
 
 package v1alpha1
 
 import (
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -364,6 +366,179 @@ func (ChangeType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{4}
 }
 
+type TimeBucket int32
+
+const (
+	TimeBucket_TIME_BUCKET_UNSPECIFIED TimeBucket = 0
+	TimeBucket_hour                    TimeBucket = 1
+	TimeBucket_day                     TimeBucket = 2
+	// Weeks start on Monday
+	TimeBucket_week  TimeBucket = 3
+	TimeBucket_month TimeBucket = 4
+)
+
+// Enum value maps for TimeBucket.
+var (
+	TimeBucket_name = map[int32]string{
+		0: "TIME_BUCKET_UNSPECIFIED",
+		1: "hour",
+		2: "day",
+		3: "week",
+		4: "month",
+	}
+	TimeBucket_value = map[string]int32{
+		"TIME_BUCKET_UNSPECIFIED": 0,
+		"hour":                    1,
+		"day":                     2,
+		"week":                    3,
+		"month":                   4,
+	}
+)
+
+func (x TimeBucket) Enum() *TimeBucket {
+	p := new(TimeBucket)
+	*p = x
+	return p
+}
+
+func (x TimeBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_event_v1alpha1_event_proto_enumTypes[5].Descriptor()
+}
+
+func (TimeBucket) Type() protoreflect.EnumType {
+	return &file_proto_event_v1alpha1_event_proto_enumTypes[5]
+}
+
+func (x TimeBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeBucket.Descriptor instead.
+func (TimeBucket) EnumDescriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{5}
+}
+
+type BlockingReasonKind int32
+
+const (
+	BlockingReasonKind_BLOCKING_REASON_KIND_UNSPECIFIED BlockingReasonKind = 0
+	BlockingReasonKind_lock                             BlockingReasonKind = 1
+	BlockingReasonKind_reservation                      BlockingReasonKind = 2
+	BlockingReasonKind_in_progress_event                BlockingReasonKind = 3
+	BlockingReasonKind_planned_event                    BlockingReasonKind = 4
+	BlockingReasonKind_freeze                           BlockingReasonKind = 5
+)
+
+// Enum value maps for BlockingReasonKind.
+var (
+	BlockingReasonKind_name = map[int32]string{
+		0: "BLOCKING_REASON_KIND_UNSPECIFIED",
+		1: "lock",
+		2: "reservation",
+		3: "in_progress_event",
+		4: "planned_event",
+		5: "freeze",
+	}
+	BlockingReasonKind_value = map[string]int32{
+		"BLOCKING_REASON_KIND_UNSPECIFIED": 0,
+		"lock":                             1,
+		"reservation":                      2,
+		"in_progress_event":                3,
+		"planned_event":                    4,
+		"freeze":                           5,
+	}
+)
+
+func (x BlockingReasonKind) Enum() *BlockingReasonKind {
+	p := new(BlockingReasonKind)
+	*p = x
+	return p
+}
+
+func (x BlockingReasonKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockingReasonKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_event_v1alpha1_event_proto_enumTypes[6].Descriptor()
+}
+
+func (BlockingReasonKind) Type() protoreflect.EnumType {
+	return &file_proto_event_v1alpha1_event_proto_enumTypes[6]
+}
+
+func (x BlockingReasonKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockingReasonKind.Descriptor instead.
+func (BlockingReasonKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{6}
+}
+
+type EventChangeKind int32
+
+const (
+	EventChangeKind_EVENT_CHANGE_KIND_UNSPECIFIED EventChangeKind = 0
+	EventChangeKind_event_created                 EventChangeKind = 1
+	EventChangeKind_event_updated                 EventChangeKind = 2
+	EventChangeKind_event_deleted                 EventChangeKind = 3
+	EventChangeKind_changelog_added               EventChangeKind = 4
+	// The event was updated and no longer matches the filter of the watch
+	EventChangeKind_event_left EventChangeKind = 5
+)
+
+// Enum value maps for EventChangeKind.
+var (
+	EventChangeKind_name = map[int32]string{
+		0: "EVENT_CHANGE_KIND_UNSPECIFIED",
+		1: "event_created",
+		2: "event_updated",
+		3: "event_deleted",
+		4: "changelog_added",
+		5: "event_left",
+	}
+	EventChangeKind_value = map[string]int32{
+		"EVENT_CHANGE_KIND_UNSPECIFIED": 0,
+		"event_created":                 1,
+		"event_updated":                 2,
+		"event_deleted":                 3,
+		"changelog_added":               4,
+		"event_left":                    5,
+	}
+)
+
+func (x EventChangeKind) Enum() *EventChangeKind {
+	p := new(EventChangeKind)
+	*p = x
+	return p
+}
+
+func (x EventChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_event_v1alpha1_event_proto_enumTypes[7].Descriptor()
+}
+
+func (EventChangeKind) Type() protoreflect.EnumType {
+	return &file_proto_event_v1alpha1_event_proto_enumTypes[7]
+}
+
+func (x EventChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventChangeKind.Descriptor instead.
+func (EventChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{7}
+}
+
 type EventAttributes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -381,6 +556,9 @@ type EventAttributes struct {
 	StakeHolders  []string               `protobuf:"bytes,13,rep,name=stake_holders,json=stakeHolders,proto3" json:"stake_holders,omitempty"`
 	Notification  bool                   `protobuf:"varint,14,opt,name=notification,proto3" json:"notification,omitempty"`
 	Notifications []string               `protobuf:"bytes,15,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// Release train of the event, a starting deployment shares the locks of the
+	// lock bundle taken with this release id
+	ReleaseId     string `protobuf:"bytes,16,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -520,12 +698,27 @@ func (x *EventAttributes) GetNotifications() []string {
 	return nil
 }
 
+func (x *EventAttributes) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
 type EventMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	SlackId       string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Duration  *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Id        string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	SlackId   string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	// Incremented by every change of the event, from 1 at its creation. Over
+	// HTTP, it is also the ETag of the responses holding the event.
+	Revision int64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Idempotency key of the request that created the event
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// SHA-256 of the request that created the event, to tell a retry from
+	// another request reusing its idempotency key
+	RequestHash   string `protobuf:"bytes,7,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -588,6 +781,27 @@ func (x *EventMetadata) GetSlackId() string {
 	return ""
 }
 
+func (x *EventMetadata) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EventMetadata) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetRequestHash() string {
+	if x != nil {
+		return x.RequestHash
+	}
+	return ""
+}
+
 type EventLinks struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	PullRequestLink string                 `protobuf:"bytes,1,opt,name=pull_request_link,json=pullRequestLink,proto3" json:"pull_request_link,omitempty"`
@@ -809,13 +1023,20 @@ func (x *Event) GetChangelog() []*ChangelogEntry {
 }
 
 type CreateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Attributes    *EventAttributes       `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Links         *EventLinks            `protobuf:"bytes,3,opt,name=links,proto3" json:"links,omitempty"`
-	SlackId       string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Attributes *EventAttributes       `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Links      *EventLinks            `protobuf:"bytes,3,opt,name=links,proto3" json:"links,omitempty"`
+	SlackId    string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	// ttl of the lock taken for the event, the lock never expires when unset
+	LockTtl *durationpb.Duration `protobuf:"bytes,5,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	// Approved exception letting a deployment through the freezes in force
+	FreezeExceptionId string `protobuf:"bytes,6,opt,name=freeze_exception_id,json=freezeExceptionId,proto3" json:"freeze_exception_id,omitempty"`
+	// Chosen by the client, a retry with the same key and request returns the
+	// event it created. Also read from the Idempotency-Key header.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateEventRequest) Reset() {
@@ -876,9 +1097,33 @@ func (x *CreateEventRequest) GetSlackId() string {
 	return ""
 }
 
+func (x *CreateEventRequest) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetFreezeExceptionId() string {
+	if x != nil {
+		return x.FreezeExceptionId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Lock taken for the event, unset when it takes none or shares the lock of
+	// its bundle or reservation
+	Lock          *v1alpha1.Lock `protobuf:"bytes,2,opt,name=lock,proto3" json:"lock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -920,6 +1165,13 @@ func (x *CreateEventResponse) GetEvent() *Event {
 	return nil
 }
 
+func (x *CreateEventResponse) GetLock() *v1alpha1.Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
 type GetEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1009,17 +1261,30 @@ func (x *GetEventResponse) GetEvent() *Event {
 }
 
 type SearchEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Type          Type                   `protobuf:"varint,2,opt,name=type,proto3,enum=tracker.event.v1alpha1.Type" json:"type,omitempty"`
-	Priority      Priority               `protobuf:"varint,3,opt,name=priority,proto3,enum=tracker.event.v1alpha1.Priority" json:"priority,omitempty"`
-	Status        Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=tracker.event.v1alpha1.Status" json:"status,omitempty"`
-	Service       string                 `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
-	StartDate     string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Environment   Environment            `protobuf:"varint,8,opt,name=environment,proto3,enum=tracker.event.v1alpha1.Environment" json:"environment,omitempty"`
-	Impact        bool                   `protobuf:"varint,9,opt,name=impact,proto3" json:"impact,omitempty"`
-	SlackId       string                 `protobuf:"bytes,10,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Source      string                  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Type        Type                    `protobuf:"varint,2,opt,name=type,proto3,enum=tracker.event.v1alpha1.Type" json:"type,omitempty"`
+	Priority    Priority                `protobuf:"varint,3,opt,name=priority,proto3,enum=tracker.event.v1alpha1.Priority" json:"priority,omitempty"`
+	Status      Status                  `protobuf:"varint,4,opt,name=status,proto3,enum=tracker.event.v1alpha1.Status" json:"status,omitempty"`
+	Service     string                  `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	StartDate   string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Environment Environment             `protobuf:"varint,8,opt,name=environment,proto3,enum=tracker.event.v1alpha1.Environment" json:"environment,omitempty"`
+	Impact      bool                    `protobuf:"varint,9,opt,name=impact,proto3" json:"impact,omitempty"`
+	SlackId     string                  `protobuf:"bytes,10,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	PerPage     *wrapperspb.UInt32Value `protobuf:"bytes,11,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page        *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=page,proto3" json:"page,omitempty"`
+	// sort field, "-" prefixed for descending order (e.g. "-created_at")
+	Sort string `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, replaces page
+	PageToken string `protobuf:"bytes,14,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// full-text query over the title, message, changelog comments, ticket and
+	// pull request link: words, "quoted phrases" and -excluded words. The
+	// events are then ranked by relevance.
+	Q string `protobuf:"bytes,15,opt,name=q,proto3" json:"q,omitempty"`
+	// filter in the query language, e.g. service in (a,b) and env=production
+	// and status!=success and owner~"team-*", ANDed with the other filters
+	Query         string `protobuf:"bytes,16,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1124,10 +1389,55 @@ func (x *SearchEventsRequest) GetSlackId() string {
 	return ""
 }
 
+func (x *SearchEventsRequest) GetPerPage() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerPage
+	}
+	return nil
+}
+
+func (x *SearchEventsRequest) GetPage() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SearchEventsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 type SearchEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// relevance of each event, in the order of events, when q is given
+	Hits          []*SearchHit `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1176,28 +1486,43 @@ func (x *SearchEventsResponse) GetTotalCount() uint32 {
 	return 0
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	PerPage       *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Page          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+func (x *SearchEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchEventsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights    []*Highlight           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *SearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*SearchHit) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,47 +1534,56 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
 	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListEventsRequest) GetPerPage() *wrapperspb.UInt32Value {
+func (x *SearchHit) GetEventId() string {
 	if x != nil {
-		return x.PerPage
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEventsRequest) GetPage() *wrapperspb.Int32Value {
+func (x *SearchHit) GetScore() float64 {
 	if x != nil {
-		return x.Page
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
 	}
 	return nil
 }
 
-type ListEventsResponse struct {
+// Highlight is an excerpt of a field matching the query, the matches wrapped
+// in <em> tags and the rest HTML escaped
+type Highlight struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
+func (x *Highlight) Reset() {
+	*x = Highlight{}
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *Highlight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*Highlight) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *Highlight) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,47 +1595,51 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
 	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListEventsResponse) GetEvents() []*Event {
+func (x *Highlight) GetField() string {
 	if x != nil {
-		return x.Events
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEventsResponse) GetTotalCount() uint32 {
+func (x *Highlight) GetSnippet() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Snippet
 	}
-	return 0
+	return ""
 }
 
-type TodayEventsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	PerPage       *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Page          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+type ListEventsRequest struct {
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	PerPage *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page    *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// sort field, "-" prefixed for descending order (e.g. "-created_at")
+	Sort string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, replaces page
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TodayEventsRequest) Reset() {
-	*x = TodayEventsRequest{}
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TodayEventsRequest) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TodayEventsRequest) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *TodayEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1313,47 +1651,62 @@ func (x *TodayEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TodayEventsRequest.ProtoReflect.Descriptor instead.
-func (*TodayEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TodayEventsRequest) GetPerPage() *wrapperspb.UInt32Value {
+func (x *ListEventsRequest) GetPerPage() *wrapperspb.UInt32Value {
 	if x != nil {
 		return x.PerPage
 	}
 	return nil
 }
 
-func (x *TodayEventsRequest) GetPage() *wrapperspb.Int32Value {
+func (x *ListEventsRequest) GetPage() *wrapperspb.Int32Value {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type TodayEventsResponse struct {
+func (x *ListEventsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TodayEventsResponse) Reset() {
-	*x = TodayEventsResponse{}
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TodayEventsResponse) String() string {
+func (x *ListEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TodayEventsResponse) ProtoMessage() {}
+func (*ListEventsResponse) ProtoMessage() {}
 
-func (x *TodayEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1365,9 +1718,120 @@ func (x *TodayEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TodayEventsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PerPage       *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodayEventsRequest) Reset() {
+	*x = TodayEventsRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodayEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodayEventsRequest) ProtoMessage() {}
+
+func (x *TodayEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodayEventsRequest.ProtoReflect.Descriptor instead.
+func (*TodayEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TodayEventsRequest) GetPerPage() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerPage
+	}
+	return nil
+}
+
+func (x *TodayEventsRequest) GetPage() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type TodayEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodayEventsResponse) Reset() {
+	*x = TodayEventsResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodayEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodayEventsResponse) ProtoMessage() {}
+
+func (x *TodayEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use TodayEventsResponse.ProtoReflect.Descriptor instead.
 func (*TodayEventsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{14}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TodayEventsResponse) GetEvents() []*Event {
@@ -1397,7 +1861,7 @@ type AddChangelogEntryRequest struct {
 
 func (x *AddChangelogEntryRequest) Reset() {
 	*x = AddChangelogEntryRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[15]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1873,7 @@ func (x *AddChangelogEntryRequest) String() string {
 func (*AddChangelogEntryRequest) ProtoMessage() {}
 
 func (x *AddChangelogEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[15]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +1886,7 @@ func (x *AddChangelogEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddChangelogEntryRequest.ProtoReflect.Descriptor instead.
 func (*AddChangelogEntryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{15}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddChangelogEntryRequest) GetId() string {
@@ -1449,7 +1913,7 @@ type AddChangelogEntryResponse struct {
 
 func (x *AddChangelogEntryResponse) Reset() {
 	*x = AddChangelogEntryResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[16]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1461,7 +1925,7 @@ func (x *AddChangelogEntryResponse) String() string {
 func (*AddChangelogEntryResponse) ProtoMessage() {}
 
 func (x *AddChangelogEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[16]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +1938,7 @@ func (x *AddChangelogEntryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddChangelogEntryResponse.ProtoReflect.Descriptor instead.
 func (*AddChangelogEntryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{16}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AddChangelogEntryResponse) GetEvent() *Event {
@@ -1496,7 +1960,7 @@ type GetEventChangelogRequest struct {
 
 func (x *GetEventChangelogRequest) Reset() {
 	*x = GetEventChangelogRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[17]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +1972,7 @@ func (x *GetEventChangelogRequest) String() string {
 func (*GetEventChangelogRequest) ProtoMessage() {}
 
 func (x *GetEventChangelogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[17]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +1985,7 @@ func (x *GetEventChangelogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventChangelogRequest.ProtoReflect.Descriptor instead.
 func (*GetEventChangelogRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{17}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetEventChangelogRequest) GetId() string {
@@ -1556,7 +2020,7 @@ type GetEventChangelogResponse struct {
 
 func (x *GetEventChangelogResponse) Reset() {
 	*x = GetEventChangelogResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[18]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1568,7 +2032,7 @@ func (x *GetEventChangelogResponse) String() string {
 func (*GetEventChangelogResponse) ProtoMessage() {}
 
 func (x *GetEventChangelogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[18]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,7 +2045,7 @@ func (x *GetEventChangelogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventChangelogResponse.ProtoReflect.Descriptor instead.
 func (*GetEventChangelogResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{18}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetEventChangelogResponse) GetChangelog() []*ChangelogEntry {
@@ -1598,20 +2062,137 @@ func (x *GetEventChangelogResponse) GetTotalCount() uint32 {
 	return 0
 }
 
+type GetEventAtRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Time to go back to, e.g. the timestamp of a changelog entry: the changes
+	// made after it are undone
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventAtRequest) Reset() {
+	*x = GetEventAtRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventAtRequest) ProtoMessage() {}
+
+func (x *GetEventAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventAtRequest.ProtoReflect.Descriptor instead.
+func (*GetEventAtRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetEventAtRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEventAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetEventAtResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event as it was, its changelog ending at the time asked
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Changelog entries after the time asked that could not be undone: their
+	// field is unknown or their old value does not parse
+	SkippedEntries uint32 `protobuf:"varint,2,opt,name=skipped_entries,json=skippedEntries,proto3" json:"skipped_entries,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEventAtResponse) Reset() {
+	*x = GetEventAtResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventAtResponse) ProtoMessage() {}
+
+func (x *GetEventAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventAtResponse.ProtoReflect.Descriptor instead.
+func (*GetEventAtResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEventAtResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GetEventAtResponse) GetSkippedEntries() uint32 {
+	if x != nil {
+		return x.SkippedEntries
+	}
+	return 0
+}
+
 type UpdateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Attributes    *EventAttributes       `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Links         *EventLinks            `protobuf:"bytes,3,opt,name=links,proto3" json:"links,omitempty"`
-	SlackId       string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
-	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Attributes *EventAttributes       `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Links      *EventLinks            `protobuf:"bytes,3,opt,name=links,proto3" json:"links,omitempty"`
+	SlackId    string                 `protobuf:"bytes,4,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	Id         string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields to update, e.g. "attributes.status,links.ticket": the others keep
+	// their value. Paths are under title, attributes and links; without mask,
+	// the whole event is replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the event is only updated if it is still at this revision, and
+	// the update fails with ABORTED otherwise. Over HTTP, the If-Match header
+	// may carry it instead.
+	Revision      int64 `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateEventRequest) Reset() {
 	*x = UpdateEventRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[19]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1623,7 +2204,7 @@ func (x *UpdateEventRequest) String() string {
 func (*UpdateEventRequest) ProtoMessage() {}
 
 func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[19]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +2217,7 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{19}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateEventRequest) GetTitle() string {
@@ -1674,6 +2255,20 @@ func (x *UpdateEventRequest) GetId() string {
 	return ""
 }
 
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type UpdateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -1683,7 +2278,7 @@ type UpdateEventResponse struct {
 
 func (x *UpdateEventResponse) Reset() {
 	*x = UpdateEventResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[20]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1695,7 +2290,7 @@ func (x *UpdateEventResponse) String() string {
 func (*UpdateEventResponse) ProtoMessage() {}
 
 func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[20]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +2303,7 @@ func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{20}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateEventResponse) GetEvent() *Event {
@@ -1728,7 +2323,7 @@ type DeleteEventRequest struct {
 
 func (x *DeleteEventRequest) Reset() {
 	*x = DeleteEventRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[21]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1740,7 +2335,7 @@ func (x *DeleteEventRequest) String() string {
 func (*DeleteEventRequest) ProtoMessage() {}
 
 func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[21]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,7 +2348,7 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{21}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteEventRequest) GetId() string {
@@ -1780,7 +2375,7 @@ type DeleteEventResponse struct {
 
 func (x *DeleteEventResponse) Reset() {
 	*x = DeleteEventResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[22]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1792,7 +2387,7 @@ func (x *DeleteEventResponse) String() string {
 func (*DeleteEventResponse) ProtoMessage() {}
 
 func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[22]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,7 +2400,7 @@ func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{22}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteEventResponse) GetId() string {
@@ -1835,7 +2430,7 @@ type AddSlackIdRequest struct {
 
 func (x *AddSlackIdRequest) Reset() {
 	*x = AddSlackIdRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[23]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +2442,7 @@ func (x *AddSlackIdRequest) String() string {
 func (*AddSlackIdRequest) ProtoMessage() {}
 
 func (x *AddSlackIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[23]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +2455,7 @@ func (x *AddSlackIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSlackIdRequest.ProtoReflect.Descriptor instead.
 func (*AddSlackIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{23}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AddSlackIdRequest) GetId() string {
@@ -1887,7 +2482,7 @@ type AddSlackIdResponse struct {
 
 func (x *AddSlackIdResponse) Reset() {
 	*x = AddSlackIdResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[24]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1899,7 +2494,7 @@ func (x *AddSlackIdResponse) String() string {
 func (*AddSlackIdResponse) ProtoMessage() {}
 
 func (x *AddSlackIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[24]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2507,7 @@ func (x *AddSlackIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSlackIdResponse.ProtoReflect.Descriptor instead.
 func (*AddSlackIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{24}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AddSlackIdResponse) GetEvent() *Event {
@@ -1930,20 +2525,22 @@ type GetEventStatsRequest struct {
 	// Required: end date for the period (format: 2006-01-02 or ISO8601)
 	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	// Optional filters
-	Environments  []Environment         `protobuf:"varint,3,rep,packed,name=environments,proto3,enum=tracker.event.v1alpha1.Environment" json:"environments,omitempty"`
-	Impact        *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=impact,proto3" json:"impact,omitempty"`
-	Priorities    []Priority            `protobuf:"varint,5,rep,packed,name=priorities,proto3,enum=tracker.event.v1alpha1.Priority" json:"priorities,omitempty"`
-	Types         []Type                `protobuf:"varint,6,rep,packed,name=types,proto3,enum=tracker.event.v1alpha1.Type" json:"types,omitempty"`
-	Statuses      []Status              `protobuf:"varint,7,rep,packed,name=statuses,proto3,enum=tracker.event.v1alpha1.Status" json:"statuses,omitempty"`
-	Source        string                `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
-	Service       string                `protobuf:"bytes,9,opt,name=service,proto3" json:"service,omitempty"`
+	Environments []Environment         `protobuf:"varint,3,rep,packed,name=environments,proto3,enum=tracker.event.v1alpha1.Environment" json:"environments,omitempty"`
+	Impact       *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	Priorities   []Priority            `protobuf:"varint,5,rep,packed,name=priorities,proto3,enum=tracker.event.v1alpha1.Priority" json:"priorities,omitempty"`
+	Types        []Type                `protobuf:"varint,6,rep,packed,name=types,proto3,enum=tracker.event.v1alpha1.Type" json:"types,omitempty"`
+	Statuses     []Status              `protobuf:"varint,7,rep,packed,name=statuses,proto3,enum=tracker.event.v1alpha1.Status" json:"statuses,omitempty"`
+	Source       string                `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	Service      string                `protobuf:"bytes,9,opt,name=service,proto3" json:"service,omitempty"`
+	// Optional filter in the query language of SearchEventsRequest.query
+	Query         string `protobuf:"bytes,10,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventStatsRequest) Reset() {
 	*x = GetEventStatsRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[25]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2552,7 @@ func (x *GetEventStatsRequest) String() string {
 func (*GetEventStatsRequest) ProtoMessage() {}
 
 func (x *GetEventStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[25]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2565,7 @@ func (x *GetEventStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{25}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetEventStatsRequest) GetStartDate() string {
@@ -2034,6 +2631,13 @@ func (x *GetEventStatsRequest) GetService() string {
 	return ""
 }
 
+func (x *GetEventStatsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 // Response for event statistics count
 type GetEventStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2046,7 +2650,7 @@ type GetEventStatsResponse struct {
 
 func (x *GetEventStatsResponse) Reset() {
 	*x = GetEventStatsResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[26]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2058,7 +2662,7 @@ func (x *GetEventStatsResponse) String() string {
 func (*GetEventStatsResponse) ProtoMessage() {}
 
 func (x *GetEventStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[26]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,7 +2675,7 @@ func (x *GetEventStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{26}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetEventStatsResponse) GetTotalCount() uint64 {
@@ -2112,13 +2716,15 @@ type GetEventStatsByMonthRequest struct {
 	Service      string                `protobuf:"bytes,9,opt,name=service,proto3" json:"service,omitempty"`
 	// Group by service in addition to month
 	GroupByService bool `protobuf:"varint,10,opt,name=group_by_service,json=groupByService,proto3" json:"group_by_service,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Optional filter in the query language of SearchEventsRequest.query
+	Query         string `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventStatsByMonthRequest) Reset() {
 	*x = GetEventStatsByMonthRequest{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[27]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2130,7 +2736,7 @@ func (x *GetEventStatsByMonthRequest) String() string {
 func (*GetEventStatsByMonthRequest) ProtoMessage() {}
 
 func (x *GetEventStatsByMonthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[27]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +2749,7 @@ func (x *GetEventStatsByMonthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventStatsByMonthRequest.ProtoReflect.Descriptor instead.
 func (*GetEventStatsByMonthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{27}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetEventStatsByMonthRequest) GetStartDate() string {
@@ -2216,6 +2822,13 @@ func (x *GetEventStatsByMonthRequest) GetGroupByService() bool {
 	return false
 }
 
+func (x *GetEventStatsByMonthRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 // Monthly statistics entry
 type MonthlyStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2229,7 +2842,7 @@ type MonthlyStats struct {
 
 func (x *MonthlyStats) Reset() {
 	*x = MonthlyStats{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[28]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2241,7 +2854,7 @@ func (x *MonthlyStats) String() string {
 func (*MonthlyStats) ProtoMessage() {}
 
 func (x *MonthlyStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[28]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,7 +2867,7 @@ func (x *MonthlyStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonthlyStats.ProtoReflect.Descriptor instead.
 func (*MonthlyStats) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{28}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MonthlyStats) GetYear() int32 {
@@ -2298,7 +2911,7 @@ type GetEventStatsByMonthResponse struct {
 
 func (x *GetEventStatsByMonthResponse) Reset() {
 	*x = GetEventStatsByMonthResponse{}
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[29]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2310,7 +2923,7 @@ func (x *GetEventStatsByMonthResponse) String() string {
 func (*GetEventStatsByMonthResponse) ProtoMessage() {}
 
 func (x *GetEventStatsByMonthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[29]
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2323,7 +2936,7 @@ func (x *GetEventStatsByMonthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventStatsByMonthResponse.ProtoReflect.Descriptor instead.
 func (*GetEventStatsByMonthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{29}
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetEventStatsByMonthResponse) GetStats() []*MonthlyStats {
@@ -2354,218 +2967,1635 @@ func (x *GetEventStatsByMonthResponse) GetEndDate() string {
 	return ""
 }
 
-var File_proto_event_v1alpha1_event_proto protoreflect.FileDescriptor
+// Request for event statistics aggregated by time bucket and by attributes
+type AggregateEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required: start date for the period (format: 2006-01-02 or ISO8601)
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required: end date for the period (format: 2006-01-02 or ISO8601)
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional filters
+	Environments []Environment         `protobuf:"varint,3,rep,packed,name=environments,proto3,enum=tracker.event.v1alpha1.Environment" json:"environments,omitempty"`
+	Impact       *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	Priorities   []Priority            `protobuf:"varint,5,rep,packed,name=priorities,proto3,enum=tracker.event.v1alpha1.Priority" json:"priorities,omitempty"`
+	Types        []Type                `protobuf:"varint,6,rep,packed,name=types,proto3,enum=tracker.event.v1alpha1.Type" json:"types,omitempty"`
+	Statuses     []Status              `protobuf:"varint,7,rep,packed,name=statuses,proto3,enum=tracker.event.v1alpha1.Status" json:"statuses,omitempty"`
+	Source       string                `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	Service      string                `protobuf:"bytes,9,opt,name=service,proto3" json:"service,omitempty"`
+	// Bucket of the creation date of the events, none when unspecified
+	Bucket TimeBucket `protobuf:"varint,10,opt,name=bucket,proto3,enum=tracker.event.v1alpha1.TimeBucket" json:"bucket,omitempty"`
+	// Attributes to group by: environment, type, status, priority, source, owner or service
+	GroupBy []string `protobuf:"bytes,11,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// Optional filter in the query language of SearchEventsRequest.query
+	Query         string `protobuf:"bytes,12,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_event_v1alpha1_event_proto_rawDesc = "" +
-	"\n" +
-	" proto/event/v1alpha1/event.proto\x12\x16tracker.event.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17validate/validate.proto\"\x84\x05\n" +
-	"\x0fEventAttributes\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x120\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12<\n" +
-	"\bpriority\x18\x04 \x01(\x0e2 .tracker.event.v1alpha1.PriorityR\bpriority\x12'\n" +
-	"\n" +
-	"related_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\trelatedId\x12\x18\n" +
-	"\aservice\x18\x06 \x01(\tR\aservice\x126\n" +
-	"\x06status\x18\a \x01(\x0e2\x1e.tracker.event.v1alpha1.StatusR\x06status\x12E\n" +
-	"\venvironment\x18\b \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x12\x16\n" +
-	"\x06impact\x18\t \x01(\bR\x06impact\x129\n" +
-	"\n" +
-	"start_date\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
-	"\x05owner\x18\f \x01(\tR\x05owner\x12#\n" +
-	"\rstake_holders\x18\r \x03(\tR\fstakeHolders\x12\"\n" +
-	"\fnotification\x18\x0e \x01(\bR\fnotification\x12$\n" +
-	"\rnotifications\x18\x0f \x03(\tR\rnotifications\"\xb6\x01\n" +
-	"\rEventMetadata\x129\n" +
-	"\n" +
-	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
-	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
-	"\x02id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x19\n" +
-	"\bslack_id\x18\x04 \x01(\tR\aslackId\"P\n" +
-	"\n" +
-	"EventLinks\x12*\n" +
-	"\x11pull_request_link\x18\x01 \x01(\tR\x0fpullRequestLink\x12\x16\n" +
-	"\x06ticket\x18\x02 \x01(\tR\x06ticket\"\x8d\x02\n" +
-	"\x0eChangelogEntry\x128\n" +
-	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
-	"\x04user\x18\x02 \x01(\tR\x04user\x12C\n" +
-	"\vchange_type\x18\x03 \x01(\x0e2\".tracker.event.v1alpha1.ChangeTypeR\n" +
-	"changeType\x12\x14\n" +
-	"\x05field\x18\x04 \x01(\tR\x05field\x12\x1b\n" +
-	"\told_value\x18\x05 \x01(\tR\boldValue\x12\x1b\n" +
-	"\tnew_value\x18\x06 \x01(\tR\bnewValue\x12\x18\n" +
-	"\acomment\x18\a \x01(\tR\acomment\"\xa9\x02\n" +
-	"\x05Event\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
-	"\n" +
-	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
-	"attributes\x128\n" +
-	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12A\n" +
-	"\bmetadata\x18\x04 \x01(\v2%.tracker.event.v1alpha1.EventMetadataR\bmetadata\x12D\n" +
-	"\tchangelog\x18\x05 \x03(\v2&.tracker.event.v1alpha1.ChangelogEntryR\tchangelog\"\xc8\x01\n" +
-	"\x12CreateEventRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
-	"\n" +
-	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
-	"attributes\x128\n" +
-	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12\x19\n" +
-	"\bslack_id\x18\x04 \x01(\tR\aslackId\"J\n" +
-	"\x13CreateEventResponse\x123\n" +
-	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"!\n" +
-	"\x0fGetEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
-	"\x10GetEventResponse\x123\n" +
-	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\xa3\x03\n" +
-	"\x13SearchEventsRequest\x12\x16\n" +
-	"\x06source\x18\x01 \x01(\tR\x06source\x120\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12<\n" +
-	"\bpriority\x18\x03 \x01(\x0e2 .tracker.event.v1alpha1.PriorityR\bpriority\x126\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x1e.tracker.event.v1alpha1.StatusR\x06status\x12\x18\n" +
-	"\aservice\x18\x05 \x01(\tR\aservice\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\a \x01(\tR\aendDate\x12E\n" +
-	"\venvironment\x18\b \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x12\x16\n" +
-	"\x06impact\x18\t \x01(\bR\x06impact\x12\x19\n" +
-	"\bslack_id\x18\n" +
-	" \x01(\tR\aslackId\"n\n" +
-	"\x14SearchEventsResponse\x125\n" +
-	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount\"}\n" +
-	"\x11ListEventsRequest\x127\n" +
-	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
-	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"l\n" +
-	"\x12ListEventsResponse\x125\n" +
-	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount\"~\n" +
-	"\x12TodayEventsRequest\x127\n" +
-	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
-	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"m\n" +
-	"\x13TodayEventsResponse\x125\n" +
-	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount\"r\n" +
-	"\x18AddChangelogEntryRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12<\n" +
-	"\x05entry\x18\x02 \x01(\v2&.tracker.event.v1alpha1.ChangelogEntryR\x05entry\"P\n" +
-	"\x19AddChangelogEntryResponse\x123\n" +
-	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\x9e\x01\n" +
-	"\x18GetEventChangelogRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
-	"\bper_page\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
-	"\x04page\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"\x82\x01\n" +
-	"\x19GetEventChangelogResponse\x12D\n" +
-	"\tchangelog\x18\x01 \x03(\v2&.tracker.event.v1alpha1.ChangelogEntryR\tchangelog\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount\"\xd8\x01\n" +
-	"\x12UpdateEventRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
-	"\n" +
-	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
-	"attributes\x128\n" +
-	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12\x19\n" +
-	"\bslack_id\x18\x04 \x01(\tR\aslackId\x12\x0e\n" +
-	"\x02id\x18\x05 \x01(\tR\x02id\"J\n" +
-	"\x13UpdateEventResponse\x123\n" +
-	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"?\n" +
-	"\x12DeleteEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\bslack_id\x18\x02 \x01(\tR\aslackId\"@\n" +
-	"\x13DeleteEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\bslack_id\x18\x02 \x01(\tR\aslackId\"H\n" +
-	"\x11AddSlackIdRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x19\n" +
-	"\bslack_id\x18\x02 \x01(\tR\aslackId\"I\n" +
-	"\x12AddSlackIdResponse\x123\n" +
-	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\xc3\x03\n" +
-	"\x14GetEventStatsRequest\x12&\n" +
-	"\n" +
-	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
-	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
-	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x122\n" +
-	"\x06impact\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12@\n" +
-	"\n" +
-	"priorities\x18\x05 \x03(\x0e2 .tracker.event.v1alpha1.PriorityR\n" +
-	"priorities\x122\n" +
-	"\x05types\x18\x06 \x03(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x05types\x12:\n" +
-	"\bstatuses\x18\a \x03(\x0e2\x1e.tracker.event.v1alpha1.StatusR\bstatuses\x12\x16\n" +
-	"\x06source\x18\b \x01(\tR\x06source\x12\x18\n" +
-	"\aservice\x18\t \x01(\tR\aservice\"r\n" +
-	"\x15GetEventStatsResponse\x12\x1f\n" +
-	"\vtotal_count\x18\x01 \x01(\x04R\n" +
-	"totalCount\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xf4\x03\n" +
-	"\x1bGetEventStatsByMonthRequest\x12&\n" +
-	"\n" +
-	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
-	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
-	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x122\n" +
-	"\x06impact\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12@\n" +
-	"\n" +
-	"priorities\x18\x05 \x03(\x0e2 .tracker.event.v1alpha1.PriorityR\n" +
-	"priorities\x122\n" +
-	"\x05types\x18\x06 \x03(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x05types\x12:\n" +
-	"\bstatuses\x18\a \x03(\x0e2\x1e.tracker.event.v1alpha1.StatusR\bstatuses\x12\x16\n" +
-	"\x06source\x18\b \x01(\tR\x06source\x12\x18\n" +
-	"\aservice\x18\t \x01(\tR\aservice\x12(\n" +
-	"\x10group_by_service\x18\n" +
-	" \x01(\bR\x0egroupByService\"h\n" +
-	"\fMonthlyStats\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
-	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x18\n" +
-	"\aservice\x18\x04 \x01(\tR\aservice\"\xb5\x01\n" +
-	"\x1cGetEventStatsByMonthResponse\x12:\n" +
-	"\x05stats\x18\x01 \x03(\v2$.tracker.event.v1alpha1.MonthlyStatsR\x05stats\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x04R\n" +
-	"totalCount\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x04 \x01(\tR\aendDate*c\n" +
-	"\x04Type\x12\x14\n" +
-	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
-	"\n" +
-	"deployment\x10\x01\x12\r\n" +
-	"\toperation\x10\x02\x12\t\n" +
-	"\x05drift\x10\x03\x12\f\n" +
-	"\bincident\x10\x04\x12\r\n" +
-	"\trpa_usage\x10\x05*L\n" +
-	"\bPriority\x12\x18\n" +
-	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x06\n" +
-	"\x02P1\x10\x01\x12\x06\n" +
-	"\x02P2\x10\x02\x12\x06\n" +
-	"\x02P3\x10\x03\x12\x06\n" +
-	"\x02P4\x10\x04\x12\x06\n" +
-	"\x02P5\x10\x05*\xcd\x01\n" +
-	"\x06Status\x12\x16\n" +
-	"\x12STATUS_UNSPECIFIED\x10\x00\x12\t\n" +
-	"\x05start\x10\x01\x12\v\n" +
-	"\afailure\x10\x02\x12\v\n" +
-	"\asuccess\x10\x03\x12\v\n" +
-	"\awarning\x10\x04\x12\t\n" +
-	"\x05error\x10\x05\x12\f\n" +
-	"\bsnapshot\x10\x06\x12\x0f\n" +
-	"\vuser_update\x10\a\x12\x12\n" +
-	"\x0erecommandation\x10\b\x12\b\n" +
-	"\x04open\x10\t\x12\t\n" +
-	"\x05close\x10\n" +
-	"\x12\b\n" +
-	"\x04done\x10\v\x12\x0f\n" +
-	"\vin_progress\x10\f\x12\v\n" +
-	"\aplanned\x10\r*\x97\x01\n" +
-	"\vEnvironment\x12\x1b\n" +
-	"\x17ENVIRONMENT_UNSPECIFIED\x10\x00\x12\x0f\n" +
-	"\vdevelopment\x10\x01\x12\x0f\n" +
-	"\vintegration\x10\x02\x12\a\n" +
-	"\x03TNR\x10\x03\x12\a\n" +
-	"\x03UAT\x10\x04\x12\v\n" +
+func (x *AggregateEventsRequest) Reset() {
+	*x = AggregateEventsRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateEventsRequest) ProtoMessage() {}
+
+func (x *AggregateEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateEventsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AggregateEventsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AggregateEventsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AggregateEventsRequest) GetEnvironments() []Environment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetImpact() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetPriorities() []Priority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetStatuses() []Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AggregateEventsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AggregateEventsRequest) GetBucket() TimeBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return TimeBucket_TIME_BUCKET_UNSPECIFIED
+}
+
+func (x *AggregateEventsRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// Statistics of the events of a bucket and group
+type Aggregation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the bucket (UTC), unset without bucket
+	Bucket *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Value of each attribute of group_by
+	Group     map[string]string `protobuf:"bytes,2,rep,name=group,proto3" json:"group,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Count     uint64            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Successes uint64            `protobuf:"varint,4,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures  uint64            `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+	// Share of success among the events ended by a success or a failure, from 0 to 1
+	SuccessRatio float64 `protobuf:"fixed64,6,opt,name=success_ratio,json=successRatio,proto3" json:"success_ratio,omitempty"`
+	// Percentiles of the duration of the events having one
+	DurationP50   *durationpb.Duration `protobuf:"bytes,7,opt,name=duration_p50,json=durationP50,proto3" json:"duration_p50,omitempty"`
+	DurationP90   *durationpb.Duration `protobuf:"bytes,8,opt,name=duration_p90,json=durationP90,proto3" json:"duration_p90,omitempty"`
+	DurationP99   *durationpb.Duration `protobuf:"bytes,9,opt,name=duration_p99,json=durationP99,proto3" json:"duration_p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregation) Reset() {
+	*x = Aggregation{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation) ProtoMessage() {}
+
+func (x *Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation.ProtoReflect.Descriptor instead.
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Aggregation) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *Aggregation) GetGroup() map[string]string {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *Aggregation) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Aggregation) GetSuccesses() uint64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *Aggregation) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Aggregation) GetSuccessRatio() float64 {
+	if x != nil {
+		return x.SuccessRatio
+	}
+	return 0
+}
+
+func (x *Aggregation) GetDurationP50() *durationpb.Duration {
+	if x != nil {
+		return x.DurationP50
+	}
+	return nil
+}
+
+func (x *Aggregation) GetDurationP90() *durationpb.Duration {
+	if x != nil {
+		return x.DurationP90
+	}
+	return nil
+}
+
+func (x *Aggregation) GetDurationP99() *durationpb.Duration {
+	if x != nil {
+		return x.DurationP99
+	}
+	return nil
+}
+
+// Response for the aggregated statistics, sorted by bucket then group
+type AggregateEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aggregations  []*Aggregation         `protobuf:"bytes,1,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+	TotalCount    uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateEventsResponse) Reset() {
+	*x = AggregateEventsResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateEventsResponse) ProtoMessage() {}
+
+func (x *AggregateEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateEventsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AggregateEventsResponse) GetAggregations() []*Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateEventsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *AggregateEventsResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AggregateEventsResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Request for the DORA metrics of a period
+type GetDoraMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required: start date for the period (format: 2006-01-02 or ISO8601)
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required: end date for the period (format: 2006-01-02 or ISO8601)
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional filters
+	Environments []Environment `protobuf:"varint,3,rep,packed,name=environments,proto3,enum=tracker.event.v1alpha1.Environment" json:"environments,omitempty"`
+	Source       string        `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Service      string        `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	// Group the metrics by service, by team (owner of the service in the
+	// catalog) and by environment, in any combination
+	GroupByService     bool `protobuf:"varint,6,opt,name=group_by_service,json=groupByService,proto3" json:"group_by_service,omitempty"`
+	GroupByTeam        bool `protobuf:"varint,7,opt,name=group_by_team,json=groupByTeam,proto3" json:"group_by_team,omitempty"`
+	GroupByEnvironment bool `protobuf:"varint,8,opt,name=group_by_environment,json=groupByEnvironment,proto3" json:"group_by_environment,omitempty"`
+	// Optional filter in the query language of SearchEventsRequest.query
+	Query         string `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoraMetricsRequest) Reset() {
+	*x = GetDoraMetricsRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoraMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoraMetricsRequest) ProtoMessage() {}
+
+func (x *GetDoraMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoraMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoraMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDoraMetricsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsRequest) GetEnvironments() []Environment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *GetDoraMetricsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsRequest) GetGroupByService() bool {
+	if x != nil {
+		return x.GroupByService
+	}
+	return false
+}
+
+func (x *GetDoraMetricsRequest) GetGroupByTeam() bool {
+	if x != nil {
+		return x.GroupByTeam
+	}
+	return false
+}
+
+func (x *GetDoraMetricsRequest) GetGroupByEnvironment() bool {
+	if x != nil {
+		return x.GroupByEnvironment
+	}
+	return false
+}
+
+func (x *GetDoraMetricsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// DORA metrics of a group, its keys are only populated when grouped by
+type DoraMetrics struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Team        string                 `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Environment Environment            `protobuf:"varint,3,opt,name=environment,proto3,enum=tracker.event.v1alpha1.Environment" json:"environment,omitempty"`
+	// Deployments ended in the period, successful or failed
+	Deployments uint64 `protobuf:"varint,4,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	// Deployments per day
+	DeploymentFrequency float64 `protobuf:"fixed64,5,opt,name=deployment_frequency,json=deploymentFrequency,proto3" json:"deployment_frequency,omitempty"`
+	// Median duration of the successful deployments, from their start to their end
+	LeadTime *durationpb.Duration `protobuf:"bytes,6,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	// Deployments failed, or related to an incident
+	FailedDeployments uint64 `protobuf:"varint,7,opt,name=failed_deployments,json=failedDeployments,proto3" json:"failed_deployments,omitempty"`
+	// Share of failed deployments, from 0 to 1
+	ChangeFailureRate float64 `protobuf:"fixed64,8,opt,name=change_failure_rate,json=changeFailureRate,proto3" json:"change_failure_rate,omitempty"`
+	// Incidents resolved in the period
+	Incidents uint64 `protobuf:"varint,9,opt,name=incidents,proto3" json:"incidents,omitempty"`
+	// Median duration of the resolved incidents, from their opening to their resolution
+	TimeToRestore *durationpb.Duration `protobuf:"bytes,10,opt,name=time_to_restore,json=timeToRestore,proto3" json:"time_to_restore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoraMetrics) Reset() {
+	*x = DoraMetrics{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraMetrics) ProtoMessage() {}
+
+func (x *DoraMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraMetrics.ProtoReflect.Descriptor instead.
+func (*DoraMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DoraMetrics) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *DoraMetrics) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *DoraMetrics) GetEnvironment() Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return Environment_ENVIRONMENT_UNSPECIFIED
+}
+
+func (x *DoraMetrics) GetDeployments() uint64 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetDeploymentFrequency() float64 {
+	if x != nil {
+		return x.DeploymentFrequency
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetLeadTime() *durationpb.Duration {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetFailedDeployments() uint64 {
+	if x != nil {
+		return x.FailedDeployments
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetChangeFailureRate() float64 {
+	if x != nil {
+		return x.ChangeFailureRate
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetIncidents() uint64 {
+	if x != nil {
+		return x.Incidents
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetTimeToRestore() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToRestore
+	}
+	return nil
+}
+
+// Response for the DORA metrics, one entry per group
+type GetDoraMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*DoraMetrics         `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoraMetricsResponse) Reset() {
+	*x = GetDoraMetricsResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoraMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoraMetricsResponse) ProtoMessage() {}
+
+func (x *GetDoraMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoraMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetDoraMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDoraMetricsResponse) GetMetrics() []*DoraMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *GetDoraMetricsResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Request for the overlapping events of a period
+type GetOverlapsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required: start date for the period (format: 2006-01-02 or ISO8601)
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required: end date for the period (format: 2006-01-02 or ISO8601)
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional filters
+	Environments []Environment `protobuf:"varint,3,rep,packed,name=environments,proto3,enum=tracker.event.v1alpha1.Environment" json:"environments,omitempty"`
+	Service      string        `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	// Pair the events of an environment whatever their service, instead of the
+	// events of the same service only
+	EnvironmentOnly bool `protobuf:"varint,5,opt,name=environment_only,json=environmentOnly,proto3" json:"environment_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetOverlapsRequest) Reset() {
+	*x = GetOverlapsRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverlapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverlapsRequest) ProtoMessage() {}
+
+func (x *GetOverlapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverlapsRequest.ProtoReflect.Descriptor instead.
+func (*GetOverlapsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetOverlapsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetOverlapsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetOverlapsRequest) GetEnvironments() []Environment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *GetOverlapsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetOverlapsRequest) GetEnvironmentOnly() bool {
+	if x != nil {
+		return x.EnvironmentOnly
+	}
+	return false
+}
+
+// Response with the overlaps sorted by start
+type GetOverlapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overlaps      []*Overlap             `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverlapsResponse) Reset() {
+	*x = GetOverlapsResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverlapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverlapsResponse) ProtoMessage() {}
+
+func (x *GetOverlapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverlapsResponse.ProtoReflect.Descriptor instead.
+func (*GetOverlapsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOverlapsResponse) GetOverlaps() []*Overlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+// Two events of an environment whose periods, from start_date, or the creation
+// date, to end_date, intersect
+type Overlap struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	First  *Event                 `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second *Event                 `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	// Owner of the service of each event in the catalog, empty when unknown
+	FirstOwner    string                 `protobuf:"bytes,3,opt,name=first_owner,json=firstOwner,proto3" json:"first_owner,omitempty"`
+	SecondOwner   string                 `protobuf:"bytes,4,opt,name=second_owner,json=secondOwner,proto3" json:"second_owner,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Overlap) Reset() {
+	*x = Overlap{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Overlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Overlap) ProtoMessage() {}
+
+func (x *Overlap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Overlap.ProtoReflect.Descriptor instead.
+func (*Overlap) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Overlap) GetFirst() *Event {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *Overlap) GetSecond() *Event {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *Overlap) GetFirstOwner() string {
+	if x != nil {
+		return x.FirstOwner
+	}
+	return ""
+}
+
+func (x *Overlap) GetSecondOwner() string {
+	if x != nil {
+		return x.SecondOwner
+	}
+	return ""
+}
+
+func (x *Overlap) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Overlap) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Overlap) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// Request for a pre-flight check, with the fields CreateEvent would receive
+type CanDeployRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Environment Environment            `protobuf:"varint,2,opt,name=environment,proto3,enum=tracker.event.v1alpha1.Environment" json:"environment,omitempty"`
+	// deployment when unset, or operation
+	Type Type `protobuf:"varint,3,opt,name=type,proto3,enum=tracker.event.v1alpha1.Type" json:"type,omitempty"`
+	// Team deploying, the owner of a reservation may deploy during it
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Release of the deployment, sharing the locks of its lock bundle
+	ReleaseId string `protobuf:"bytes,5,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// Approved exception letting the deployment through a freeze
+	FreezeExceptionId string `protobuf:"bytes,6,opt,name=freeze_exception_id,json=freezeExceptionId,proto3" json:"freeze_exception_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CanDeployRequest) Reset() {
+	*x = CanDeployRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanDeployRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanDeployRequest) ProtoMessage() {}
+
+func (x *CanDeployRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanDeployRequest.ProtoReflect.Descriptor instead.
+func (*CanDeployRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CanDeployRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *CanDeployRequest) GetEnvironment() Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return Environment_ENVIRONMENT_UNSPECIFIED
+}
+
+func (x *CanDeployRequest) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_TYPE_UNSPECIFIED
+}
+
+func (x *CanDeployRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *CanDeployRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *CanDeployRequest) GetFreezeExceptionId() string {
+	if x != nil {
+		return x.FreezeExceptionId
+	}
+	return ""
+}
+
+// Verdict of a pre-flight check, with every reason refusing the deployment
+type CanDeployResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reasons []*BlockingReason      `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Some reasons were left out: at most 20 of each kind are listed
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanDeployResponse) Reset() {
+	*x = CanDeployResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanDeployResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanDeployResponse) ProtoMessage() {}
+
+func (x *CanDeployResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanDeployResponse.ProtoReflect.Descriptor instead.
+func (*CanDeployResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CanDeployResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CanDeployResponse) GetReasons() []*BlockingReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *CanDeployResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type BlockingReason struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Kind    BlockingReasonKind     `protobuf:"varint,1,opt,name=kind,proto3,enum=tracker.event.v1alpha1.BlockingReasonKind" json:"kind,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Id of the lock, reservation, event or freeze blocking the deployment
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Date the reason ends at, when known
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockingReason) Reset() {
+	*x = BlockingReason{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockingReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingReason) ProtoMessage() {}
+
+func (x *BlockingReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingReason.ProtoReflect.Descriptor instead.
+func (*BlockingReason) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BlockingReason) GetKind() BlockingReasonKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BlockingReasonKind_BLOCKING_REASON_KIND_UNSPECIFIED
+}
+
+func (x *BlockingReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlockingReason) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlockingReason) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// Request for the changes of the events matching the filter, unset fields match any event
+type WatchEventsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Source      string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Type        Type                   `protobuf:"varint,2,opt,name=type,proto3,enum=tracker.event.v1alpha1.Type" json:"type,omitempty"`
+	Priority    Priority               `protobuf:"varint,3,opt,name=priority,proto3,enum=tracker.event.v1alpha1.Priority" json:"priority,omitempty"`
+	Status      Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=tracker.event.v1alpha1.Status" json:"status,omitempty"`
+	Service     string                 `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	Environment Environment            `protobuf:"varint,6,opt,name=environment,proto3,enum=tracker.event.v1alpha1.Environment" json:"environment,omitempty"`
+	Impact      *wrapperspb.BoolValue  `protobuf:"bytes,7,opt,name=impact,proto3" json:"impact,omitempty"`
+	// resume_token of the last change received, to first get the changes made since
+	ResumeToken   string `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WatchEventsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_TYPE_UNSPECIFIED
+}
+
+func (x *WatchEventsRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *WatchEventsRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *WatchEventsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetEnvironment() Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return Environment_ENVIRONMENT_UNSPECIFIED
+}
+
+func (x *WatchEventsRequest) GetImpact() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// A change of an event. The first response of a watch has no change, only
+// the resume_token of the current position.
+type WatchEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  EventChangeKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=tracker.event.v1alpha1.EventChangeKind" json:"kind,omitempty"`
+	// The event after the change, as it was before its deletion
+	Event *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// The entry added, for changelog_added
+	Entry       *ChangelogEntry        `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	ResumeToken string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The attributes of the event before an update
+	PreviousAttributes *EventAttributes `protobuf:"bytes,6,opt,name=previous_attributes,json=previousAttributes,proto3" json:"previous_attributes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_v1alpha1_event_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_event_v1alpha1_event_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchEventsResponse) GetKind() EventChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EventChangeKind_EVENT_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *WatchEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetEntry() *ChangelogEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchEventsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetPreviousAttributes() *EventAttributes {
+	if x != nil {
+		return x.PreviousAttributes
+	}
+	return nil
+}
+
+var File_proto_event_v1alpha1_event_proto protoreflect.FileDescriptor
+
+const file_proto_event_v1alpha1_event_proto_rawDesc = "" +
+	"\n" +
+	" proto/event/v1alpha1/event.proto\x12\x16tracker.event.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1eproto/lock/v1alpha1/lock.proto\x1a\x17validate/validate.proto\"\xa3\x05\n" +
+	"\x0fEventAttributes\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12<\n" +
+	"\bpriority\x18\x04 \x01(\x0e2 .tracker.event.v1alpha1.PriorityR\bpriority\x12'\n" +
+	"\n" +
+	"related_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\trelatedId\x12\x18\n" +
+	"\aservice\x18\x06 \x01(\tR\aservice\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.tracker.event.v1alpha1.StatusR\x06status\x12E\n" +
+	"\venvironment\x18\b \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x12\x16\n" +
+	"\x06impact\x18\t \x01(\bR\x06impact\x129\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
+	"\x05owner\x18\f \x01(\tR\x05owner\x12#\n" +
+	"\rstake_holders\x18\r \x03(\tR\fstakeHolders\x12\"\n" +
+	"\fnotification\x18\x0e \x01(\bR\fnotification\x12$\n" +
+	"\rnotifications\x18\x0f \x03(\tR\rnotifications\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x10 \x01(\tR\treleaseId\"\x9e\x02\n" +
+	"\rEventMetadata\x129\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
+	"\x02id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x19\n" +
+	"\bslack_id\x18\x04 \x01(\tR\aslackId\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\frequest_hash\x18\a \x01(\tR\vrequestHash\"P\n" +
+	"\n" +
+	"EventLinks\x12*\n" +
+	"\x11pull_request_link\x18\x01 \x01(\tR\x0fpullRequestLink\x12\x16\n" +
+	"\x06ticket\x18\x02 \x01(\tR\x06ticket\"\x8d\x02\n" +
+	"\x0eChangelogEntry\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12C\n" +
+	"\vchange_type\x18\x03 \x01(\x0e2\".tracker.event.v1alpha1.ChangeTypeR\n" +
+	"changeType\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x05 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x06 \x01(\tR\bnewValue\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\"\xa9\x02\n" +
+	"\x05Event\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
+	"attributes\x128\n" +
+	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12A\n" +
+	"\bmetadata\x18\x04 \x01(\v2%.tracker.event.v1alpha1.EventMetadataR\bmetadata\x12D\n" +
+	"\tchangelog\x18\x05 \x03(\v2&.tracker.event.v1alpha1.ChangelogEntryR\tchangelog\"\xeb\x02\n" +
+	"\x12CreateEventRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
+	"attributes\x128\n" +
+	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12\x19\n" +
+	"\bslack_id\x18\x04 \x01(\tR\aslackId\x12>\n" +
+	"\block_ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\alockTtl\x12.\n" +
+	"\x13freeze_exception_id\x18\x06 \x01(\tR\x11freezeExceptionId\x121\n" +
+	"\x0fidempotency_key\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x0eidempotencyKey\"{\n" +
+	"\x13CreateEventResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\x12/\n" +
+	"\x04lock\x18\x02 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\"!\n" +
+	"\x0fGetEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x10GetEventResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\xe4\x04\n" +
+	"\x13SearchEventsRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12<\n" +
+	"\bpriority\x18\x03 \x01(\x0e2 .tracker.event.v1alpha1.PriorityR\bpriority\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.tracker.event.v1alpha1.StatusR\x06status\x12\x18\n" +
+	"\aservice\x18\x05 \x01(\tR\aservice\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12E\n" +
+	"\venvironment\x18\b \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x12\x16\n" +
+	"\x06impact\x18\t \x01(\bR\x06impact\x12\x19\n" +
+	"\bslack_id\x18\n" +
+	" \x01(\tR\aslackId\x127\n" +
+	"\bper_page\x18\v \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
+	"\x04page\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x12\x12\n" +
+	"\x04sort\x18\r \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x0e \x01(\tR\tpageToken\x12\f\n" +
+	"\x01q\x18\x0f \x01(\tR\x01q\x12\x14\n" +
+	"\x05query\x18\x10 \x01(\tR\x05query\"\xcd\x01\n" +
+	"\x14SearchEventsResponse\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x125\n" +
+	"\x04hits\x18\x04 \x03(\v2!.tracker.event.v1alpha1.SearchHitR\x04hits\"\x7f\n" +
+	"\tSearchHit\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12A\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2!.tracker.event.v1alpha1.HighlightR\n" +
+	"highlights\";\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\xb0\x01\n" +
+	"\x11ListEventsRequest\x127\n" +
+	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
+	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x94\x01\n" +
+	"\x12ListEventsResponse\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"~\n" +
+	"\x12TodayEventsRequest\x127\n" +
+	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
+	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"m\n" +
+	"\x13TodayEventsResponse\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.tracker.event.v1alpha1.EventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\"r\n" +
+	"\x18AddChangelogEntryRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12<\n" +
+	"\x05entry\x18\x02 \x01(\v2&.tracker.event.v1alpha1.ChangelogEntryR\x05entry\"P\n" +
+	"\x19AddChangelogEntryResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\x9e\x01\n" +
+	"\x18GetEventChangelogRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
+	"\bper_page\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
+	"\x04page\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"\x82\x01\n" +
+	"\x19GetEventChangelogResponse\x12D\n" +
+	"\tchangelog\x18\x01 \x03(\v2&.tracker.event.v1alpha1.ChangelogEntryR\tchangelog\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\"Y\n" +
+	"\x11GetEventAtRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"r\n" +
+	"\x12GetEventAtResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\x12'\n" +
+	"\x0fskipped_entries\x18\x02 \x01(\rR\x0eskippedEntries\"\xb1\x02\n" +
+	"\x12UpdateEventRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12G\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\n" +
+	"attributes\x128\n" +
+	"\x05links\x18\x03 \x01(\v2\".tracker.event.v1alpha1.EventLinksR\x05links\x12\x19\n" +
+	"\bslack_id\x18\x04 \x01(\tR\aslackId\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1a\n" +
+	"\brevision\x18\a \x01(\x03R\brevision\"J\n" +
+	"\x13UpdateEventResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"?\n" +
+	"\x12DeleteEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bslack_id\x18\x02 \x01(\tR\aslackId\"@\n" +
+	"\x13DeleteEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bslack_id\x18\x02 \x01(\tR\aslackId\"H\n" +
+	"\x11AddSlackIdRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x19\n" +
+	"\bslack_id\x18\x02 \x01(\tR\aslackId\"I\n" +
+	"\x12AddSlackIdResponse\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\"\xd9\x03\n" +
+	"\x14GetEventStatsRequest\x12&\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
+	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x122\n" +
+	"\x06impact\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12@\n" +
+	"\n" +
+	"priorities\x18\x05 \x03(\x0e2 .tracker.event.v1alpha1.PriorityR\n" +
+	"priorities\x122\n" +
+	"\x05types\x18\x06 \x03(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x05types\x12:\n" +
+	"\bstatuses\x18\a \x03(\x0e2\x1e.tracker.event.v1alpha1.StatusR\bstatuses\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12\x18\n" +
+	"\aservice\x18\t \x01(\tR\aservice\x12\x14\n" +
+	"\x05query\x18\n" +
+	" \x01(\tR\x05query\"r\n" +
+	"\x15GetEventStatsResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x04R\n" +
+	"totalCount\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\x8a\x04\n" +
+	"\x1bGetEventStatsByMonthRequest\x12&\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
+	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x122\n" +
+	"\x06impact\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12@\n" +
+	"\n" +
+	"priorities\x18\x05 \x03(\x0e2 .tracker.event.v1alpha1.PriorityR\n" +
+	"priorities\x122\n" +
+	"\x05types\x18\x06 \x03(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x05types\x12:\n" +
+	"\bstatuses\x18\a \x03(\x0e2\x1e.tracker.event.v1alpha1.StatusR\bstatuses\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12\x18\n" +
+	"\aservice\x18\t \x01(\tR\aservice\x12(\n" +
+	"\x10group_by_service\x18\n" +
+	" \x01(\bR\x0egroupByService\x12\x14\n" +
+	"\x05query\x18\v \x01(\tR\x05query\"h\n" +
+	"\fMonthlyStats\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\"\xb5\x01\n" +
+	"\x1cGetEventStatsByMonthResponse\x12:\n" +
+	"\x05stats\x18\x01 \x03(\v2$.tracker.event.v1alpha1.MonthlyStatsR\x05stats\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x04R\n" +
+	"totalCount\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xb2\x04\n" +
+	"\x16AggregateEventsRequest\x12&\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
+	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x122\n" +
+	"\x06impact\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12@\n" +
+	"\n" +
+	"priorities\x18\x05 \x03(\x0e2 .tracker.event.v1alpha1.PriorityR\n" +
+	"priorities\x122\n" +
+	"\x05types\x18\x06 \x03(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x05types\x12:\n" +
+	"\bstatuses\x18\a \x03(\x0e2\x1e.tracker.event.v1alpha1.StatusR\bstatuses\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12\x18\n" +
+	"\aservice\x18\t \x01(\tR\aservice\x12:\n" +
+	"\x06bucket\x18\n" +
+	" \x01(\x0e2\".tracker.event.v1alpha1.TimeBucketR\x06bucket\x12\x19\n" +
+	"\bgroup_by\x18\v \x03(\tR\agroupBy\x12\x14\n" +
+	"\x05query\x18\f \x01(\tR\x05query\"\xf0\x03\n" +
+	"\vAggregation\x122\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06bucket\x12D\n" +
+	"\x05group\x18\x02 \x03(\v2..tracker.event.v1alpha1.Aggregation.GroupEntryR\x05group\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x1c\n" +
+	"\tsuccesses\x18\x04 \x01(\x04R\tsuccesses\x12\x1a\n" +
+	"\bfailures\x18\x05 \x01(\x04R\bfailures\x12#\n" +
+	"\rsuccess_ratio\x18\x06 \x01(\x01R\fsuccessRatio\x12<\n" +
+	"\fduration_p50\x18\a \x01(\v2\x19.google.protobuf.DurationR\vdurationP50\x12<\n" +
+	"\fduration_p90\x18\b \x01(\v2\x19.google.protobuf.DurationR\vdurationP90\x12<\n" +
+	"\fduration_p99\x18\t \x01(\v2\x19.google.protobuf.DurationR\vdurationP99\x1a8\n" +
+	"\n" +
+	"GroupEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x01\n" +
+	"\x17AggregateEventsResponse\x12G\n" +
+	"\faggregations\x18\x01 \x03(\v2#.tracker.event.v1alpha1.AggregationR\faggregations\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x04R\n" +
+	"totalCount\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xf4\x02\n" +
+	"\x15GetDoraMetricsRequest\x12&\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
+	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\aservice\x18\x05 \x01(\tR\aservice\x12(\n" +
+	"\x10group_by_service\x18\x06 \x01(\bR\x0egroupByService\x12\"\n" +
+	"\rgroup_by_team\x18\a \x01(\bR\vgroupByTeam\x120\n" +
+	"\x14group_by_environment\x18\b \x01(\bR\x12groupByEnvironment\x12\x14\n" +
+	"\x05query\x18\t \x01(\tR\x05query\"\xcf\x03\n" +
+	"\vDoraMetrics\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x12\n" +
+	"\x04team\x18\x02 \x01(\tR\x04team\x12E\n" +
+	"\venvironment\x18\x03 \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x12 \n" +
+	"\vdeployments\x18\x04 \x01(\x04R\vdeployments\x121\n" +
+	"\x14deployment_frequency\x18\x05 \x01(\x01R\x13deploymentFrequency\x126\n" +
+	"\tlead_time\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bleadTime\x12-\n" +
+	"\x12failed_deployments\x18\a \x01(\x04R\x11failedDeployments\x12.\n" +
+	"\x13change_failure_rate\x18\b \x01(\x01R\x11changeFailureRate\x12\x1c\n" +
+	"\tincidents\x18\t \x01(\x04R\tincidents\x12A\n" +
+	"\x0ftime_to_restore\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\rtimeToRestore\"\x91\x01\n" +
+	"\x16GetDoraMetricsResponse\x12=\n" +
+	"\ametrics\x18\x01 \x03(\v2#.tracker.event.v1alpha1.DoraMetricsR\ametrics\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xee\x01\n" +
+	"\x12GetOverlapsRequest\x12&\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendDate\x12G\n" +
+	"\fenvironments\x18\x03 \x03(\x0e2#.tracker.event.v1alpha1.EnvironmentR\fenvironments\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12)\n" +
+	"\x10environment_only\x18\x05 \x01(\bR\x0fenvironmentOnly\"R\n" +
+	"\x13GetOverlapsResponse\x12;\n" +
+	"\boverlaps\x18\x01 \x03(\v2\x1f.tracker.event.v1alpha1.OverlapR\boverlaps\"\xd0\x02\n" +
+	"\aOverlap\x123\n" +
+	"\x05first\x18\x01 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05first\x125\n" +
+	"\x06second\x18\x02 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x06second\x12\x1f\n" +
+	"\vfirst_owner\x18\x03 \x01(\tR\n" +
+	"firstOwner\x12!\n" +
+	"\fsecond_owner\x18\x04 \x01(\tR\vsecondOwner\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\"\x9f\x02\n" +
+	"\x10CanDeployRequest\x12!\n" +
+	"\aservice\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aservice\x12Q\n" +
+	"\venvironment\x18\x02 \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\venvironment\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x05 \x01(\tR\treleaseId\x12.\n" +
+	"\x13freeze_exception_id\x18\x06 \x01(\tR\x11freezeExceptionId\"\x8d\x01\n" +
+	"\x11CanDeployResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12@\n" +
+	"\areasons\x18\x02 \x03(\v2&.tracker.event.v1alpha1.BlockingReasonR\areasons\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xac\x01\n" +
+	"\x0eBlockingReason\x12>\n" +
+	"\x04kind\x18\x01 \x01(\x0e2*.tracker.event.v1alpha1.BlockingReasonKindR\x04kind\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x8c\x03\n" +
+	"\x12WatchEventsRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.tracker.event.v1alpha1.TypeR\x04type\x12<\n" +
+	"\bpriority\x18\x03 \x01(\x0e2 .tracker.event.v1alpha1.PriorityR\bpriority\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.tracker.event.v1alpha1.StatusR\x06status\x12\x18\n" +
+	"\aservice\x18\x05 \x01(\tR\aservice\x12E\n" +
+	"\venvironment\x18\x06 \x01(\x0e2#.tracker.event.v1alpha1.EnvironmentR\venvironment\x122\n" +
+	"\x06impact\x18\a \x01(\v2\x1a.google.protobuf.BoolValueR\x06impact\x12!\n" +
+	"\fresume_token\x18\b \x01(\tR\vresumeToken\"\xfc\x02\n" +
+	"\x13WatchEventsResponse\x12;\n" +
+	"\x04kind\x18\x01 \x01(\x0e2'.tracker.event.v1alpha1.EventChangeKindR\x04kind\x123\n" +
+	"\x05event\x18\x02 \x01(\v2\x1d.tracker.event.v1alpha1.EventR\x05event\x12<\n" +
+	"\x05entry\x18\x03 \x01(\v2&.tracker.event.v1alpha1.ChangelogEntryR\x05entry\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12X\n" +
+	"\x13previous_attributes\x18\x06 \x01(\v2'.tracker.event.v1alpha1.EventAttributesR\x12previousAttributes*c\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"deployment\x10\x01\x12\r\n" +
+	"\toperation\x10\x02\x12\t\n" +
+	"\x05drift\x10\x03\x12\f\n" +
+	"\bincident\x10\x04\x12\r\n" +
+	"\trpa_usage\x10\x05*L\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x06\n" +
+	"\x02P1\x10\x01\x12\x06\n" +
+	"\x02P2\x10\x02\x12\x06\n" +
+	"\x02P3\x10\x03\x12\x06\n" +
+	"\x02P4\x10\x04\x12\x06\n" +
+	"\x02P5\x10\x05*\xe3\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05start\x10\x01\x12\v\n" +
+	"\afailure\x10\x02\x12\v\n" +
+	"\asuccess\x10\x03\x12\v\n" +
+	"\awarning\x10\x04\x12\t\n" +
+	"\x05error\x10\x05\x12\f\n" +
+	"\bsnapshot\x10\x06\x12\x0f\n" +
+	"\vuser_update\x10\a\x12\x12\n" +
+	"\x0erecommandation\x10\b\x12\b\n" +
+	"\x04open\x10\t\x12\t\n" +
+	"\x05close\x10\n" +
+	"\x12\b\n" +
+	"\x04done\x10\v\x12\x0f\n" +
+	"\vin_progress\x10\f\x12\v\n" +
+	"\aplanned\x10\r\x12\x14\n" +
+	"\x10waiting_approval\x10\x0e*\x97\x01\n" +
+	"\vEnvironment\x12\x1b\n" +
+	"\x17ENVIRONMENT_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vdevelopment\x10\x01\x12\x0f\n" +
+	"\vintegration\x10\x02\x12\a\n" +
+	"\x03TNR\x10\x03\x12\a\n" +
+	"\x03UAT\x10\x04\x12\v\n" +
 	"\arecette\x10\x05\x12\x11\n" +
 	"\rpreproduction\x10\x06\x12\x0e\n" +
 	"\n" +
@@ -2584,10 +4614,33 @@ const file_proto_event_v1alpha1_event_proto_rawDesc = "" +
 	"\x06linked\x10\a\x12\n" +
 	"\n" +
 	"\x06locked\x10\b\x12\f\n" +
-	"\bunlocked\x10\t2\xf9\r\n" +
+	"\bunlocked\x10\t*Q\n" +
+	"\n" +
+	"TimeBucket\x12\x1b\n" +
+	"\x17TIME_BUCKET_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04hour\x10\x01\x12\a\n" +
+	"\x03day\x10\x02\x12\b\n" +
+	"\x04week\x10\x03\x12\t\n" +
+	"\x05month\x10\x04*\x8b\x01\n" +
+	"\x12BlockingReasonKind\x12$\n" +
+	" BLOCKING_REASON_KIND_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04lock\x10\x01\x12\x0f\n" +
+	"\vreservation\x10\x02\x12\x15\n" +
+	"\x11in_progress_event\x10\x03\x12\x11\n" +
+	"\rplanned_event\x10\x04\x12\n" +
+	"\n" +
+	"\x06freeze\x10\x05*\x92\x01\n" +
+	"\x0fEventChangeKind\x12!\n" +
+	"\x1dEVENT_CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\revent_created\x10\x01\x12\x11\n" +
+	"\revent_updated\x10\x02\x12\x11\n" +
+	"\revent_deleted\x10\x03\x12\x13\n" +
+	"\x0fchangelog_added\x10\x04\x12\x0e\n" +
+	"\n" +
+	"event_left\x10\x052\xe4\x14\n" +
 	"\fEventService\x12\x86\x01\n" +
-	"\vCreateEvent\x12*.tracker.event.v1alpha1.CreateEventRequest\x1a+.tracker.event.v1alpha1.CreateEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1alpha1/event\x12\x86\x01\n" +
-	"\vUpdateEvent\x12*.tracker.event.v1alpha1.UpdateEventRequest\x1a+.tracker.event.v1alpha1.UpdateEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1alpha1/event\x12\x89\x01\n" +
+	"\vCreateEvent\x12*.tracker.event.v1alpha1.CreateEventRequest\x1a+.tracker.event.v1alpha1.CreateEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1alpha1/event\x12\xa0\x01\n" +
+	"\vUpdateEvent\x12*.tracker.event.v1alpha1.UpdateEventRequest\x1a+.tracker.event.v1alpha1.UpdateEventResponse\"8\x82\xd3\xe4\x93\x022:\x01*Z\x18:\x01*2\x13/api/v1alpha1/event\x1a\x13/api/v1alpha1/event\x12\x89\x01\n" +
 	"\fDeleteEvents\x12*.tracker.event.v1alpha1.DeleteEventRequest\x1a+.tracker.event.v1alpha1.DeleteEventResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1alpha1/event/{id}\x12\x7f\n" +
 	"\bGetEvent\x12'.tracker.event.v1alpha1.GetEventRequest\x1a(.tracker.event.v1alpha1.GetEventResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1alpha1/event/{id}\x12\x8e\x01\n" +
 	"\fSearchEvents\x12+.tracker.event.v1alpha1.SearchEventsRequest\x1a,.tracker.event.v1alpha1.SearchEventsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1alpha1/events/search\x12\x86\x01\n" +
@@ -2595,11 +4648,18 @@ const file_proto_event_v1alpha1_event_proto_rawDesc = "" +
 	"ListEvents\x12).tracker.event.v1alpha1.ListEventsRequest\x1a*.tracker.event.v1alpha1.ListEventsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1alpha1/events/list\x12\x8a\x01\n" +
 	"\vTodayEvents\x12*.tracker.event.v1alpha1.TodayEventsRequest\x1a+.tracker.event.v1alpha1.TodayEventsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1alpha1/events/today\x12\xa7\x01\n" +
 	"\x11AddChangelogEntry\x120.tracker.event.v1alpha1.AddChangelogEntryRequest\x1a1.tracker.event.v1alpha1.AddChangelogEntryResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1alpha1/event/{id}/changelog\x12\xa4\x01\n" +
-	"\x11GetEventChangelog\x120.tracker.event.v1alpha1.GetEventChangelogRequest\x1a1.tracker.event.v1alpha1.GetEventChangelogResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1alpha1/event/{id}/changelog\x12\x8e\x01\n" +
+	"\x11GetEventChangelog\x120.tracker.event.v1alpha1.GetEventChangelogRequest\x1a1.tracker.event.v1alpha1.GetEventChangelogResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1alpha1/event/{id}/changelog\x12\x88\x01\n" +
+	"\n" +
+	"GetEventAt\x12).tracker.event.v1alpha1.GetEventAtRequest\x1a*.tracker.event.v1alpha1.GetEventAtResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1alpha1/event/{id}/at\x12\x8e\x01\n" +
 	"\n" +
 	"AddSlackId\x12).tracker.event.v1alpha1.AddSlackIdRequest\x1a*.tracker.event.v1alpha1.AddSlackIdResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1alpha1/event/{id}/slack\x12\x90\x01\n" +
 	"\rGetEventStats\x12,.tracker.event.v1alpha1.GetEventStatsRequest\x1a-.tracker.event.v1alpha1.GetEventStatsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1alpha1/events/stats\x12\xad\x01\n" +
-	"\x14GetEventStatsByMonth\x123.tracker.event.v1alpha1.GetEventStatsByMonthRequest\x1a4.tracker.event.v1alpha1.GetEventStatsByMonthResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1alpha1/events/stats/monthlyB\x16Z\x14proto/event/v1alpha1b\x06proto3"
+	"\x14GetEventStatsByMonth\x123.tracker.event.v1alpha1.GetEventStatsByMonthRequest\x1a4.tracker.event.v1alpha1.GetEventStatsByMonthResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1alpha1/events/stats/monthly\x12\xa0\x01\n" +
+	"\x0fAggregateEvents\x12..tracker.event.v1alpha1.AggregateEventsRequest\x1a/.tracker.event.v1alpha1.AggregateEventsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1alpha1/events/stats/aggregate\x12\x98\x01\n" +
+	"\x0eGetDoraMetrics\x12-.tracker.event.v1alpha1.GetDoraMetricsRequest\x1a..tracker.event.v1alpha1.GetDoraMetricsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1alpha1/events/stats/dora\x12\x8d\x01\n" +
+	"\vGetOverlaps\x12*.tracker.event.v1alpha1.GetOverlapsRequest\x1a+.tracker.event.v1alpha1.GetOverlapsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1alpha1/events/overlaps\x12\x89\x01\n" +
+	"\tCanDeploy\x12(.tracker.event.v1alpha1.CanDeployRequest\x1a).tracker.event.v1alpha1.CanDeployResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1alpha1/events/can-deploy\x12j\n" +
+	"\vWatchEvents\x12*.tracker.event.v1alpha1.WatchEventsRequest\x1a+.tracker.event.v1alpha1.WatchEventsResponse\"\x000\x01B\x16Z\x14proto/event/v1alpha1b\x06proto3"
 
 var (
 	file_proto_event_v1alpha1_event_proto_rawDescOnce sync.Once
@@ -2613,129 +4673,213 @@ func file_proto_event_v1alpha1_event_proto_rawDescGZIP() []byte {
 	return file_proto_event_v1alpha1_event_proto_rawDescData
 }
 
-var file_proto_event_v1alpha1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_proto_event_v1alpha1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_proto_event_v1alpha1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_event_v1alpha1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_proto_event_v1alpha1_event_proto_goTypes = []any{
 	(Type)(0),                            // 0: tracker.event.v1alpha1.Type
 	(Priority)(0),                        // 1: tracker.event.v1alpha1.Priority
 	(Status)(0),                          // 2: tracker.event.v1alpha1.Status
 	(Environment)(0),                     // 3: tracker.event.v1alpha1.Environment
 	(ChangeType)(0),                      // 4: tracker.event.v1alpha1.ChangeType
-	(*EventAttributes)(nil),              // 5: tracker.event.v1alpha1.EventAttributes
-	(*EventMetadata)(nil),                // 6: tracker.event.v1alpha1.EventMetadata
-	(*EventLinks)(nil),                   // 7: tracker.event.v1alpha1.EventLinks
-	(*ChangelogEntry)(nil),               // 8: tracker.event.v1alpha1.ChangelogEntry
-	(*Event)(nil),                        // 9: tracker.event.v1alpha1.Event
-	(*CreateEventRequest)(nil),           // 10: tracker.event.v1alpha1.CreateEventRequest
-	(*CreateEventResponse)(nil),          // 11: tracker.event.v1alpha1.CreateEventResponse
-	(*GetEventRequest)(nil),              // 12: tracker.event.v1alpha1.GetEventRequest
-	(*GetEventResponse)(nil),             // 13: tracker.event.v1alpha1.GetEventResponse
-	(*SearchEventsRequest)(nil),          // 14: tracker.event.v1alpha1.SearchEventsRequest
-	(*SearchEventsResponse)(nil),         // 15: tracker.event.v1alpha1.SearchEventsResponse
-	(*ListEventsRequest)(nil),            // 16: tracker.event.v1alpha1.ListEventsRequest
-	(*ListEventsResponse)(nil),           // 17: tracker.event.v1alpha1.ListEventsResponse
-	(*TodayEventsRequest)(nil),           // 18: tracker.event.v1alpha1.TodayEventsRequest
-	(*TodayEventsResponse)(nil),          // 19: tracker.event.v1alpha1.TodayEventsResponse
-	(*AddChangelogEntryRequest)(nil),     // 20: tracker.event.v1alpha1.AddChangelogEntryRequest
-	(*AddChangelogEntryResponse)(nil),    // 21: tracker.event.v1alpha1.AddChangelogEntryResponse
-	(*GetEventChangelogRequest)(nil),     // 22: tracker.event.v1alpha1.GetEventChangelogRequest
-	(*GetEventChangelogResponse)(nil),    // 23: tracker.event.v1alpha1.GetEventChangelogResponse
-	(*UpdateEventRequest)(nil),           // 24: tracker.event.v1alpha1.UpdateEventRequest
-	(*UpdateEventResponse)(nil),          // 25: tracker.event.v1alpha1.UpdateEventResponse
-	(*DeleteEventRequest)(nil),           // 26: tracker.event.v1alpha1.DeleteEventRequest
-	(*DeleteEventResponse)(nil),          // 27: tracker.event.v1alpha1.DeleteEventResponse
-	(*AddSlackIdRequest)(nil),            // 28: tracker.event.v1alpha1.AddSlackIdRequest
-	(*AddSlackIdResponse)(nil),           // 29: tracker.event.v1alpha1.AddSlackIdResponse
-	(*GetEventStatsRequest)(nil),         // 30: tracker.event.v1alpha1.GetEventStatsRequest
-	(*GetEventStatsResponse)(nil),        // 31: tracker.event.v1alpha1.GetEventStatsResponse
-	(*GetEventStatsByMonthRequest)(nil),  // 32: tracker.event.v1alpha1.GetEventStatsByMonthRequest
-	(*MonthlyStats)(nil),                 // 33: tracker.event.v1alpha1.MonthlyStats
-	(*GetEventStatsByMonthResponse)(nil), // 34: tracker.event.v1alpha1.GetEventStatsByMonthResponse
-	(*timestamppb.Timestamp)(nil),        // 35: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),          // 36: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil),       // 37: google.protobuf.UInt32Value
-	(*wrapperspb.Int32Value)(nil),        // 38: google.protobuf.Int32Value
-	(*wrapperspb.BoolValue)(nil),         // 39: google.protobuf.BoolValue
+	(TimeBucket)(0),                      // 5: tracker.event.v1alpha1.TimeBucket
+	(BlockingReasonKind)(0),              // 6: tracker.event.v1alpha1.BlockingReasonKind
+	(EventChangeKind)(0),                 // 7: tracker.event.v1alpha1.EventChangeKind
+	(*EventAttributes)(nil),              // 8: tracker.event.v1alpha1.EventAttributes
+	(*EventMetadata)(nil),                // 9: tracker.event.v1alpha1.EventMetadata
+	(*EventLinks)(nil),                   // 10: tracker.event.v1alpha1.EventLinks
+	(*ChangelogEntry)(nil),               // 11: tracker.event.v1alpha1.ChangelogEntry
+	(*Event)(nil),                        // 12: tracker.event.v1alpha1.Event
+	(*CreateEventRequest)(nil),           // 13: tracker.event.v1alpha1.CreateEventRequest
+	(*CreateEventResponse)(nil),          // 14: tracker.event.v1alpha1.CreateEventResponse
+	(*GetEventRequest)(nil),              // 15: tracker.event.v1alpha1.GetEventRequest
+	(*GetEventResponse)(nil),             // 16: tracker.event.v1alpha1.GetEventResponse
+	(*SearchEventsRequest)(nil),          // 17: tracker.event.v1alpha1.SearchEventsRequest
+	(*SearchEventsResponse)(nil),         // 18: tracker.event.v1alpha1.SearchEventsResponse
+	(*SearchHit)(nil),                    // 19: tracker.event.v1alpha1.SearchHit
+	(*Highlight)(nil),                    // 20: tracker.event.v1alpha1.Highlight
+	(*ListEventsRequest)(nil),            // 21: tracker.event.v1alpha1.ListEventsRequest
+	(*ListEventsResponse)(nil),           // 22: tracker.event.v1alpha1.ListEventsResponse
+	(*TodayEventsRequest)(nil),           // 23: tracker.event.v1alpha1.TodayEventsRequest
+	(*TodayEventsResponse)(nil),          // 24: tracker.event.v1alpha1.TodayEventsResponse
+	(*AddChangelogEntryRequest)(nil),     // 25: tracker.event.v1alpha1.AddChangelogEntryRequest
+	(*AddChangelogEntryResponse)(nil),    // 26: tracker.event.v1alpha1.AddChangelogEntryResponse
+	(*GetEventChangelogRequest)(nil),     // 27: tracker.event.v1alpha1.GetEventChangelogRequest
+	(*GetEventChangelogResponse)(nil),    // 28: tracker.event.v1alpha1.GetEventChangelogResponse
+	(*GetEventAtRequest)(nil),            // 29: tracker.event.v1alpha1.GetEventAtRequest
+	(*GetEventAtResponse)(nil),           // 30: tracker.event.v1alpha1.GetEventAtResponse
+	(*UpdateEventRequest)(nil),           // 31: tracker.event.v1alpha1.UpdateEventRequest
+	(*UpdateEventResponse)(nil),          // 32: tracker.event.v1alpha1.UpdateEventResponse
+	(*DeleteEventRequest)(nil),           // 33: tracker.event.v1alpha1.DeleteEventRequest
+	(*DeleteEventResponse)(nil),          // 34: tracker.event.v1alpha1.DeleteEventResponse
+	(*AddSlackIdRequest)(nil),            // 35: tracker.event.v1alpha1.AddSlackIdRequest
+	(*AddSlackIdResponse)(nil),           // 36: tracker.event.v1alpha1.AddSlackIdResponse
+	(*GetEventStatsRequest)(nil),         // 37: tracker.event.v1alpha1.GetEventStatsRequest
+	(*GetEventStatsResponse)(nil),        // 38: tracker.event.v1alpha1.GetEventStatsResponse
+	(*GetEventStatsByMonthRequest)(nil),  // 39: tracker.event.v1alpha1.GetEventStatsByMonthRequest
+	(*MonthlyStats)(nil),                 // 40: tracker.event.v1alpha1.MonthlyStats
+	(*GetEventStatsByMonthResponse)(nil), // 41: tracker.event.v1alpha1.GetEventStatsByMonthResponse
+	(*AggregateEventsRequest)(nil),       // 42: tracker.event.v1alpha1.AggregateEventsRequest
+	(*Aggregation)(nil),                  // 43: tracker.event.v1alpha1.Aggregation
+	(*AggregateEventsResponse)(nil),      // 44: tracker.event.v1alpha1.AggregateEventsResponse
+	(*GetDoraMetricsRequest)(nil),        // 45: tracker.event.v1alpha1.GetDoraMetricsRequest
+	(*DoraMetrics)(nil),                  // 46: tracker.event.v1alpha1.DoraMetrics
+	(*GetDoraMetricsResponse)(nil),       // 47: tracker.event.v1alpha1.GetDoraMetricsResponse
+	(*GetOverlapsRequest)(nil),           // 48: tracker.event.v1alpha1.GetOverlapsRequest
+	(*GetOverlapsResponse)(nil),          // 49: tracker.event.v1alpha1.GetOverlapsResponse
+	(*Overlap)(nil),                      // 50: tracker.event.v1alpha1.Overlap
+	(*CanDeployRequest)(nil),             // 51: tracker.event.v1alpha1.CanDeployRequest
+	(*CanDeployResponse)(nil),            // 52: tracker.event.v1alpha1.CanDeployResponse
+	(*BlockingReason)(nil),               // 53: tracker.event.v1alpha1.BlockingReason
+	(*WatchEventsRequest)(nil),           // 54: tracker.event.v1alpha1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),          // 55: tracker.event.v1alpha1.WatchEventsResponse
+	nil,                                  // 56: tracker.event.v1alpha1.Aggregation.GroupEntry
+	(*timestamppb.Timestamp)(nil),        // 57: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 58: google.protobuf.Duration
+	(*v1alpha1.Lock)(nil),                // 59: tracker.lock.v1alpha1.Lock
+	(*wrapperspb.UInt32Value)(nil),       // 60: google.protobuf.UInt32Value
+	(*wrapperspb.Int32Value)(nil),        // 61: google.protobuf.Int32Value
+	(*fieldmaskpb.FieldMask)(nil),        // 62: google.protobuf.FieldMask
+	(*wrapperspb.BoolValue)(nil),         // 63: google.protobuf.BoolValue
 }
 var file_proto_event_v1alpha1_event_proto_depIdxs = []int32{
-	0,  // 0: tracker.event.v1alpha1.EventAttributes.type:type_name -> tracker.event.v1alpha1.Type
-	1,  // 1: tracker.event.v1alpha1.EventAttributes.priority:type_name -> tracker.event.v1alpha1.Priority
-	2,  // 2: tracker.event.v1alpha1.EventAttributes.status:type_name -> tracker.event.v1alpha1.Status
-	3,  // 3: tracker.event.v1alpha1.EventAttributes.environment:type_name -> tracker.event.v1alpha1.Environment
-	35, // 4: tracker.event.v1alpha1.EventAttributes.start_date:type_name -> google.protobuf.Timestamp
-	35, // 5: tracker.event.v1alpha1.EventAttributes.end_date:type_name -> google.protobuf.Timestamp
-	35, // 6: tracker.event.v1alpha1.EventMetadata.created_at:type_name -> google.protobuf.Timestamp
-	36, // 7: tracker.event.v1alpha1.EventMetadata.duration:type_name -> google.protobuf.Duration
-	35, // 8: tracker.event.v1alpha1.ChangelogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	4,  // 9: tracker.event.v1alpha1.ChangelogEntry.change_type:type_name -> tracker.event.v1alpha1.ChangeType
-	5,  // 10: tracker.event.v1alpha1.Event.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
-	7,  // 11: tracker.event.v1alpha1.Event.links:type_name -> tracker.event.v1alpha1.EventLinks
-	6,  // 12: tracker.event.v1alpha1.Event.metadata:type_name -> tracker.event.v1alpha1.EventMetadata
-	8,  // 13: tracker.event.v1alpha1.Event.changelog:type_name -> tracker.event.v1alpha1.ChangelogEntry
-	5,  // 14: tracker.event.v1alpha1.CreateEventRequest.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
-	7,  // 15: tracker.event.v1alpha1.CreateEventRequest.links:type_name -> tracker.event.v1alpha1.EventLinks
-	9,  // 16: tracker.event.v1alpha1.CreateEventResponse.event:type_name -> tracker.event.v1alpha1.Event
-	9,  // 17: tracker.event.v1alpha1.GetEventResponse.event:type_name -> tracker.event.v1alpha1.Event
-	0,  // 18: tracker.event.v1alpha1.SearchEventsRequest.type:type_name -> tracker.event.v1alpha1.Type
-	1,  // 19: tracker.event.v1alpha1.SearchEventsRequest.priority:type_name -> tracker.event.v1alpha1.Priority
-	2,  // 20: tracker.event.v1alpha1.SearchEventsRequest.status:type_name -> tracker.event.v1alpha1.Status
-	3,  // 21: tracker.event.v1alpha1.SearchEventsRequest.environment:type_name -> tracker.event.v1alpha1.Environment
-	9,  // 22: tracker.event.v1alpha1.SearchEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
-	37, // 23: tracker.event.v1alpha1.ListEventsRequest.per_page:type_name -> google.protobuf.UInt32Value
-	38, // 24: tracker.event.v1alpha1.ListEventsRequest.page:type_name -> google.protobuf.Int32Value
-	9,  // 25: tracker.event.v1alpha1.ListEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
-	37, // 26: tracker.event.v1alpha1.TodayEventsRequest.per_page:type_name -> google.protobuf.UInt32Value
-	38, // 27: tracker.event.v1alpha1.TodayEventsRequest.page:type_name -> google.protobuf.Int32Value
-	9,  // 28: tracker.event.v1alpha1.TodayEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
-	8,  // 29: tracker.event.v1alpha1.AddChangelogEntryRequest.entry:type_name -> tracker.event.v1alpha1.ChangelogEntry
-	9,  // 30: tracker.event.v1alpha1.AddChangelogEntryResponse.event:type_name -> tracker.event.v1alpha1.Event
-	37, // 31: tracker.event.v1alpha1.GetEventChangelogRequest.per_page:type_name -> google.protobuf.UInt32Value
-	38, // 32: tracker.event.v1alpha1.GetEventChangelogRequest.page:type_name -> google.protobuf.Int32Value
-	8,  // 33: tracker.event.v1alpha1.GetEventChangelogResponse.changelog:type_name -> tracker.event.v1alpha1.ChangelogEntry
-	5,  // 34: tracker.event.v1alpha1.UpdateEventRequest.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
-	7,  // 35: tracker.event.v1alpha1.UpdateEventRequest.links:type_name -> tracker.event.v1alpha1.EventLinks
-	9,  // 36: tracker.event.v1alpha1.UpdateEventResponse.event:type_name -> tracker.event.v1alpha1.Event
-	9,  // 37: tracker.event.v1alpha1.AddSlackIdResponse.event:type_name -> tracker.event.v1alpha1.Event
-	3,  // 38: tracker.event.v1alpha1.GetEventStatsRequest.environments:type_name -> tracker.event.v1alpha1.Environment
-	39, // 39: tracker.event.v1alpha1.GetEventStatsRequest.impact:type_name -> google.protobuf.BoolValue
-	1,  // 40: tracker.event.v1alpha1.GetEventStatsRequest.priorities:type_name -> tracker.event.v1alpha1.Priority
-	0,  // 41: tracker.event.v1alpha1.GetEventStatsRequest.types:type_name -> tracker.event.v1alpha1.Type
-	2,  // 42: tracker.event.v1alpha1.GetEventStatsRequest.statuses:type_name -> tracker.event.v1alpha1.Status
-	3,  // 43: tracker.event.v1alpha1.GetEventStatsByMonthRequest.environments:type_name -> tracker.event.v1alpha1.Environment
-	39, // 44: tracker.event.v1alpha1.GetEventStatsByMonthRequest.impact:type_name -> google.protobuf.BoolValue
-	1,  // 45: tracker.event.v1alpha1.GetEventStatsByMonthRequest.priorities:type_name -> tracker.event.v1alpha1.Priority
-	0,  // 46: tracker.event.v1alpha1.GetEventStatsByMonthRequest.types:type_name -> tracker.event.v1alpha1.Type
-	2,  // 47: tracker.event.v1alpha1.GetEventStatsByMonthRequest.statuses:type_name -> tracker.event.v1alpha1.Status
-	33, // 48: tracker.event.v1alpha1.GetEventStatsByMonthResponse.stats:type_name -> tracker.event.v1alpha1.MonthlyStats
-	10, // 49: tracker.event.v1alpha1.EventService.CreateEvent:input_type -> tracker.event.v1alpha1.CreateEventRequest
-	24, // 50: tracker.event.v1alpha1.EventService.UpdateEvent:input_type -> tracker.event.v1alpha1.UpdateEventRequest
-	26, // 51: tracker.event.v1alpha1.EventService.DeleteEvents:input_type -> tracker.event.v1alpha1.DeleteEventRequest
-	12, // 52: tracker.event.v1alpha1.EventService.GetEvent:input_type -> tracker.event.v1alpha1.GetEventRequest
-	14, // 53: tracker.event.v1alpha1.EventService.SearchEvents:input_type -> tracker.event.v1alpha1.SearchEventsRequest
-	16, // 54: tracker.event.v1alpha1.EventService.ListEvents:input_type -> tracker.event.v1alpha1.ListEventsRequest
-	18, // 55: tracker.event.v1alpha1.EventService.TodayEvents:input_type -> tracker.event.v1alpha1.TodayEventsRequest
-	20, // 56: tracker.event.v1alpha1.EventService.AddChangelogEntry:input_type -> tracker.event.v1alpha1.AddChangelogEntryRequest
-	22, // 57: tracker.event.v1alpha1.EventService.GetEventChangelog:input_type -> tracker.event.v1alpha1.GetEventChangelogRequest
-	28, // 58: tracker.event.v1alpha1.EventService.AddSlackId:input_type -> tracker.event.v1alpha1.AddSlackIdRequest
-	30, // 59: tracker.event.v1alpha1.EventService.GetEventStats:input_type -> tracker.event.v1alpha1.GetEventStatsRequest
-	32, // 60: tracker.event.v1alpha1.EventService.GetEventStatsByMonth:input_type -> tracker.event.v1alpha1.GetEventStatsByMonthRequest
-	11, // 61: tracker.event.v1alpha1.EventService.CreateEvent:output_type -> tracker.event.v1alpha1.CreateEventResponse
-	25, // 62: tracker.event.v1alpha1.EventService.UpdateEvent:output_type -> tracker.event.v1alpha1.UpdateEventResponse
-	27, // 63: tracker.event.v1alpha1.EventService.DeleteEvents:output_type -> tracker.event.v1alpha1.DeleteEventResponse
-	13, // 64: tracker.event.v1alpha1.EventService.GetEvent:output_type -> tracker.event.v1alpha1.GetEventResponse
-	15, // 65: tracker.event.v1alpha1.EventService.SearchEvents:output_type -> tracker.event.v1alpha1.SearchEventsResponse
-	17, // 66: tracker.event.v1alpha1.EventService.ListEvents:output_type -> tracker.event.v1alpha1.ListEventsResponse
-	19, // 67: tracker.event.v1alpha1.EventService.TodayEvents:output_type -> tracker.event.v1alpha1.TodayEventsResponse
-	21, // 68: tracker.event.v1alpha1.EventService.AddChangelogEntry:output_type -> tracker.event.v1alpha1.AddChangelogEntryResponse
-	23, // 69: tracker.event.v1alpha1.EventService.GetEventChangelog:output_type -> tracker.event.v1alpha1.GetEventChangelogResponse
-	29, // 70: tracker.event.v1alpha1.EventService.AddSlackId:output_type -> tracker.event.v1alpha1.AddSlackIdResponse
-	31, // 71: tracker.event.v1alpha1.EventService.GetEventStats:output_type -> tracker.event.v1alpha1.GetEventStatsResponse
-	34, // 72: tracker.event.v1alpha1.EventService.GetEventStatsByMonth:output_type -> tracker.event.v1alpha1.GetEventStatsByMonthResponse
-	61, // [61:73] is the sub-list for method output_type
-	49, // [49:61] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	0,   // 0: tracker.event.v1alpha1.EventAttributes.type:type_name -> tracker.event.v1alpha1.Type
+	1,   // 1: tracker.event.v1alpha1.EventAttributes.priority:type_name -> tracker.event.v1alpha1.Priority
+	2,   // 2: tracker.event.v1alpha1.EventAttributes.status:type_name -> tracker.event.v1alpha1.Status
+	3,   // 3: tracker.event.v1alpha1.EventAttributes.environment:type_name -> tracker.event.v1alpha1.Environment
+	57,  // 4: tracker.event.v1alpha1.EventAttributes.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 5: tracker.event.v1alpha1.EventAttributes.end_date:type_name -> google.protobuf.Timestamp
+	57,  // 6: tracker.event.v1alpha1.EventMetadata.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 7: tracker.event.v1alpha1.EventMetadata.duration:type_name -> google.protobuf.Duration
+	57,  // 8: tracker.event.v1alpha1.ChangelogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	4,   // 9: tracker.event.v1alpha1.ChangelogEntry.change_type:type_name -> tracker.event.v1alpha1.ChangeType
+	8,   // 10: tracker.event.v1alpha1.Event.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
+	10,  // 11: tracker.event.v1alpha1.Event.links:type_name -> tracker.event.v1alpha1.EventLinks
+	9,   // 12: tracker.event.v1alpha1.Event.metadata:type_name -> tracker.event.v1alpha1.EventMetadata
+	11,  // 13: tracker.event.v1alpha1.Event.changelog:type_name -> tracker.event.v1alpha1.ChangelogEntry
+	8,   // 14: tracker.event.v1alpha1.CreateEventRequest.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
+	10,  // 15: tracker.event.v1alpha1.CreateEventRequest.links:type_name -> tracker.event.v1alpha1.EventLinks
+	58,  // 16: tracker.event.v1alpha1.CreateEventRequest.lock_ttl:type_name -> google.protobuf.Duration
+	12,  // 17: tracker.event.v1alpha1.CreateEventResponse.event:type_name -> tracker.event.v1alpha1.Event
+	59,  // 18: tracker.event.v1alpha1.CreateEventResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	12,  // 19: tracker.event.v1alpha1.GetEventResponse.event:type_name -> tracker.event.v1alpha1.Event
+	0,   // 20: tracker.event.v1alpha1.SearchEventsRequest.type:type_name -> tracker.event.v1alpha1.Type
+	1,   // 21: tracker.event.v1alpha1.SearchEventsRequest.priority:type_name -> tracker.event.v1alpha1.Priority
+	2,   // 22: tracker.event.v1alpha1.SearchEventsRequest.status:type_name -> tracker.event.v1alpha1.Status
+	3,   // 23: tracker.event.v1alpha1.SearchEventsRequest.environment:type_name -> tracker.event.v1alpha1.Environment
+	60,  // 24: tracker.event.v1alpha1.SearchEventsRequest.per_page:type_name -> google.protobuf.UInt32Value
+	61,  // 25: tracker.event.v1alpha1.SearchEventsRequest.page:type_name -> google.protobuf.Int32Value
+	12,  // 26: tracker.event.v1alpha1.SearchEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
+	19,  // 27: tracker.event.v1alpha1.SearchEventsResponse.hits:type_name -> tracker.event.v1alpha1.SearchHit
+	20,  // 28: tracker.event.v1alpha1.SearchHit.highlights:type_name -> tracker.event.v1alpha1.Highlight
+	60,  // 29: tracker.event.v1alpha1.ListEventsRequest.per_page:type_name -> google.protobuf.UInt32Value
+	61,  // 30: tracker.event.v1alpha1.ListEventsRequest.page:type_name -> google.protobuf.Int32Value
+	12,  // 31: tracker.event.v1alpha1.ListEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
+	60,  // 32: tracker.event.v1alpha1.TodayEventsRequest.per_page:type_name -> google.protobuf.UInt32Value
+	61,  // 33: tracker.event.v1alpha1.TodayEventsRequest.page:type_name -> google.protobuf.Int32Value
+	12,  // 34: tracker.event.v1alpha1.TodayEventsResponse.events:type_name -> tracker.event.v1alpha1.Event
+	11,  // 35: tracker.event.v1alpha1.AddChangelogEntryRequest.entry:type_name -> tracker.event.v1alpha1.ChangelogEntry
+	12,  // 36: tracker.event.v1alpha1.AddChangelogEntryResponse.event:type_name -> tracker.event.v1alpha1.Event
+	60,  // 37: tracker.event.v1alpha1.GetEventChangelogRequest.per_page:type_name -> google.protobuf.UInt32Value
+	61,  // 38: tracker.event.v1alpha1.GetEventChangelogRequest.page:type_name -> google.protobuf.Int32Value
+	11,  // 39: tracker.event.v1alpha1.GetEventChangelogResponse.changelog:type_name -> tracker.event.v1alpha1.ChangelogEntry
+	57,  // 40: tracker.event.v1alpha1.GetEventAtRequest.at:type_name -> google.protobuf.Timestamp
+	12,  // 41: tracker.event.v1alpha1.GetEventAtResponse.event:type_name -> tracker.event.v1alpha1.Event
+	8,   // 42: tracker.event.v1alpha1.UpdateEventRequest.attributes:type_name -> tracker.event.v1alpha1.EventAttributes
+	10,  // 43: tracker.event.v1alpha1.UpdateEventRequest.links:type_name -> tracker.event.v1alpha1.EventLinks
+	62,  // 44: tracker.event.v1alpha1.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12,  // 45: tracker.event.v1alpha1.UpdateEventResponse.event:type_name -> tracker.event.v1alpha1.Event
+	12,  // 46: tracker.event.v1alpha1.AddSlackIdResponse.event:type_name -> tracker.event.v1alpha1.Event
+	3,   // 47: tracker.event.v1alpha1.GetEventStatsRequest.environments:type_name -> tracker.event.v1alpha1.Environment
+	63,  // 48: tracker.event.v1alpha1.GetEventStatsRequest.impact:type_name -> google.protobuf.BoolValue
+	1,   // 49: tracker.event.v1alpha1.GetEventStatsRequest.priorities:type_name -> tracker.event.v1alpha1.Priority
+	0,   // 50: tracker.event.v1alpha1.GetEventStatsRequest.types:type_name -> tracker.event.v1alpha1.Type
+	2,   // 51: tracker.event.v1alpha1.GetEventStatsRequest.statuses:type_name -> tracker.event.v1alpha1.Status
+	3,   // 52: tracker.event.v1alpha1.GetEventStatsByMonthRequest.environments:type_name -> tracker.event.v1alpha1.Environment
+	63,  // 53: tracker.event.v1alpha1.GetEventStatsByMonthRequest.impact:type_name -> google.protobuf.BoolValue
+	1,   // 54: tracker.event.v1alpha1.GetEventStatsByMonthRequest.priorities:type_name -> tracker.event.v1alpha1.Priority
+	0,   // 55: tracker.event.v1alpha1.GetEventStatsByMonthRequest.types:type_name -> tracker.event.v1alpha1.Type
+	2,   // 56: tracker.event.v1alpha1.GetEventStatsByMonthRequest.statuses:type_name -> tracker.event.v1alpha1.Status
+	40,  // 57: tracker.event.v1alpha1.GetEventStatsByMonthResponse.stats:type_name -> tracker.event.v1alpha1.MonthlyStats
+	3,   // 58: tracker.event.v1alpha1.AggregateEventsRequest.environments:type_name -> tracker.event.v1alpha1.Environment
+	63,  // 59: tracker.event.v1alpha1.AggregateEventsRequest.impact:type_name -> google.protobuf.BoolValue
+	1,   // 60: tracker.event.v1alpha1.AggregateEventsRequest.priorities:type_name -> tracker.event.v1alpha1.Priority
+	0,   // 61: tracker.event.v1alpha1.AggregateEventsRequest.types:type_name -> tracker.event.v1alpha1.Type
+	2,   // 62: tracker.event.v1alpha1.AggregateEventsRequest.statuses:type_name -> tracker.event.v1alpha1.Status
+	5,   // 63: tracker.event.v1alpha1.AggregateEventsRequest.bucket:type_name -> tracker.event.v1alpha1.TimeBucket
+	57,  // 64: tracker.event.v1alpha1.Aggregation.bucket:type_name -> google.protobuf.Timestamp
+	56,  // 65: tracker.event.v1alpha1.Aggregation.group:type_name -> tracker.event.v1alpha1.Aggregation.GroupEntry
+	58,  // 66: tracker.event.v1alpha1.Aggregation.duration_p50:type_name -> google.protobuf.Duration
+	58,  // 67: tracker.event.v1alpha1.Aggregation.duration_p90:type_name -> google.protobuf.Duration
+	58,  // 68: tracker.event.v1alpha1.Aggregation.duration_p99:type_name -> google.protobuf.Duration
+	43,  // 69: tracker.event.v1alpha1.AggregateEventsResponse.aggregations:type_name -> tracker.event.v1alpha1.Aggregation
+	3,   // 70: tracker.event.v1alpha1.GetDoraMetricsRequest.environments:type_name -> tracker.event.v1alpha1.Environment
+	3,   // 71: tracker.event.v1alpha1.DoraMetrics.environment:type_name -> tracker.event.v1alpha1.Environment
+	58,  // 72: tracker.event.v1alpha1.DoraMetrics.lead_time:type_name -> google.protobuf.Duration
+	58,  // 73: tracker.event.v1alpha1.DoraMetrics.time_to_restore:type_name -> google.protobuf.Duration
+	46,  // 74: tracker.event.v1alpha1.GetDoraMetricsResponse.metrics:type_name -> tracker.event.v1alpha1.DoraMetrics
+	3,   // 75: tracker.event.v1alpha1.GetOverlapsRequest.environments:type_name -> tracker.event.v1alpha1.Environment
+	50,  // 76: tracker.event.v1alpha1.GetOverlapsResponse.overlaps:type_name -> tracker.event.v1alpha1.Overlap
+	12,  // 77: tracker.event.v1alpha1.Overlap.first:type_name -> tracker.event.v1alpha1.Event
+	12,  // 78: tracker.event.v1alpha1.Overlap.second:type_name -> tracker.event.v1alpha1.Event
+	57,  // 79: tracker.event.v1alpha1.Overlap.start:type_name -> google.protobuf.Timestamp
+	57,  // 80: tracker.event.v1alpha1.Overlap.end:type_name -> google.protobuf.Timestamp
+	58,  // 81: tracker.event.v1alpha1.Overlap.duration:type_name -> google.protobuf.Duration
+	3,   // 82: tracker.event.v1alpha1.CanDeployRequest.environment:type_name -> tracker.event.v1alpha1.Environment
+	0,   // 83: tracker.event.v1alpha1.CanDeployRequest.type:type_name -> tracker.event.v1alpha1.Type
+	53,  // 84: tracker.event.v1alpha1.CanDeployResponse.reasons:type_name -> tracker.event.v1alpha1.BlockingReason
+	6,   // 85: tracker.event.v1alpha1.BlockingReason.kind:type_name -> tracker.event.v1alpha1.BlockingReasonKind
+	57,  // 86: tracker.event.v1alpha1.BlockingReason.until:type_name -> google.protobuf.Timestamp
+	0,   // 87: tracker.event.v1alpha1.WatchEventsRequest.type:type_name -> tracker.event.v1alpha1.Type
+	1,   // 88: tracker.event.v1alpha1.WatchEventsRequest.priority:type_name -> tracker.event.v1alpha1.Priority
+	2,   // 89: tracker.event.v1alpha1.WatchEventsRequest.status:type_name -> tracker.event.v1alpha1.Status
+	3,   // 90: tracker.event.v1alpha1.WatchEventsRequest.environment:type_name -> tracker.event.v1alpha1.Environment
+	63,  // 91: tracker.event.v1alpha1.WatchEventsRequest.impact:type_name -> google.protobuf.BoolValue
+	7,   // 92: tracker.event.v1alpha1.WatchEventsResponse.kind:type_name -> tracker.event.v1alpha1.EventChangeKind
+	12,  // 93: tracker.event.v1alpha1.WatchEventsResponse.event:type_name -> tracker.event.v1alpha1.Event
+	11,  // 94: tracker.event.v1alpha1.WatchEventsResponse.entry:type_name -> tracker.event.v1alpha1.ChangelogEntry
+	57,  // 95: tracker.event.v1alpha1.WatchEventsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	8,   // 96: tracker.event.v1alpha1.WatchEventsResponse.previous_attributes:type_name -> tracker.event.v1alpha1.EventAttributes
+	13,  // 97: tracker.event.v1alpha1.EventService.CreateEvent:input_type -> tracker.event.v1alpha1.CreateEventRequest
+	31,  // 98: tracker.event.v1alpha1.EventService.UpdateEvent:input_type -> tracker.event.v1alpha1.UpdateEventRequest
+	33,  // 99: tracker.event.v1alpha1.EventService.DeleteEvents:input_type -> tracker.event.v1alpha1.DeleteEventRequest
+	15,  // 100: tracker.event.v1alpha1.EventService.GetEvent:input_type -> tracker.event.v1alpha1.GetEventRequest
+	17,  // 101: tracker.event.v1alpha1.EventService.SearchEvents:input_type -> tracker.event.v1alpha1.SearchEventsRequest
+	21,  // 102: tracker.event.v1alpha1.EventService.ListEvents:input_type -> tracker.event.v1alpha1.ListEventsRequest
+	23,  // 103: tracker.event.v1alpha1.EventService.TodayEvents:input_type -> tracker.event.v1alpha1.TodayEventsRequest
+	25,  // 104: tracker.event.v1alpha1.EventService.AddChangelogEntry:input_type -> tracker.event.v1alpha1.AddChangelogEntryRequest
+	27,  // 105: tracker.event.v1alpha1.EventService.GetEventChangelog:input_type -> tracker.event.v1alpha1.GetEventChangelogRequest
+	29,  // 106: tracker.event.v1alpha1.EventService.GetEventAt:input_type -> tracker.event.v1alpha1.GetEventAtRequest
+	35,  // 107: tracker.event.v1alpha1.EventService.AddSlackId:input_type -> tracker.event.v1alpha1.AddSlackIdRequest
+	37,  // 108: tracker.event.v1alpha1.EventService.GetEventStats:input_type -> tracker.event.v1alpha1.GetEventStatsRequest
+	39,  // 109: tracker.event.v1alpha1.EventService.GetEventStatsByMonth:input_type -> tracker.event.v1alpha1.GetEventStatsByMonthRequest
+	42,  // 110: tracker.event.v1alpha1.EventService.AggregateEvents:input_type -> tracker.event.v1alpha1.AggregateEventsRequest
+	45,  // 111: tracker.event.v1alpha1.EventService.GetDoraMetrics:input_type -> tracker.event.v1alpha1.GetDoraMetricsRequest
+	48,  // 112: tracker.event.v1alpha1.EventService.GetOverlaps:input_type -> tracker.event.v1alpha1.GetOverlapsRequest
+	51,  // 113: tracker.event.v1alpha1.EventService.CanDeploy:input_type -> tracker.event.v1alpha1.CanDeployRequest
+	54,  // 114: tracker.event.v1alpha1.EventService.WatchEvents:input_type -> tracker.event.v1alpha1.WatchEventsRequest
+	14,  // 115: tracker.event.v1alpha1.EventService.CreateEvent:output_type -> tracker.event.v1alpha1.CreateEventResponse
+	32,  // 116: tracker.event.v1alpha1.EventService.UpdateEvent:output_type -> tracker.event.v1alpha1.UpdateEventResponse
+	34,  // 117: tracker.event.v1alpha1.EventService.DeleteEvents:output_type -> tracker.event.v1alpha1.DeleteEventResponse
+	16,  // 118: tracker.event.v1alpha1.EventService.GetEvent:output_type -> tracker.event.v1alpha1.GetEventResponse
+	18,  // 119: tracker.event.v1alpha1.EventService.SearchEvents:output_type -> tracker.event.v1alpha1.SearchEventsResponse
+	22,  // 120: tracker.event.v1alpha1.EventService.ListEvents:output_type -> tracker.event.v1alpha1.ListEventsResponse
+	24,  // 121: tracker.event.v1alpha1.EventService.TodayEvents:output_type -> tracker.event.v1alpha1.TodayEventsResponse
+	26,  // 122: tracker.event.v1alpha1.EventService.AddChangelogEntry:output_type -> tracker.event.v1alpha1.AddChangelogEntryResponse
+	28,  // 123: tracker.event.v1alpha1.EventService.GetEventChangelog:output_type -> tracker.event.v1alpha1.GetEventChangelogResponse
+	30,  // 124: tracker.event.v1alpha1.EventService.GetEventAt:output_type -> tracker.event.v1alpha1.GetEventAtResponse
+	36,  // 125: tracker.event.v1alpha1.EventService.AddSlackId:output_type -> tracker.event.v1alpha1.AddSlackIdResponse
+	38,  // 126: tracker.event.v1alpha1.EventService.GetEventStats:output_type -> tracker.event.v1alpha1.GetEventStatsResponse
+	41,  // 127: tracker.event.v1alpha1.EventService.GetEventStatsByMonth:output_type -> tracker.event.v1alpha1.GetEventStatsByMonthResponse
+	44,  // 128: tracker.event.v1alpha1.EventService.AggregateEvents:output_type -> tracker.event.v1alpha1.AggregateEventsResponse
+	47,  // 129: tracker.event.v1alpha1.EventService.GetDoraMetrics:output_type -> tracker.event.v1alpha1.GetDoraMetricsResponse
+	49,  // 130: tracker.event.v1alpha1.EventService.GetOverlaps:output_type -> tracker.event.v1alpha1.GetOverlapsResponse
+	52,  // 131: tracker.event.v1alpha1.EventService.CanDeploy:output_type -> tracker.event.v1alpha1.CanDeployResponse
+	55,  // 132: tracker.event.v1alpha1.EventService.WatchEvents:output_type -> tracker.event.v1alpha1.WatchEventsResponse
+	115, // [115:133] is the sub-list for method output_type
+	97,  // [97:115] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
 }
 
 func init() { file_proto_event_v1alpha1_event_proto_init() }
@@ -2748,8 +4892,8 @@ func file_proto_event_v1alpha1_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_event_v1alpha1_event_proto_rawDesc), len(file_proto_event_v1alpha1_event_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   30,
+			NumEnums:      8,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
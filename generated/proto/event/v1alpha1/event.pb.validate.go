@@ -150,6 +150,8 @@ func (m *EventAttributes) validate(all bool) error {
 
 	// no validation rules for Notification
 
+	// no validation rules for ReleaseId
+
 	if len(errors) > 0 {
 		return EventAttributesMultiError(errors)
 	}
@@ -330,6 +332,12 @@ func (m *EventMetadata) validate(all bool) error {
 
 	// no validation rules for SlackId
 
+	// no validation rules for Revision
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for RequestHash
+
 	if len(errors) > 0 {
 		return EventMetadataMultiError(errors)
 	}
@@ -965,6 +973,49 @@ func (m *CreateEventRequest) validate(all bool) error {
 
 	// no validation rules for SlackId
 
+	if d := m.GetLockTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateEventRequestValidationError{
+				field:  "LockTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateEventRequestValidationError{
+					field:  "LockTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for FreezeExceptionId
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 255 {
+		err := CreateEventRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateEventRequestMultiError(errors)
 	}
@@ -1096,6 +1147,35 @@ func (m *CreateEventResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetLock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEventResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEventResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEventResponseValidationError{
+				field:  "Lock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateEventResponseMultiError(errors)
 	}
@@ -1449,6 +1529,72 @@ func (m *SearchEventsRequest) validate(all bool) error {
 
 	// no validation rules for SlackId
 
+	if all {
+		switch v := interface{}(m.GetPerPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchEventsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchEventsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchEventsRequestValidationError{
+				field:  "PerPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchEventsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchEventsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchEventsRequestValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sort
+
+	// no validation rules for PageToken
+
+	// no validation rules for Q
+
+	// no validation rules for Query
+
 	if len(errors) > 0 {
 		return SearchEventsRequestMultiError(errors)
 	}
@@ -1587,6 +1733,42 @@ func (m *SearchEventsResponse) validate(all bool) error {
 
 	// no validation rules for TotalCount
 
+	// no validation rules for NextPageToken
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchEventsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchEventsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchEventsResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SearchEventsResponseMultiError(errors)
 	}
@@ -1667,100 +1849,79 @@ var _ interface {
 	ErrorName() string
 } = SearchEventsResponseValidationError{}
 
-// Validate checks the field values on ListEventsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsRequest) Validate() error {
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListEventsRequestMultiError, or nil if none found.
-func (m *ListEventsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsRequest) validate(all bool) error {
+func (m *SearchHit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPerPage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "PerPage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "PerPage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "PerPage",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for EventId
 
-	if all {
-		switch v := interface{}(m.GetPage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Page",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Page",
+				return SearchHitValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "Page",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return ListEventsRequestMultiError(errors)
+		return SearchHitMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListEventsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsRequestMultiError []error
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsRequestMultiError) Error() string {
+func (m SearchHitMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1769,11 +1930,11 @@ func (m ListEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+func (m SearchHitMultiError) AllErrors() []error { return m }
 
-// ListEventsRequestValidationError is the validation error returned by
-// ListEventsRequest.Validate if the designated constraints aren't met.
-type ListEventsRequestValidationError struct {
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1781,24 +1942,22 @@ type ListEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsRequestValidationError) Field() string { return e.field }
+func (e SearchHitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+func (e SearchHitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+func (e SearchHitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsRequestValidationError) Key() bool { return e.key }
+func (e SearchHitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsRequestValidationError) ErrorName() string {
-	return "ListEventsRequestValidationError"
-}
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListEventsRequestValidationError) Error() string {
+func (e SearchHitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1810,14 +1969,14 @@ func (e ListEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsRequest.%s: %s%s",
+		"invalid %sSearchHit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsRequestValidationError{}
+var _ error = SearchHitValidationError{}
 
 var _ interface {
 	Field() string
@@ -1825,35 +1984,302 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsRequestValidationError{}
+} = SearchHitValidationError{}
 
-// Validate checks the field values on ListEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsResponse) Validate() error {
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListEventsResponseMultiError, or nil if none found.
-func (m *ListEventsResponse) ValidateAll() error {
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsResponse) validate(all bool) error {
+func (m *Highlight) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
-		_, _ = idx, item
+	// no validation rules for Field
 
-		if all {
-			switch v := interface{}(item).(type) {
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPerPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "PerPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sort
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListEventsResponseValidationError{
@@ -1885,6 +2311,8 @@ func (m *ListEventsResponse) validate(all bool) error {
 
 	// no validation rules for TotalCount
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListEventsResponseMultiError(errors)
 	}
@@ -2863,10 +3291,294 @@ var _ interface {
 	ErrorName() string
 } = GetEventChangelogResponseValidationError{}
 
-// Validate checks the field values on UpdateEventRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateEventRequest) Validate() error {
+// Validate checks the field values on GetEventAtRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetEventAtRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventAtRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventAtRequestMultiError, or nil if none found.
+func (m *GetEventAtRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventAtRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetEventAtRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEventAtRequestValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEventAtRequestValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEventAtRequestValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetEventAtRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetEventAtRequest) _validateUuid(uuid string) error {
+	if matched := _event_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetEventAtRequestMultiError is an error wrapping multiple validation errors
+// returned by GetEventAtRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetEventAtRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEventAtRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEventAtRequestMultiError) AllErrors() []error { return m }
+
+// GetEventAtRequestValidationError is the validation error returned by
+// GetEventAtRequest.Validate if the designated constraints aren't met.
+type GetEventAtRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventAtRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventAtRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventAtRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventAtRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventAtRequestValidationError) ErrorName() string {
+	return "GetEventAtRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEventAtRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventAtRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventAtRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventAtRequestValidationError{}
+
+// Validate checks the field values on GetEventAtResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEventAtResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventAtResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventAtResponseMultiError, or nil if none found.
+func (m *GetEventAtResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventAtResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEventAtResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEventAtResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEventAtResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SkippedEntries
+
+	if len(errors) > 0 {
+		return GetEventAtResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEventAtResponseMultiError is an error wrapping multiple validation errors
+// returned by GetEventAtResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetEventAtResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEventAtResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEventAtResponseMultiError) AllErrors() []error { return m }
+
+// GetEventAtResponseValidationError is the validation error returned by
+// GetEventAtResponse.Validate if the designated constraints aren't met.
+type GetEventAtResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventAtResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventAtResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventAtResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventAtResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventAtResponseValidationError) ErrorName() string {
+	return "GetEventAtResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEventAtResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventAtResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventAtResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventAtResponseValidationError{}
+
+// Validate checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventRequest) Validate() error {
 	return m.validate(false)
 }
 
@@ -2949,6 +3661,37 @@ func (m *UpdateEventRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return UpdateEventRequestMultiError(errors)
 	}
@@ -3704,6 +4447,8 @@ func (m *GetEventStatsRequest) validate(all bool) error {
 
 	// no validation rules for Service
 
+	// no validation rules for Query
+
 	if len(errors) > 0 {
 		return GetEventStatsRequestMultiError(errors)
 	}
@@ -3971,6 +4716,8 @@ func (m *GetEventStatsByMonthRequest) validate(all bool) error {
 
 	// no validation rules for GroupByService
 
+	// no validation rules for Query
+
 	if len(errors) > 0 {
 		return GetEventStatsByMonthRequestMultiError(errors)
 	}
@@ -4301,3 +5048,2278 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetEventStatsByMonthResponseValidationError{}
+
+// Validate checks the field values on AggregateEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AggregateEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregateEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregateEventsRequestMultiError, or nil if none found.
+func (m *AggregateEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregateEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStartDate()) < 1 {
+		err := AggregateEventsRequestValidationError{
+			field:  "StartDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEndDate()) < 1 {
+		err := AggregateEventsRequestValidationError{
+			field:  "EndDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetImpact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateEventsRequestValidationError{
+					field:  "Impact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateEventsRequestValidationError{
+					field:  "Impact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImpact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateEventsRequestValidationError{
+				field:  "Impact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for Service
+
+	// no validation rules for Bucket
+
+	// no validation rules for Query
+
+	if len(errors) > 0 {
+		return AggregateEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by AggregateEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AggregateEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateEventsRequestMultiError) AllErrors() []error { return m }
+
+// AggregateEventsRequestValidationError is the validation error returned by
+// AggregateEventsRequest.Validate if the designated constraints aren't met.
+type AggregateEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateEventsRequestValidationError) ErrorName() string {
+	return "AggregateEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AggregateEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregateEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateEventsRequestValidationError{}
+
+// Validate checks the field values on Aggregation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Aggregation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AggregationMultiError, or
+// nil if none found.
+func (m *Aggregation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregationValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Group
+
+	// no validation rules for Count
+
+	// no validation rules for Successes
+
+	// no validation rules for Failures
+
+	// no validation rules for SuccessRatio
+
+	if all {
+		switch v := interface{}(m.GetDurationP50()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP50",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP50",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurationP50()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregationValidationError{
+				field:  "DurationP50",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDurationP90()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP90",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP90",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurationP90()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregationValidationError{
+				field:  "DurationP90",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDurationP99()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP99",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregationValidationError{
+					field:  "DurationP99",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurationP99()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregationValidationError{
+				field:  "DurationP99",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AggregationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregationMultiError is an error wrapping multiple validation errors
+// returned by Aggregation.ValidateAll() if the designated constraints aren't met.
+type AggregationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregationMultiError) AllErrors() []error { return m }
+
+// AggregationValidationError is the validation error returned by
+// Aggregation.Validate if the designated constraints aren't met.
+type AggregationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregationValidationError) ErrorName() string { return "AggregationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AggregationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregationValidationError{}
+
+// Validate checks the field values on AggregateEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AggregateEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregateEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregateEventsResponseMultiError, or nil if none found.
+func (m *AggregateEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregateEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAggregations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AggregateEventsResponseValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AggregateEventsResponseValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AggregateEventsResponseValidationError{
+					field:  fmt.Sprintf("Aggregations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if len(errors) > 0 {
+		return AggregateEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by AggregateEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AggregateEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateEventsResponseMultiError) AllErrors() []error { return m }
+
+// AggregateEventsResponseValidationError is the validation error returned by
+// AggregateEventsResponse.Validate if the designated constraints aren't met.
+type AggregateEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateEventsResponseValidationError) ErrorName() string {
+	return "AggregateEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AggregateEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregateEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateEventsResponseValidationError{}
+
+// Validate checks the field values on GetDoraMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDoraMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDoraMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDoraMetricsRequestMultiError, or nil if none found.
+func (m *GetDoraMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDoraMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStartDate()) < 1 {
+		err := GetDoraMetricsRequestValidationError{
+			field:  "StartDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEndDate()) < 1 {
+		err := GetDoraMetricsRequestValidationError{
+			field:  "EndDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for Service
+
+	// no validation rules for GroupByService
+
+	// no validation rules for GroupByTeam
+
+	// no validation rules for GroupByEnvironment
+
+	// no validation rules for Query
+
+	if len(errors) > 0 {
+		return GetDoraMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDoraMetricsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDoraMetricsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDoraMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDoraMetricsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDoraMetricsRequestMultiError) AllErrors() []error { return m }
+
+// GetDoraMetricsRequestValidationError is the validation error returned by
+// GetDoraMetricsRequest.Validate if the designated constraints aren't met.
+type GetDoraMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDoraMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDoraMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDoraMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDoraMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDoraMetricsRequestValidationError) ErrorName() string {
+	return "GetDoraMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDoraMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDoraMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDoraMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDoraMetricsRequestValidationError{}
+
+// Validate checks the field values on DoraMetrics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DoraMetrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DoraMetrics with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DoraMetricsMultiError, or
+// nil if none found.
+func (m *DoraMetrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DoraMetrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Team
+
+	// no validation rules for Environment
+
+	// no validation rules for Deployments
+
+	// no validation rules for DeploymentFrequency
+
+	if all {
+		switch v := interface{}(m.GetLeadTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DoraMetricsValidationError{
+					field:  "LeadTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DoraMetricsValidationError{
+					field:  "LeadTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeadTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DoraMetricsValidationError{
+				field:  "LeadTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FailedDeployments
+
+	// no validation rules for ChangeFailureRate
+
+	// no validation rules for Incidents
+
+	if all {
+		switch v := interface{}(m.GetTimeToRestore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DoraMetricsValidationError{
+					field:  "TimeToRestore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DoraMetricsValidationError{
+					field:  "TimeToRestore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeToRestore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DoraMetricsValidationError{
+				field:  "TimeToRestore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DoraMetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DoraMetricsMultiError is an error wrapping multiple validation errors
+// returned by DoraMetrics.ValidateAll() if the designated constraints aren't met.
+type DoraMetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DoraMetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DoraMetricsMultiError) AllErrors() []error { return m }
+
+// DoraMetricsValidationError is the validation error returned by
+// DoraMetrics.Validate if the designated constraints aren't met.
+type DoraMetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DoraMetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DoraMetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DoraMetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DoraMetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DoraMetricsValidationError) ErrorName() string { return "DoraMetricsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DoraMetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDoraMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DoraMetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DoraMetricsValidationError{}
+
+// Validate checks the field values on GetDoraMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDoraMetricsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDoraMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDoraMetricsResponseMultiError, or nil if none found.
+func (m *GetDoraMetricsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDoraMetricsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMetrics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDoraMetricsResponseValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDoraMetricsResponseValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDoraMetricsResponseValidationError{
+					field:  fmt.Sprintf("Metrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if len(errors) > 0 {
+		return GetDoraMetricsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDoraMetricsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDoraMetricsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDoraMetricsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDoraMetricsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDoraMetricsResponseMultiError) AllErrors() []error { return m }
+
+// GetDoraMetricsResponseValidationError is the validation error returned by
+// GetDoraMetricsResponse.Validate if the designated constraints aren't met.
+type GetDoraMetricsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDoraMetricsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDoraMetricsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDoraMetricsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDoraMetricsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDoraMetricsResponseValidationError) ErrorName() string {
+	return "GetDoraMetricsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDoraMetricsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDoraMetricsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDoraMetricsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDoraMetricsResponseValidationError{}
+
+// Validate checks the field values on GetOverlapsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOverlapsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOverlapsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOverlapsRequestMultiError, or nil if none found.
+func (m *GetOverlapsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOverlapsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStartDate()) < 1 {
+		err := GetOverlapsRequestValidationError{
+			field:  "StartDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEndDate()) < 1 {
+		err := GetOverlapsRequestValidationError{
+			field:  "EndDate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Service
+
+	// no validation rules for EnvironmentOnly
+
+	if len(errors) > 0 {
+		return GetOverlapsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOverlapsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOverlapsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOverlapsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOverlapsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOverlapsRequestMultiError) AllErrors() []error { return m }
+
+// GetOverlapsRequestValidationError is the validation error returned by
+// GetOverlapsRequest.Validate if the designated constraints aren't met.
+type GetOverlapsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOverlapsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOverlapsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOverlapsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOverlapsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOverlapsRequestValidationError) ErrorName() string {
+	return "GetOverlapsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOverlapsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOverlapsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOverlapsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOverlapsRequestValidationError{}
+
+// Validate checks the field values on GetOverlapsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOverlapsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOverlapsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOverlapsResponseMultiError, or nil if none found.
+func (m *GetOverlapsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOverlapsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOverlaps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOverlapsResponseValidationError{
+						field:  fmt.Sprintf("Overlaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOverlapsResponseValidationError{
+						field:  fmt.Sprintf("Overlaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOverlapsResponseValidationError{
+					field:  fmt.Sprintf("Overlaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOverlapsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOverlapsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOverlapsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOverlapsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOverlapsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOverlapsResponseMultiError) AllErrors() []error { return m }
+
+// GetOverlapsResponseValidationError is the validation error returned by
+// GetOverlapsResponse.Validate if the designated constraints aren't met.
+type GetOverlapsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOverlapsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOverlapsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOverlapsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOverlapsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOverlapsResponseValidationError) ErrorName() string {
+	return "GetOverlapsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOverlapsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOverlapsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOverlapsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOverlapsResponseValidationError{}
+
+// Validate checks the field values on Overlap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Overlap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Overlap with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OverlapMultiError, or nil if none found.
+func (m *Overlap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Overlap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFirst()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "First",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "First",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirst()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverlapValidationError{
+				field:  "First",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecond()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Second",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Second",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecond()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverlapValidationError{
+				field:  "Second",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FirstOwner
+
+	// no validation rules for SecondOwner
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverlapValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverlapValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverlapValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverlapValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OverlapMultiError(errors)
+	}
+
+	return nil
+}
+
+// OverlapMultiError is an error wrapping multiple validation errors returned
+// by Overlap.ValidateAll() if the designated constraints aren't met.
+type OverlapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OverlapMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OverlapMultiError) AllErrors() []error { return m }
+
+// OverlapValidationError is the validation error returned by Overlap.Validate
+// if the designated constraints aren't met.
+type OverlapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OverlapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OverlapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OverlapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OverlapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OverlapValidationError) ErrorName() string { return "OverlapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OverlapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOverlap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OverlapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OverlapValidationError{}
+
+// Validate checks the field values on CanDeployRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CanDeployRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CanDeployRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CanDeployRequestMultiError, or nil if none found.
+func (m *CanDeployRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CanDeployRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetService()) < 1 {
+		err := CanDeployRequestValidationError{
+			field:  "Service",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CanDeployRequest_Environment_NotInLookup[m.GetEnvironment()]; ok {
+		err := CanDeployRequestValidationError{
+			field:  "Environment",
+			reason: "value must not be in list [ENVIRONMENT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Environment_name[int32(m.GetEnvironment())]; !ok {
+		err := CanDeployRequestValidationError{
+			field:  "Environment",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for Owner
+
+	// no validation rules for ReleaseId
+
+	// no validation rules for FreezeExceptionId
+
+	if len(errors) > 0 {
+		return CanDeployRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CanDeployRequestMultiError is an error wrapping multiple validation errors
+// returned by CanDeployRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CanDeployRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CanDeployRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CanDeployRequestMultiError) AllErrors() []error { return m }
+
+// CanDeployRequestValidationError is the validation error returned by
+// CanDeployRequest.Validate if the designated constraints aren't met.
+type CanDeployRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CanDeployRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CanDeployRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CanDeployRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CanDeployRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CanDeployRequestValidationError) ErrorName() string { return "CanDeployRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CanDeployRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCanDeployRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CanDeployRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CanDeployRequestValidationError{}
+
+var _CanDeployRequest_Environment_NotInLookup = map[Environment]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CanDeployResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CanDeployResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CanDeployResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CanDeployResponseMultiError, or nil if none found.
+func (m *CanDeployResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CanDeployResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetReasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CanDeployResponseValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CanDeployResponseValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CanDeployResponseValidationError{
+					field:  fmt.Sprintf("Reasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return CanDeployResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CanDeployResponseMultiError is an error wrapping multiple validation errors
+// returned by CanDeployResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CanDeployResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CanDeployResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CanDeployResponseMultiError) AllErrors() []error { return m }
+
+// CanDeployResponseValidationError is the validation error returned by
+// CanDeployResponse.Validate if the designated constraints aren't met.
+type CanDeployResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CanDeployResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CanDeployResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CanDeployResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CanDeployResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CanDeployResponseValidationError) ErrorName() string {
+	return "CanDeployResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CanDeployResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCanDeployResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CanDeployResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CanDeployResponseValidationError{}
+
+// Validate checks the field values on BlockingReason with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockingReason) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockingReason with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockingReasonMultiError,
+// or nil if none found.
+func (m *BlockingReason) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockingReason) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Message
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockingReasonValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockingReasonValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockingReasonValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlockingReasonMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockingReasonMultiError is an error wrapping multiple validation errors
+// returned by BlockingReason.ValidateAll() if the designated constraints
+// aren't met.
+type BlockingReasonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockingReasonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockingReasonMultiError) AllErrors() []error { return m }
+
+// BlockingReasonValidationError is the validation error returned by
+// BlockingReason.Validate if the designated constraints aren't met.
+type BlockingReasonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockingReasonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockingReasonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockingReasonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockingReasonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockingReasonValidationError) ErrorName() string { return "BlockingReasonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockingReasonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockingReason.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockingReasonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockingReasonValidationError{}
+
+// Validate checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchEventsRequestMultiError, or nil if none found.
+func (m *WatchEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Type
+
+	// no validation rules for Priority
+
+	// no validation rules for Status
+
+	// no validation rules for Service
+
+	// no validation rules for Environment
+
+	if all {
+		switch v := interface{}(m.GetImpact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Impact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Impact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImpact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsRequestValidationError{
+				field:  "Impact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchEventsRequestMultiError) AllErrors() []error { return m }
+
+// WatchEventsRequestValidationError is the validation error returned by
+// WatchEventsRequest.Validate if the designated constraints aren't met.
+type WatchEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchEventsRequestValidationError) ErrorName() string {
+	return "WatchEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchEventsRequestValidationError{}
+
+// Validate checks the field values on WatchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchEventsResponseMultiError, or nil if none found.
+func (m *WatchEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Entry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Entry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "Entry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreviousAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "PreviousAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "PreviousAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviousAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "PreviousAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchEventsResponseMultiError) AllErrors() []error { return m }
+
+// WatchEventsResponseValidationError is the validation error returned by
+// WatchEventsResponse.Validate if the designated constraints aren't met.
+type WatchEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchEventsResponseValidationError) ErrorName() string {
+	return "WatchEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchEventsResponseValidationError{}
@@ -28,9 +28,15 @@ const (
 	EventService_TodayEvents_FullMethodName          = "/tracker.event.v1alpha1.EventService/TodayEvents"
 	EventService_AddChangelogEntry_FullMethodName    = "/tracker.event.v1alpha1.EventService/AddChangelogEntry"
 	EventService_GetEventChangelog_FullMethodName    = "/tracker.event.v1alpha1.EventService/GetEventChangelog"
+	EventService_GetEventAt_FullMethodName           = "/tracker.event.v1alpha1.EventService/GetEventAt"
 	EventService_AddSlackId_FullMethodName           = "/tracker.event.v1alpha1.EventService/AddSlackId"
 	EventService_GetEventStats_FullMethodName        = "/tracker.event.v1alpha1.EventService/GetEventStats"
 	EventService_GetEventStatsByMonth_FullMethodName = "/tracker.event.v1alpha1.EventService/GetEventStatsByMonth"
+	EventService_AggregateEvents_FullMethodName      = "/tracker.event.v1alpha1.EventService/AggregateEvents"
+	EventService_GetDoraMetrics_FullMethodName       = "/tracker.event.v1alpha1.EventService/GetDoraMetrics"
+	EventService_GetOverlaps_FullMethodName          = "/tracker.event.v1alpha1.EventService/GetOverlaps"
+	EventService_CanDeploy_FullMethodName            = "/tracker.event.v1alpha1.EventService/CanDeploy"
+	EventService_WatchEvents_FullMethodName          = "/tracker.event.v1alpha1.EventService/WatchEvents"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -48,12 +54,29 @@ type EventServiceClient interface {
 	AddChangelogEntry(ctx context.Context, in *AddChangelogEntryRequest, opts ...grpc.CallOption) (*AddChangelogEntryResponse, error)
 	// Get the changelog entries for an existing event
 	GetEventChangelog(ctx context.Context, in *GetEventChangelogRequest, opts ...grpc.CallOption) (*GetEventChangelogResponse, error)
+	// Reconstruct an event as it was at a past time, from its changelog
+	GetEventAt(ctx context.Context, in *GetEventAtRequest, opts ...grpc.CallOption) (*GetEventAtResponse, error)
 	// Add a Slack ID to an existing event
 	AddSlackId(ctx context.Context, in *AddSlackIdRequest, opts ...grpc.CallOption) (*AddSlackIdResponse, error)
 	// Get event statistics count with filters
 	GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*GetEventStatsResponse, error)
 	// Get event statistics aggregated by month
 	GetEventStatsByMonth(ctx context.Context, in *GetEventStatsByMonthRequest, opts ...grpc.CallOption) (*GetEventStatsByMonthResponse, error)
+	// Get event statistics aggregated by time bucket and by attributes
+	AggregateEvents(ctx context.Context, in *AggregateEventsRequest, opts ...grpc.CallOption) (*AggregateEventsResponse, error)
+	// Get the DORA metrics of the deployments and incidents of a period
+	GetDoraMetrics(ctx context.Context, in *GetDoraMetricsRequest, opts ...grpc.CallOption) (*GetDoraMetricsResponse, error)
+	// Get the pairs of events of a service, or of an environment, whose periods intersect
+	GetOverlaps(ctx context.Context, in *GetOverlapsRequest, opts ...grpc.CallOption) (*GetOverlapsResponse, error)
+	// Check whether a deployment of a service may start now, without creating an event
+	CanDeploy(ctx context.Context, in *CanDeployRequest, opts ...grpc.CallOption) (*CanDeployResponse, error)
+	// WatchEvents streams the creations, updates, deletions and changelog
+	// entries of the events matching the filter, and an event_left change when
+	// an update makes an event leave it. The changes are stored, every instance
+	// of the tracker sends them and resumes their tokens, until 1024 newer
+	// changes replace them. Over REST it is served as Server-Sent Events on
+	// GET /api/v1alpha1/events/watch.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error)
 }
 
 type eventServiceClient struct {
@@ -154,6 +177,16 @@ func (c *eventServiceClient) GetEventChangelog(ctx context.Context, in *GetEvent
 	return out, nil
 }
 
+func (c *eventServiceClient) GetEventAt(ctx context.Context, in *GetEventAtRequest, opts ...grpc.CallOption) (*GetEventAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventAtResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) AddSlackId(ctx context.Context, in *AddSlackIdRequest, opts ...grpc.CallOption) (*AddSlackIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddSlackIdResponse)
@@ -184,6 +217,65 @@ func (c *eventServiceClient) GetEventStatsByMonth(ctx context.Context, in *GetEv
 	return out, nil
 }
 
+func (c *eventServiceClient) AggregateEvents(ctx context.Context, in *AggregateEventsRequest, opts ...grpc.CallOption) (*AggregateEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_AggregateEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetDoraMetrics(ctx context.Context, in *GetDoraMetricsRequest, opts ...grpc.CallOption) (*GetDoraMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDoraMetricsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetDoraMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetOverlaps(ctx context.Context, in *GetOverlapsRequest, opts ...grpc.CallOption) (*GetOverlapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverlapsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetOverlaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CanDeploy(ctx context.Context, in *CanDeployRequest, opts ...grpc.CallOption) (*CanDeployResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanDeployResponse)
+	err := c.cc.Invoke(ctx, EventService_CanDeploy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsResponse]
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -199,12 +291,29 @@ type EventServiceServer interface {
 	AddChangelogEntry(context.Context, *AddChangelogEntryRequest) (*AddChangelogEntryResponse, error)
 	// Get the changelog entries for an existing event
 	GetEventChangelog(context.Context, *GetEventChangelogRequest) (*GetEventChangelogResponse, error)
+	// Reconstruct an event as it was at a past time, from its changelog
+	GetEventAt(context.Context, *GetEventAtRequest) (*GetEventAtResponse, error)
 	// Add a Slack ID to an existing event
 	AddSlackId(context.Context, *AddSlackIdRequest) (*AddSlackIdResponse, error)
 	// Get event statistics count with filters
 	GetEventStats(context.Context, *GetEventStatsRequest) (*GetEventStatsResponse, error)
 	// Get event statistics aggregated by month
 	GetEventStatsByMonth(context.Context, *GetEventStatsByMonthRequest) (*GetEventStatsByMonthResponse, error)
+	// Get event statistics aggregated by time bucket and by attributes
+	AggregateEvents(context.Context, *AggregateEventsRequest) (*AggregateEventsResponse, error)
+	// Get the DORA metrics of the deployments and incidents of a period
+	GetDoraMetrics(context.Context, *GetDoraMetricsRequest) (*GetDoraMetricsResponse, error)
+	// Get the pairs of events of a service, or of an environment, whose periods intersect
+	GetOverlaps(context.Context, *GetOverlapsRequest) (*GetOverlapsResponse, error)
+	// Check whether a deployment of a service may start now, without creating an event
+	CanDeploy(context.Context, *CanDeployRequest) (*CanDeployResponse, error)
+	// WatchEvents streams the creations, updates, deletions and changelog
+	// entries of the events matching the filter, and an event_left change when
+	// an update makes an event leave it. The changes are stored, every instance
+	// of the tracker sends them and resumes their tokens, until 1024 newer
+	// changes replace them. Over REST it is served as Server-Sent Events on
+	// GET /api/v1alpha1/events/watch.
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -242,6 +351,9 @@ func (UnimplementedEventServiceServer) AddChangelogEntry(context.Context, *AddCh
 func (UnimplementedEventServiceServer) GetEventChangelog(context.Context, *GetEventChangelogRequest) (*GetEventChangelogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventChangelog not implemented")
 }
+func (UnimplementedEventServiceServer) GetEventAt(context.Context, *GetEventAtRequest) (*GetEventAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventAt not implemented")
+}
 func (UnimplementedEventServiceServer) AddSlackId(context.Context, *AddSlackIdRequest) (*AddSlackIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSlackId not implemented")
 }
@@ -251,6 +363,21 @@ func (UnimplementedEventServiceServer) GetEventStats(context.Context, *GetEventS
 func (UnimplementedEventServiceServer) GetEventStatsByMonth(context.Context, *GetEventStatsByMonthRequest) (*GetEventStatsByMonthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventStatsByMonth not implemented")
 }
+func (UnimplementedEventServiceServer) AggregateEvents(context.Context, *AggregateEventsRequest) (*AggregateEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetDoraMetrics(context.Context, *GetDoraMetricsRequest) (*GetDoraMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoraMetrics not implemented")
+}
+func (UnimplementedEventServiceServer) GetOverlaps(context.Context, *GetOverlapsRequest) (*GetOverlapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverlaps not implemented")
+}
+func (UnimplementedEventServiceServer) CanDeploy(context.Context, *CanDeployRequest) (*CanDeployResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanDeploy not implemented")
+}
+func (UnimplementedEventServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -434,6 +561,24 @@ func _EventService_GetEventChangelog_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_GetEventAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventAt(ctx, req.(*GetEventAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_AddSlackId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddSlackIdRequest)
 	if err := dec(in); err != nil {
@@ -488,6 +633,89 @@ func _EventService_GetEventStatsByMonth_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_AggregateEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AggregateEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AggregateEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AggregateEvents(ctx, req.(*AggregateEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetDoraMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoraMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetDoraMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetDoraMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetDoraMetrics(ctx, req.(*GetDoraMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetOverlaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverlapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetOverlaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetOverlaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetOverlaps(ctx, req.(*GetOverlapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CanDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanDeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CanDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CanDeploy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CanDeploy(ctx, req.(*CanDeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsResponse]
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -531,6 +759,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventChangelog",
 			Handler:    _EventService_GetEventChangelog_Handler,
 		},
+		{
+			MethodName: "GetEventAt",
+			Handler:    _EventService_GetEventAt_Handler,
+		},
 		{
 			MethodName: "AddSlackId",
 			Handler:    _EventService_AddSlackId_Handler,
@@ -543,7 +775,29 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventStatsByMonth",
 			Handler:    _EventService_GetEventStatsByMonth_Handler,
 		},
+		{
+			MethodName: "AggregateEvents",
+			Handler:    _EventService_AggregateEvents_Handler,
+		},
+		{
+			MethodName: "GetDoraMetrics",
+			Handler:    _EventService_GetDoraMetrics_Handler,
+		},
+		{
+			MethodName: "GetOverlaps",
+			Handler:    _EventService_GetOverlaps_Handler,
+		},
+		{
+			MethodName: "CanDeploy",
+			Handler:    _EventService_CanDeploy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _EventService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/event/v1alpha1/event.proto",
 }
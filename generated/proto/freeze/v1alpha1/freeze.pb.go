@@ -0,0 +1,1633 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/freeze/v1alpha1/freeze.proto
+
+package v1alpha1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_UNSPECIFIED Weekday = 0
+	Weekday_monday              Weekday = 1
+	Weekday_tuesday             Weekday = 2
+	Weekday_wednesday           Weekday = 3
+	Weekday_thursday            Weekday = 4
+	Weekday_friday              Weekday = 5
+	Weekday_saturday            Weekday = 6
+	Weekday_sunday              Weekday = 7
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_UNSPECIFIED",
+		1: "monday",
+		2: "tuesday",
+		3: "wednesday",
+		4: "thursday",
+		5: "friday",
+		6: "saturday",
+		7: "sunday",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_UNSPECIFIED": 0,
+		"monday":              1,
+		"tuesday":             2,
+		"wednesday":           3,
+		"thursday":            4,
+		"friday":              5,
+		"saturday":            6,
+		"sunday":              7,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_freeze_v1alpha1_freeze_proto_enumTypes[0].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_proto_freeze_v1alpha1_freeze_proto_enumTypes[0]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{0}
+}
+
+// Freeze refuses the deployments of an environment over a period, or over the
+// windows of a recurrence, e.g. every Friday after 16:00
+type Freeze struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Environment of the events frozen, as in an event (e.g. "production")
+	Environment string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Services frozen, every service of the environment when empty
+	Services []string `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	// SLA levels of the catalog frozen (e.g. "critical"), every level when empty
+	SlaLevels []string `protobuf:"bytes,6,rep,name=sla_levels,json=slaLevels,proto3" json:"sla_levels,omitempty"`
+	// Start of the freeze, or of the recurrence. Required without recurrence.
+	Start *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the freeze, or of the recurrence. Required without recurrence.
+	End *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	// The freeze only applies during the windows of the recurrence when set
+	Recurrence    *Recurrence            `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Exceptions    []*FreezeException     `protobuf:"bytes,13,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Freeze) Reset() {
+	*x = Freeze{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Freeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Freeze) ProtoMessage() {}
+
+func (x *Freeze) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Freeze.ProtoReflect.Descriptor instead.
+func (*Freeze) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Freeze) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Freeze) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Freeze) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Freeze) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Freeze) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Freeze) GetSlaLevels() []string {
+	if x != nil {
+		return x.SlaLevels
+	}
+	return nil
+}
+
+func (x *Freeze) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Freeze) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Freeze) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Freeze) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Freeze) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Freeze) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Freeze) GetExceptions() []*FreezeException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// Recurrence repeats a freeze window every week on some days
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Days  []Weekday              `protobuf:"varint,1,rep,packed,name=days,proto3,enum=tracker.freeze.v1alpha1.Weekday" json:"days,omitempty"`
+	// Start of the window on each day, "HH:MM"
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End of the window, "HH:MM". The end of the day when empty, the next day
+	// when not after start_time (e.g. 22:00 to 06:00).
+	EndTime string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// IANA time zone of the times, e.g. "Europe/Paris", UTC when empty
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recurrence) GetDays() []Weekday {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Recurrence) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Recurrence) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// FreezeException lets a service deploy during a freeze once approved
+type FreezeException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,5,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeException) Reset() {
+	*x = FreezeException{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeException) ProtoMessage() {}
+
+func (x *FreezeException) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeException.ProtoReflect.Descriptor instead.
+func (*FreezeException) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FreezeException) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreezeException) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *FreezeException) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *FreezeException) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeException) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *FreezeException) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *FreezeException) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+type CreateFreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Environment   string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Services      []string               `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	SlaLevels     []string               `protobuf:"bytes,5,rep,name=sla_levels,json=slaLevels,proto3" json:"sla_levels,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Recurrence    *Recurrence            `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFreezeRequest) Reset() {
+	*x = CreateFreezeRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFreezeRequest) ProtoMessage() {}
+
+func (x *CreateFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFreezeRequest.ProtoReflect.Descriptor instead.
+func (*CreateFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFreezeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFreezeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateFreezeRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *CreateFreezeRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *CreateFreezeRequest) GetSlaLevels() []string {
+	if x != nil {
+		return x.SlaLevels
+	}
+	return nil
+}
+
+func (x *CreateFreezeRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateFreezeRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *CreateFreezeRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateFreezeRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateFreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Freeze        *Freeze                `protobuf:"bytes,1,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFreezeResponse) Reset() {
+	*x = CreateFreezeResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFreezeResponse) ProtoMessage() {}
+
+func (x *CreateFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFreezeResponse.ProtoReflect.Descriptor instead.
+func (*CreateFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateFreezeResponse) GetFreeze() *Freeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+type GetFreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFreezeRequest) Reset() {
+	*x = GetFreezeRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreezeRequest) ProtoMessage() {}
+
+func (x *GetFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreezeRequest.ProtoReflect.Descriptor instead.
+func (*GetFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFreezeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Freeze        *Freeze                `protobuf:"bytes,1,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFreezeResponse) Reset() {
+	*x = GetFreezeResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreezeResponse) ProtoMessage() {}
+
+func (x *GetFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreezeResponse.ProtoReflect.Descriptor instead.
+func (*GetFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFreezeResponse) GetFreeze() *Freeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+type UpdateFreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Services      []string               `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	SlaLevels     []string               `protobuf:"bytes,6,rep,name=sla_levels,json=slaLevels,proto3" json:"sla_levels,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	Recurrence    *Recurrence            `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFreezeRequest) Reset() {
+	*x = UpdateFreezeRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreezeRequest) ProtoMessage() {}
+
+func (x *UpdateFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreezeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateFreezeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFreezeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFreezeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateFreezeRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateFreezeRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *UpdateFreezeRequest) GetSlaLevels() []string {
+	if x != nil {
+		return x.SlaLevels
+	}
+	return nil
+}
+
+func (x *UpdateFreezeRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *UpdateFreezeRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *UpdateFreezeRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+type UpdateFreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Freeze        *Freeze                `protobuf:"bytes,1,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFreezeResponse) Reset() {
+	*x = UpdateFreezeResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreezeResponse) ProtoMessage() {}
+
+func (x *UpdateFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreezeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateFreezeResponse) GetFreeze() *Freeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+type DeleteFreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFreezeRequest) Reset() {
+	*x = DeleteFreezeRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFreezeRequest) ProtoMessage() {}
+
+func (x *DeleteFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFreezeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteFreezeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFreezeResponse) Reset() {
+	*x = DeleteFreezeResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFreezeResponse) ProtoMessage() {}
+
+func (x *DeleteFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFreezeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteFreezeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFreezesRequest struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Environment string                  `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	PerPage     *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// next_page_token of the previous page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreezesRequest) Reset() {
+	*x = ListFreezesRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreezesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreezesRequest) ProtoMessage() {}
+
+func (x *ListFreezesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreezesRequest.ProtoReflect.Descriptor instead.
+func (*ListFreezesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListFreezesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ListFreezesRequest) GetPerPage() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerPage
+	}
+	return nil
+}
+
+func (x *ListFreezesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFreezesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Freezes       []*Freeze              `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreezesResponse) Reset() {
+	*x = ListFreezesResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreezesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreezesResponse) ProtoMessage() {}
+
+func (x *ListFreezesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreezesResponse.ProtoReflect.Descriptor instead.
+func (*ListFreezesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListFreezesResponse) GetFreezes() []*Freeze {
+	if x != nil {
+		return x.Freezes
+	}
+	return nil
+}
+
+func (x *ListFreezesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListFreezesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetActiveFreezesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Environment string                 `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Freezes applying to this service, given its SLA level in the catalog
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Date to check, now when unset
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveFreezesRequest) Reset() {
+	*x = GetActiveFreezesRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveFreezesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveFreezesRequest) ProtoMessage() {}
+
+func (x *GetActiveFreezesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveFreezesRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveFreezesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetActiveFreezesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GetActiveFreezesRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetActiveFreezesRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetActiveFreezesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Freezes       []*ActiveFreeze        `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveFreezesResponse) Reset() {
+	*x = GetActiveFreezesResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveFreezesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveFreezesResponse) ProtoMessage() {}
+
+func (x *GetActiveFreezesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveFreezesResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveFreezesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetActiveFreezesResponse) GetFreezes() []*ActiveFreeze {
+	if x != nil {
+		return x.Freezes
+	}
+	return nil
+}
+
+type ActiveFreeze struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Freeze *Freeze                `protobuf:"bytes,1,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	// End of the freeze, or of the current window of a recurring freeze
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveFreeze) Reset() {
+	*x = ActiveFreeze{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveFreeze) ProtoMessage() {}
+
+func (x *ActiveFreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveFreeze.ProtoReflect.Descriptor instead.
+func (*ActiveFreeze) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ActiveFreeze) GetFreeze() *Freeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+func (x *ActiveFreeze) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type RequestFreezeExceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FreezeId      string                 `protobuf:"bytes,1,opt,name=freeze_id,json=freezeId,proto3" json:"freeze_id,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestFreezeExceptionRequest) Reset() {
+	*x = RequestFreezeExceptionRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestFreezeExceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFreezeExceptionRequest) ProtoMessage() {}
+
+func (x *RequestFreezeExceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFreezeExceptionRequest.ProtoReflect.Descriptor instead.
+func (*RequestFreezeExceptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestFreezeExceptionRequest) GetFreezeId() string {
+	if x != nil {
+		return x.FreezeId
+	}
+	return ""
+}
+
+func (x *RequestFreezeExceptionRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *RequestFreezeExceptionRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *RequestFreezeExceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestFreezeExceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exception     *FreezeException       `protobuf:"bytes,1,opt,name=exception,proto3" json:"exception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestFreezeExceptionResponse) Reset() {
+	*x = RequestFreezeExceptionResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestFreezeExceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFreezeExceptionResponse) ProtoMessage() {}
+
+func (x *RequestFreezeExceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFreezeExceptionResponse.ProtoReflect.Descriptor instead.
+func (*RequestFreezeExceptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestFreezeExceptionResponse) GetException() *FreezeException {
+	if x != nil {
+		return x.Exception
+	}
+	return nil
+}
+
+type ApproveFreezeExceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FreezeId      string                 `protobuf:"bytes,1,opt,name=freeze_id,json=freezeId,proto3" json:"freeze_id,omitempty"`
+	ExceptionId   string                 `protobuf:"bytes,2,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,3,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFreezeExceptionRequest) Reset() {
+	*x = ApproveFreezeExceptionRequest{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFreezeExceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFreezeExceptionRequest) ProtoMessage() {}
+
+func (x *ApproveFreezeExceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFreezeExceptionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFreezeExceptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApproveFreezeExceptionRequest) GetFreezeId() string {
+	if x != nil {
+		return x.FreezeId
+	}
+	return ""
+}
+
+func (x *ApproveFreezeExceptionRequest) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
+func (x *ApproveFreezeExceptionRequest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+type ApproveFreezeExceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exception     *FreezeException       `protobuf:"bytes,1,opt,name=exception,proto3" json:"exception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFreezeExceptionResponse) Reset() {
+	*x = ApproveFreezeExceptionResponse{}
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFreezeExceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFreezeExceptionResponse) ProtoMessage() {}
+
+func (x *ApproveFreezeExceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_freeze_v1alpha1_freeze_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFreezeExceptionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFreezeExceptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApproveFreezeExceptionResponse) GetException() *FreezeException {
+	if x != nil {
+		return x.Exception
+	}
+	return nil
+}
+
+var File_proto_freeze_v1alpha1_freeze_proto protoreflect.FileDescriptor
+
+const file_proto_freeze_v1alpha1_freeze_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/freeze/v1alpha1/freeze.proto\x12\x17tracker.freeze.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xa5\x04\n" +
+	"\x06Freeze\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12 \n" +
+	"\venvironment\x18\x04 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bservices\x18\x05 \x03(\tR\bservices\x12\x1d\n" +
+	"\n" +
+	"sla_levels\x18\x06 \x03(\tR\tslaLevels\x120\n" +
+	"\x05start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12C\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2#.tracker.freeze.v1alpha1.RecurrenceR\n" +
+	"recurrence\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
+	"\n" +
+	"exceptions\x18\r \x03(\v2(.tracker.freeze.v1alpha1.FreezeExceptionR\n" +
+	"exceptions\"\x99\x01\n" +
+	"\n" +
+	"Recurrence\x124\n" +
+	"\x04days\x18\x01 \x03(\x0e2 .tracker.freeze.v1alpha1.WeekdayR\x04days\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\x93\x02\n" +
+	"\x0fFreezeException\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vapproved_by\x18\x05 \x01(\tR\n" +
+	"approvedBy\x12=\n" +
+	"\frequested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12;\n" +
+	"\vapproved_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\"\xe2\x02\n" +
+	"\x13CreateFreezeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bservices\x18\x04 \x03(\tR\bservices\x12\x1d\n" +
+	"\n" +
+	"sla_levels\x18\x05 \x03(\tR\tslaLevels\x120\n" +
+	"\x05start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12C\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2#.tracker.freeze.v1alpha1.RecurrenceR\n" +
+	"recurrence\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\"O\n" +
+	"\x14CreateFreezeResponse\x127\n" +
+	"\x06freeze\x18\x01 \x01(\v2\x1f.tracker.freeze.v1alpha1.FreezeR\x06freeze\"\"\n" +
+	"\x10GetFreezeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x11GetFreezeResponse\x127\n" +
+	"\x06freeze\x18\x01 \x01(\v2\x1f.tracker.freeze.v1alpha1.FreezeR\x06freeze\"\xd3\x02\n" +
+	"\x13UpdateFreezeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12 \n" +
+	"\venvironment\x18\x04 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bservices\x18\x05 \x03(\tR\bservices\x12\x1d\n" +
+	"\n" +
+	"sla_levels\x18\x06 \x03(\tR\tslaLevels\x120\n" +
+	"\x05start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12C\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2#.tracker.freeze.v1alpha1.RecurrenceR\n" +
+	"recurrence\"O\n" +
+	"\x14UpdateFreezeResponse\x127\n" +
+	"\x06freeze\x18\x01 \x01(\v2\x1f.tracker.freeze.v1alpha1.FreezeR\x06freeze\"%\n" +
+	"\x13DeleteFreezeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14DeleteFreezeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x01\n" +
+	"\x12ListFreezesRequest\x12 \n" +
+	"\venvironment\x18\x01 \x01(\tR\venvironment\x127\n" +
+	"\bper_page\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x99\x01\n" +
+	"\x13ListFreezesResponse\x129\n" +
+	"\afreezes\x18\x01 \x03(\v2\x1f.tracker.freeze.v1alpha1.FreezeR\afreezes\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x17GetActiveFreezesRequest\x12 \n" +
+	"\venvironment\x18\x01 \x01(\tR\venvironment\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"[\n" +
+	"\x18GetActiveFreezesResponse\x12?\n" +
+	"\afreezes\x18\x01 \x03(\v2%.tracker.freeze.v1alpha1.ActiveFreezeR\afreezes\"y\n" +
+	"\fActiveFreeze\x127\n" +
+	"\x06freeze\x18\x01 \x01(\v2\x1f.tracker.freeze.v1alpha1.FreezeR\x06freeze\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x91\x01\n" +
+	"\x1dRequestFreezeExceptionRequest\x12\x1b\n" +
+	"\tfreeze_id\x18\x01 \x01(\tR\bfreezeId\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"h\n" +
+	"\x1eRequestFreezeExceptionResponse\x12F\n" +
+	"\texception\x18\x01 \x01(\v2(.tracker.freeze.v1alpha1.FreezeExceptionR\texception\"\x80\x01\n" +
+	"\x1dApproveFreezeExceptionRequest\x12\x1b\n" +
+	"\tfreeze_id\x18\x01 \x01(\tR\bfreezeId\x12!\n" +
+	"\fexception_id\x18\x02 \x01(\tR\vexceptionId\x12\x1f\n" +
+	"\vapproved_by\x18\x03 \x01(\tR\n" +
+	"approvedBy\"h\n" +
+	"\x1eApproveFreezeExceptionResponse\x12F\n" +
+	"\texception\x18\x01 \x01(\v2(.tracker.freeze.v1alpha1.FreezeExceptionR\texception*~\n" +
+	"\aWeekday\x12\x17\n" +
+	"\x13WEEKDAY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06monday\x10\x01\x12\v\n" +
+	"\atuesday\x10\x02\x12\r\n" +
+	"\twednesday\x10\x03\x12\f\n" +
+	"\bthursday\x10\x04\x12\n" +
+	"\n" +
+	"\x06friday\x10\x05\x12\f\n" +
+	"\bsaturday\x10\x06\x12\n" +
+	"\n" +
+	"\x06sunday\x10\a2\x97\n" +
+	"\n" +
+	"\rFreezeService\x12\x8c\x01\n" +
+	"\fCreateFreeze\x12,.tracker.freeze.v1alpha1.CreateFreezeRequest\x1a-.tracker.freeze.v1alpha1.CreateFreezeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1alpha1/freeze\x12\x85\x01\n" +
+	"\tGetFreeze\x12).tracker.freeze.v1alpha1.GetFreezeRequest\x1a*.tracker.freeze.v1alpha1.GetFreezeResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1alpha1/freeze/{id}\x12\x91\x01\n" +
+	"\fUpdateFreeze\x12,.tracker.freeze.v1alpha1.UpdateFreezeRequest\x1a-.tracker.freeze.v1alpha1.UpdateFreezeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1alpha1/freeze/{id}\x12\x8e\x01\n" +
+	"\fDeleteFreeze\x12,.tracker.freeze.v1alpha1.DeleteFreezeRequest\x1a-.tracker.freeze.v1alpha1.DeleteFreezeResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1alpha1/freeze/{id}\x12\x8c\x01\n" +
+	"\vListFreezes\x12+.tracker.freeze.v1alpha1.ListFreezesRequest\x1a,.tracker.freeze.v1alpha1.ListFreezesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1alpha1/freezes/list\x12\x9d\x01\n" +
+	"\x10GetActiveFreezes\x120.tracker.freeze.v1alpha1.GetActiveFreezesRequest\x1a1.tracker.freeze.v1alpha1.GetActiveFreezesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1alpha1/freezes/active\x12\xc0\x01\n" +
+	"\x16RequestFreezeException\x126.tracker.freeze.v1alpha1.RequestFreezeExceptionRequest\x1a7.tracker.freeze.v1alpha1.RequestFreezeExceptionResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1alpha1/freeze/{freeze_id}/exception\x12\xd7\x01\n" +
+	"\x16ApproveFreezeException\x126.tracker.freeze.v1alpha1.ApproveFreezeExceptionRequest\x1a7.tracker.freeze.v1alpha1.ApproveFreezeExceptionResponse\"L\x82\xd3\xe4\x93\x02F:\x01*\"A/api/v1alpha1/freeze/{freeze_id}/exception/{exception_id}/approveB\x17Z\x15proto/freeze/v1alpha1b\x06proto3"
+
+var (
+	file_proto_freeze_v1alpha1_freeze_proto_rawDescOnce sync.Once
+	file_proto_freeze_v1alpha1_freeze_proto_rawDescData []byte
+)
+
+func file_proto_freeze_v1alpha1_freeze_proto_rawDescGZIP() []byte {
+	file_proto_freeze_v1alpha1_freeze_proto_rawDescOnce.Do(func() {
+		file_proto_freeze_v1alpha1_freeze_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_freeze_v1alpha1_freeze_proto_rawDesc), len(file_proto_freeze_v1alpha1_freeze_proto_rawDesc)))
+	})
+	return file_proto_freeze_v1alpha1_freeze_proto_rawDescData
+}
+
+var file_proto_freeze_v1alpha1_freeze_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_freeze_v1alpha1_freeze_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_freeze_v1alpha1_freeze_proto_goTypes = []any{
+	(Weekday)(0),                           // 0: tracker.freeze.v1alpha1.Weekday
+	(*Freeze)(nil),                         // 1: tracker.freeze.v1alpha1.Freeze
+	(*Recurrence)(nil),                     // 2: tracker.freeze.v1alpha1.Recurrence
+	(*FreezeException)(nil),                // 3: tracker.freeze.v1alpha1.FreezeException
+	(*CreateFreezeRequest)(nil),            // 4: tracker.freeze.v1alpha1.CreateFreezeRequest
+	(*CreateFreezeResponse)(nil),           // 5: tracker.freeze.v1alpha1.CreateFreezeResponse
+	(*GetFreezeRequest)(nil),               // 6: tracker.freeze.v1alpha1.GetFreezeRequest
+	(*GetFreezeResponse)(nil),              // 7: tracker.freeze.v1alpha1.GetFreezeResponse
+	(*UpdateFreezeRequest)(nil),            // 8: tracker.freeze.v1alpha1.UpdateFreezeRequest
+	(*UpdateFreezeResponse)(nil),           // 9: tracker.freeze.v1alpha1.UpdateFreezeResponse
+	(*DeleteFreezeRequest)(nil),            // 10: tracker.freeze.v1alpha1.DeleteFreezeRequest
+	(*DeleteFreezeResponse)(nil),           // 11: tracker.freeze.v1alpha1.DeleteFreezeResponse
+	(*ListFreezesRequest)(nil),             // 12: tracker.freeze.v1alpha1.ListFreezesRequest
+	(*ListFreezesResponse)(nil),            // 13: tracker.freeze.v1alpha1.ListFreezesResponse
+	(*GetActiveFreezesRequest)(nil),        // 14: tracker.freeze.v1alpha1.GetActiveFreezesRequest
+	(*GetActiveFreezesResponse)(nil),       // 15: tracker.freeze.v1alpha1.GetActiveFreezesResponse
+	(*ActiveFreeze)(nil),                   // 16: tracker.freeze.v1alpha1.ActiveFreeze
+	(*RequestFreezeExceptionRequest)(nil),  // 17: tracker.freeze.v1alpha1.RequestFreezeExceptionRequest
+	(*RequestFreezeExceptionResponse)(nil), // 18: tracker.freeze.v1alpha1.RequestFreezeExceptionResponse
+	(*ApproveFreezeExceptionRequest)(nil),  // 19: tracker.freeze.v1alpha1.ApproveFreezeExceptionRequest
+	(*ApproveFreezeExceptionResponse)(nil), // 20: tracker.freeze.v1alpha1.ApproveFreezeExceptionResponse
+	(*timestamppb.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(*wrapperspb.UInt32Value)(nil),         // 22: google.protobuf.UInt32Value
+}
+var file_proto_freeze_v1alpha1_freeze_proto_depIdxs = []int32{
+	21, // 0: tracker.freeze.v1alpha1.Freeze.start:type_name -> google.protobuf.Timestamp
+	21, // 1: tracker.freeze.v1alpha1.Freeze.end:type_name -> google.protobuf.Timestamp
+	2,  // 2: tracker.freeze.v1alpha1.Freeze.recurrence:type_name -> tracker.freeze.v1alpha1.Recurrence
+	21, // 3: tracker.freeze.v1alpha1.Freeze.created_at:type_name -> google.protobuf.Timestamp
+	21, // 4: tracker.freeze.v1alpha1.Freeze.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: tracker.freeze.v1alpha1.Freeze.exceptions:type_name -> tracker.freeze.v1alpha1.FreezeException
+	0,  // 6: tracker.freeze.v1alpha1.Recurrence.days:type_name -> tracker.freeze.v1alpha1.Weekday
+	21, // 7: tracker.freeze.v1alpha1.FreezeException.requested_at:type_name -> google.protobuf.Timestamp
+	21, // 8: tracker.freeze.v1alpha1.FreezeException.approved_at:type_name -> google.protobuf.Timestamp
+	21, // 9: tracker.freeze.v1alpha1.CreateFreezeRequest.start:type_name -> google.protobuf.Timestamp
+	21, // 10: tracker.freeze.v1alpha1.CreateFreezeRequest.end:type_name -> google.protobuf.Timestamp
+	2,  // 11: tracker.freeze.v1alpha1.CreateFreezeRequest.recurrence:type_name -> tracker.freeze.v1alpha1.Recurrence
+	1,  // 12: tracker.freeze.v1alpha1.CreateFreezeResponse.freeze:type_name -> tracker.freeze.v1alpha1.Freeze
+	1,  // 13: tracker.freeze.v1alpha1.GetFreezeResponse.freeze:type_name -> tracker.freeze.v1alpha1.Freeze
+	21, // 14: tracker.freeze.v1alpha1.UpdateFreezeRequest.start:type_name -> google.protobuf.Timestamp
+	21, // 15: tracker.freeze.v1alpha1.UpdateFreezeRequest.end:type_name -> google.protobuf.Timestamp
+	2,  // 16: tracker.freeze.v1alpha1.UpdateFreezeRequest.recurrence:type_name -> tracker.freeze.v1alpha1.Recurrence
+	1,  // 17: tracker.freeze.v1alpha1.UpdateFreezeResponse.freeze:type_name -> tracker.freeze.v1alpha1.Freeze
+	22, // 18: tracker.freeze.v1alpha1.ListFreezesRequest.per_page:type_name -> google.protobuf.UInt32Value
+	1,  // 19: tracker.freeze.v1alpha1.ListFreezesResponse.freezes:type_name -> tracker.freeze.v1alpha1.Freeze
+	21, // 20: tracker.freeze.v1alpha1.GetActiveFreezesRequest.at:type_name -> google.protobuf.Timestamp
+	16, // 21: tracker.freeze.v1alpha1.GetActiveFreezesResponse.freezes:type_name -> tracker.freeze.v1alpha1.ActiveFreeze
+	1,  // 22: tracker.freeze.v1alpha1.ActiveFreeze.freeze:type_name -> tracker.freeze.v1alpha1.Freeze
+	21, // 23: tracker.freeze.v1alpha1.ActiveFreeze.until:type_name -> google.protobuf.Timestamp
+	3,  // 24: tracker.freeze.v1alpha1.RequestFreezeExceptionResponse.exception:type_name -> tracker.freeze.v1alpha1.FreezeException
+	3,  // 25: tracker.freeze.v1alpha1.ApproveFreezeExceptionResponse.exception:type_name -> tracker.freeze.v1alpha1.FreezeException
+	4,  // 26: tracker.freeze.v1alpha1.FreezeService.CreateFreeze:input_type -> tracker.freeze.v1alpha1.CreateFreezeRequest
+	6,  // 27: tracker.freeze.v1alpha1.FreezeService.GetFreeze:input_type -> tracker.freeze.v1alpha1.GetFreezeRequest
+	8,  // 28: tracker.freeze.v1alpha1.FreezeService.UpdateFreeze:input_type -> tracker.freeze.v1alpha1.UpdateFreezeRequest
+	10, // 29: tracker.freeze.v1alpha1.FreezeService.DeleteFreeze:input_type -> tracker.freeze.v1alpha1.DeleteFreezeRequest
+	12, // 30: tracker.freeze.v1alpha1.FreezeService.ListFreezes:input_type -> tracker.freeze.v1alpha1.ListFreezesRequest
+	14, // 31: tracker.freeze.v1alpha1.FreezeService.GetActiveFreezes:input_type -> tracker.freeze.v1alpha1.GetActiveFreezesRequest
+	17, // 32: tracker.freeze.v1alpha1.FreezeService.RequestFreezeException:input_type -> tracker.freeze.v1alpha1.RequestFreezeExceptionRequest
+	19, // 33: tracker.freeze.v1alpha1.FreezeService.ApproveFreezeException:input_type -> tracker.freeze.v1alpha1.ApproveFreezeExceptionRequest
+	5,  // 34: tracker.freeze.v1alpha1.FreezeService.CreateFreeze:output_type -> tracker.freeze.v1alpha1.CreateFreezeResponse
+	7,  // 35: tracker.freeze.v1alpha1.FreezeService.GetFreeze:output_type -> tracker.freeze.v1alpha1.GetFreezeResponse
+	9,  // 36: tracker.freeze.v1alpha1.FreezeService.UpdateFreeze:output_type -> tracker.freeze.v1alpha1.UpdateFreezeResponse
+	11, // 37: tracker.freeze.v1alpha1.FreezeService.DeleteFreeze:output_type -> tracker.freeze.v1alpha1.DeleteFreezeResponse
+	13, // 38: tracker.freeze.v1alpha1.FreezeService.ListFreezes:output_type -> tracker.freeze.v1alpha1.ListFreezesResponse
+	15, // 39: tracker.freeze.v1alpha1.FreezeService.GetActiveFreezes:output_type -> tracker.freeze.v1alpha1.GetActiveFreezesResponse
+	18, // 40: tracker.freeze.v1alpha1.FreezeService.RequestFreezeException:output_type -> tracker.freeze.v1alpha1.RequestFreezeExceptionResponse
+	20, // 41: tracker.freeze.v1alpha1.FreezeService.ApproveFreezeException:output_type -> tracker.freeze.v1alpha1.ApproveFreezeExceptionResponse
+	34, // [34:42] is the sub-list for method output_type
+	26, // [26:34] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_proto_freeze_v1alpha1_freeze_proto_init() }
+func file_proto_freeze_v1alpha1_freeze_proto_init() {
+	if File_proto_freeze_v1alpha1_freeze_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_freeze_v1alpha1_freeze_proto_rawDesc), len(file_proto_freeze_v1alpha1_freeze_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_freeze_v1alpha1_freeze_proto_goTypes,
+		DependencyIndexes: file_proto_freeze_v1alpha1_freeze_proto_depIdxs,
+		EnumInfos:         file_proto_freeze_v1alpha1_freeze_proto_enumTypes,
+		MessageInfos:      file_proto_freeze_v1alpha1_freeze_proto_msgTypes,
+	}.Build()
+	File_proto_freeze_v1alpha1_freeze_proto = out.File
+	file_proto_freeze_v1alpha1_freeze_proto_goTypes = nil
+	file_proto_freeze_v1alpha1_freeze_proto_depIdxs = nil
+}
@@ -0,0 +1,2880 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/freeze/v1alpha1/freeze.proto
+
+package v1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Freeze with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Freeze) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Freeze with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FreezeMultiError, or nil if none found.
+func (m *Freeze) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Freeze) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	// no validation rules for Environment
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExceptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FreezeValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FreezeValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FreezeValidationError{
+					field:  fmt.Sprintf("Exceptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FreezeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeMultiError is an error wrapping multiple validation errors returned by
+// Freeze.ValidateAll() if the designated constraints aren't met.
+type FreezeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeMultiError) AllErrors() []error { return m }
+
+// FreezeValidationError is the validation error returned by Freeze.Validate if
+// the designated constraints aren't met.
+type FreezeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeValidationError) ErrorName() string { return "FreezeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreezeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeze.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeValidationError{}
+
+// Validate checks the field values on Recurrence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Recurrence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Recurrence with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecurrenceMultiError, or
+// nil if none found.
+func (m *Recurrence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Recurrence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return RecurrenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecurrenceMultiError is an error wrapping multiple validation errors
+// returned by Recurrence.ValidateAll() if the designated constraints aren't met.
+type RecurrenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecurrenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecurrenceMultiError) AllErrors() []error { return m }
+
+// RecurrenceValidationError is the validation error returned by
+// Recurrence.Validate if the designated constraints aren't met.
+type RecurrenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecurrenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecurrenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecurrenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecurrenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecurrenceValidationError) ErrorName() string { return "RecurrenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecurrenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecurrence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecurrenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecurrenceValidationError{}
+
+// Validate checks the field values on FreezeException with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FreezeException) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeException with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeExceptionMultiError, or nil if none found.
+func (m *FreezeException) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeException) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Service
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for Reason
+
+	// no validation rules for ApprovedBy
+
+	if all {
+		switch v := interface{}(m.GetRequestedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeExceptionValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeExceptionValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequestedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeExceptionValidationError{
+				field:  "RequestedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetApprovedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeExceptionValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeExceptionValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApprovedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeExceptionValidationError{
+				field:  "ApprovedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FreezeExceptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeExceptionMultiError is an error wrapping multiple validation errors
+// returned by FreezeException.ValidateAll() if the designated constraints
+// aren't met.
+type FreezeExceptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeExceptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeExceptionMultiError) AllErrors() []error { return m }
+
+// FreezeExceptionValidationError is the validation error returned by
+// FreezeException.Validate if the designated constraints aren't met.
+type FreezeExceptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeExceptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeExceptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeExceptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeExceptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeExceptionValidationError) ErrorName() string { return "FreezeExceptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreezeExceptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeException.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeExceptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeExceptionValidationError{}
+
+// Validate checks the field values on CreateFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFreezeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFreezeRequestMultiError, or nil if none found.
+func (m *CreateFreezeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFreezeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	// no validation rules for Environment
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFreezeRequestValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFreezeRequestValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFreezeRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFreezeRequestValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if len(errors) > 0 {
+		return CreateFreezeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFreezeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFreezeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFreezeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFreezeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFreezeRequestMultiError) AllErrors() []error { return m }
+
+// CreateFreezeRequestValidationError is the validation error returned by
+// CreateFreezeRequest.Validate if the designated constraints aren't met.
+type CreateFreezeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFreezeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFreezeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFreezeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFreezeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFreezeRequestValidationError) ErrorName() string {
+	return "CreateFreezeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFreezeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFreezeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFreezeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFreezeRequestValidationError{}
+
+// Validate checks the field values on CreateFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFreezeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFreezeResponseMultiError, or nil if none found.
+func (m *CreateFreezeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFreezeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFreeze()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreeze()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFreezeResponseValidationError{
+				field:  "Freeze",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFreezeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFreezeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFreezeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFreezeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFreezeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFreezeResponseMultiError) AllErrors() []error { return m }
+
+// CreateFreezeResponseValidationError is the validation error returned by
+// CreateFreezeResponse.Validate if the designated constraints aren't met.
+type CreateFreezeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFreezeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFreezeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFreezeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFreezeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFreezeResponseValidationError) ErrorName() string {
+	return "CreateFreezeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFreezeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFreezeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFreezeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFreezeResponseValidationError{}
+
+// Validate checks the field values on GetFreezeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFreezeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFreezeRequestMultiError, or nil if none found.
+func (m *GetFreezeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFreezeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetFreezeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFreezeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFreezeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFreezeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFreezeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFreezeRequestMultiError) AllErrors() []error { return m }
+
+// GetFreezeRequestValidationError is the validation error returned by
+// GetFreezeRequest.Validate if the designated constraints aren't met.
+type GetFreezeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFreezeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFreezeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFreezeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFreezeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFreezeRequestValidationError) ErrorName() string { return "GetFreezeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFreezeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFreezeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFreezeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFreezeRequestValidationError{}
+
+// Validate checks the field values on GetFreezeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFreezeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFreezeResponseMultiError, or nil if none found.
+func (m *GetFreezeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFreezeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFreeze()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreeze()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFreezeResponseValidationError{
+				field:  "Freeze",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFreezeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFreezeResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFreezeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFreezeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFreezeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFreezeResponseMultiError) AllErrors() []error { return m }
+
+// GetFreezeResponseValidationError is the validation error returned by
+// GetFreezeResponse.Validate if the designated constraints aren't met.
+type GetFreezeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFreezeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFreezeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFreezeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFreezeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFreezeResponseValidationError) ErrorName() string {
+	return "GetFreezeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFreezeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFreezeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFreezeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFreezeResponseValidationError{}
+
+// Validate checks the field values on UpdateFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFreezeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFreezeRequestMultiError, or nil if none found.
+func (m *UpdateFreezeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFreezeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	// no validation rules for Environment
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFreezeRequestValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFreezeRequestValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFreezeRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFreezeRequestValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFreezeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFreezeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateFreezeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFreezeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFreezeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFreezeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFreezeRequestValidationError is the validation error returned by
+// UpdateFreezeRequest.Validate if the designated constraints aren't met.
+type UpdateFreezeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFreezeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFreezeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFreezeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFreezeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFreezeRequestValidationError) ErrorName() string {
+	return "UpdateFreezeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFreezeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFreezeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFreezeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFreezeRequestValidationError{}
+
+// Validate checks the field values on UpdateFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFreezeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFreezeResponseMultiError, or nil if none found.
+func (m *UpdateFreezeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFreezeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFreeze()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFreezeResponseValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreeze()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFreezeResponseValidationError{
+				field:  "Freeze",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFreezeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFreezeResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateFreezeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFreezeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFreezeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFreezeResponseMultiError) AllErrors() []error { return m }
+
+// UpdateFreezeResponseValidationError is the validation error returned by
+// UpdateFreezeResponse.Validate if the designated constraints aren't met.
+type UpdateFreezeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFreezeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFreezeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFreezeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFreezeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFreezeResponseValidationError) ErrorName() string {
+	return "UpdateFreezeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFreezeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFreezeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFreezeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFreezeResponseValidationError{}
+
+// Validate checks the field values on DeleteFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFreezeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFreezeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFreezeRequestMultiError, or nil if none found.
+func (m *DeleteFreezeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFreezeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteFreezeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFreezeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFreezeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFreezeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFreezeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFreezeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFreezeRequestValidationError is the validation error returned by
+// DeleteFreezeRequest.Validate if the designated constraints aren't met.
+type DeleteFreezeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFreezeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFreezeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFreezeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFreezeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFreezeRequestValidationError) ErrorName() string {
+	return "DeleteFreezeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFreezeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFreezeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFreezeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFreezeRequestValidationError{}
+
+// Validate checks the field values on DeleteFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFreezeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFreezeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFreezeResponseMultiError, or nil if none found.
+func (m *DeleteFreezeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFreezeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteFreezeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFreezeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteFreezeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFreezeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFreezeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFreezeResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFreezeResponseValidationError is the validation error returned by
+// DeleteFreezeResponse.Validate if the designated constraints aren't met.
+type DeleteFreezeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFreezeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFreezeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFreezeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFreezeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFreezeResponseValidationError) ErrorName() string {
+	return "DeleteFreezeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFreezeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFreezeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFreezeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFreezeResponseValidationError{}
+
+// Validate checks the field values on ListFreezesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFreezesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFreezesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFreezesRequestMultiError, or nil if none found.
+func (m *ListFreezesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFreezesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Environment
+
+	if all {
+		switch v := interface{}(m.GetPerPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFreezesRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFreezesRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFreezesRequestValidationError{
+				field:  "PerPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFreezesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFreezesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFreezesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListFreezesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFreezesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFreezesRequestMultiError) AllErrors() []error { return m }
+
+// ListFreezesRequestValidationError is the validation error returned by
+// ListFreezesRequest.Validate if the designated constraints aren't met.
+type ListFreezesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFreezesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFreezesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFreezesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFreezesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFreezesRequestValidationError) ErrorName() string {
+	return "ListFreezesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFreezesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFreezesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFreezesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFreezesRequestValidationError{}
+
+// Validate checks the field values on ListFreezesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFreezesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFreezesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFreezesResponseMultiError, or nil if none found.
+func (m *ListFreezesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFreezesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFreezes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFreezesResponseValidationError{
+						field:  fmt.Sprintf("Freezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFreezesResponseValidationError{
+						field:  fmt.Sprintf("Freezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFreezesResponseValidationError{
+					field:  fmt.Sprintf("Freezes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFreezesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFreezesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFreezesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFreezesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFreezesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFreezesResponseMultiError) AllErrors() []error { return m }
+
+// ListFreezesResponseValidationError is the validation error returned by
+// ListFreezesResponse.Validate if the designated constraints aren't met.
+type ListFreezesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFreezesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFreezesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFreezesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFreezesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFreezesResponseValidationError) ErrorName() string {
+	return "ListFreezesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFreezesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFreezesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFreezesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFreezesResponseValidationError{}
+
+// Validate checks the field values on GetActiveFreezesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetActiveFreezesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActiveFreezesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActiveFreezesRequestMultiError, or nil if none found.
+func (m *GetActiveFreezesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActiveFreezesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Environment
+
+	// no validation rules for Service
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetActiveFreezesRequestValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetActiveFreezesRequestValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetActiveFreezesRequestValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetActiveFreezesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActiveFreezesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetActiveFreezesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetActiveFreezesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActiveFreezesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActiveFreezesRequestMultiError) AllErrors() []error { return m }
+
+// GetActiveFreezesRequestValidationError is the validation error returned by
+// GetActiveFreezesRequest.Validate if the designated constraints aren't met.
+type GetActiveFreezesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActiveFreezesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActiveFreezesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActiveFreezesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActiveFreezesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActiveFreezesRequestValidationError) ErrorName() string {
+	return "GetActiveFreezesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActiveFreezesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActiveFreezesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActiveFreezesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActiveFreezesRequestValidationError{}
+
+// Validate checks the field values on GetActiveFreezesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetActiveFreezesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActiveFreezesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActiveFreezesResponseMultiError, or nil if none found.
+func (m *GetActiveFreezesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActiveFreezesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFreezes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetActiveFreezesResponseValidationError{
+						field:  fmt.Sprintf("Freezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetActiveFreezesResponseValidationError{
+						field:  fmt.Sprintf("Freezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetActiveFreezesResponseValidationError{
+					field:  fmt.Sprintf("Freezes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetActiveFreezesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActiveFreezesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetActiveFreezesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetActiveFreezesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActiveFreezesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActiveFreezesResponseMultiError) AllErrors() []error { return m }
+
+// GetActiveFreezesResponseValidationError is the validation error returned by
+// GetActiveFreezesResponse.Validate if the designated constraints aren't met.
+type GetActiveFreezesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActiveFreezesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActiveFreezesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActiveFreezesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActiveFreezesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActiveFreezesResponseValidationError) ErrorName() string {
+	return "GetActiveFreezesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActiveFreezesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActiveFreezesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActiveFreezesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActiveFreezesResponseValidationError{}
+
+// Validate checks the field values on ActiveFreeze with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ActiveFreeze) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActiveFreeze with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ActiveFreezeMultiError, or
+// nil if none found.
+func (m *ActiveFreeze) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActiveFreeze) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFreeze()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActiveFreezeValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActiveFreezeValidationError{
+					field:  "Freeze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreeze()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActiveFreezeValidationError{
+				field:  "Freeze",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActiveFreezeValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActiveFreezeValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActiveFreezeValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ActiveFreezeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActiveFreezeMultiError is an error wrapping multiple validation errors
+// returned by ActiveFreeze.ValidateAll() if the designated constraints aren't met.
+type ActiveFreezeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActiveFreezeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActiveFreezeMultiError) AllErrors() []error { return m }
+
+// ActiveFreezeValidationError is the validation error returned by
+// ActiveFreeze.Validate if the designated constraints aren't met.
+type ActiveFreezeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActiveFreezeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActiveFreezeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActiveFreezeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActiveFreezeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActiveFreezeValidationError) ErrorName() string { return "ActiveFreezeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActiveFreezeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActiveFreeze.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActiveFreezeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActiveFreezeValidationError{}
+
+// Validate checks the field values on RequestFreezeExceptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestFreezeExceptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestFreezeExceptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestFreezeExceptionRequestMultiError, or nil if none found.
+func (m *RequestFreezeExceptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestFreezeExceptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FreezeId
+
+	// no validation rules for Service
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RequestFreezeExceptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestFreezeExceptionRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestFreezeExceptionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RequestFreezeExceptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestFreezeExceptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestFreezeExceptionRequestMultiError) AllErrors() []error { return m }
+
+// RequestFreezeExceptionRequestValidationError is the validation error
+// returned by RequestFreezeExceptionRequest.Validate if the designated
+// constraints aren't met.
+type RequestFreezeExceptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestFreezeExceptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestFreezeExceptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestFreezeExceptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestFreezeExceptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestFreezeExceptionRequestValidationError) ErrorName() string {
+	return "RequestFreezeExceptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestFreezeExceptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestFreezeExceptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestFreezeExceptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestFreezeExceptionRequestValidationError{}
+
+// Validate checks the field values on RequestFreezeExceptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestFreezeExceptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestFreezeExceptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestFreezeExceptionResponseMultiError, or nil if none found.
+func (m *RequestFreezeExceptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestFreezeExceptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetException()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestFreezeExceptionResponseValidationError{
+					field:  "Exception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestFreezeExceptionResponseValidationError{
+					field:  "Exception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetException()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestFreezeExceptionResponseValidationError{
+				field:  "Exception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestFreezeExceptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestFreezeExceptionResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestFreezeExceptionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RequestFreezeExceptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestFreezeExceptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestFreezeExceptionResponseMultiError) AllErrors() []error { return m }
+
+// RequestFreezeExceptionResponseValidationError is the validation error
+// returned by RequestFreezeExceptionResponse.Validate if the designated
+// constraints aren't met.
+type RequestFreezeExceptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestFreezeExceptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestFreezeExceptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestFreezeExceptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestFreezeExceptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestFreezeExceptionResponseValidationError) ErrorName() string {
+	return "RequestFreezeExceptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestFreezeExceptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestFreezeExceptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestFreezeExceptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestFreezeExceptionResponseValidationError{}
+
+// Validate checks the field values on ApproveFreezeExceptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveFreezeExceptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveFreezeExceptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveFreezeExceptionRequestMultiError, or nil if none found.
+func (m *ApproveFreezeExceptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveFreezeExceptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FreezeId
+
+	// no validation rules for ExceptionId
+
+	// no validation rules for ApprovedBy
+
+	if len(errors) > 0 {
+		return ApproveFreezeExceptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveFreezeExceptionRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveFreezeExceptionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveFreezeExceptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveFreezeExceptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveFreezeExceptionRequestMultiError) AllErrors() []error { return m }
+
+// ApproveFreezeExceptionRequestValidationError is the validation error
+// returned by ApproveFreezeExceptionRequest.Validate if the designated
+// constraints aren't met.
+type ApproveFreezeExceptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveFreezeExceptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveFreezeExceptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveFreezeExceptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveFreezeExceptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveFreezeExceptionRequestValidationError) ErrorName() string {
+	return "ApproveFreezeExceptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveFreezeExceptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveFreezeExceptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveFreezeExceptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveFreezeExceptionRequestValidationError{}
+
+// Validate checks the field values on ApproveFreezeExceptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveFreezeExceptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveFreezeExceptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveFreezeExceptionResponseMultiError, or nil if none found.
+func (m *ApproveFreezeExceptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveFreezeExceptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetException()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveFreezeExceptionResponseValidationError{
+					field:  "Exception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveFreezeExceptionResponseValidationError{
+					field:  "Exception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetException()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveFreezeExceptionResponseValidationError{
+				field:  "Exception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveFreezeExceptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveFreezeExceptionResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveFreezeExceptionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveFreezeExceptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveFreezeExceptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveFreezeExceptionResponseMultiError) AllErrors() []error { return m }
+
+// ApproveFreezeExceptionResponseValidationError is the validation error
+// returned by ApproveFreezeExceptionResponse.Validate if the designated
+// constraints aren't met.
+type ApproveFreezeExceptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveFreezeExceptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveFreezeExceptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveFreezeExceptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveFreezeExceptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveFreezeExceptionResponseValidationError) ErrorName() string {
+	return "ApproveFreezeExceptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveFreezeExceptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveFreezeExceptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveFreezeExceptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveFreezeExceptionResponseValidationError{}
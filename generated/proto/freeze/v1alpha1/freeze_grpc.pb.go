@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/freeze/v1alpha1/freeze.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FreezeService_CreateFreeze_FullMethodName           = "/tracker.freeze.v1alpha1.FreezeService/CreateFreeze"
+	FreezeService_GetFreeze_FullMethodName              = "/tracker.freeze.v1alpha1.FreezeService/GetFreeze"
+	FreezeService_UpdateFreeze_FullMethodName           = "/tracker.freeze.v1alpha1.FreezeService/UpdateFreeze"
+	FreezeService_DeleteFreeze_FullMethodName           = "/tracker.freeze.v1alpha1.FreezeService/DeleteFreeze"
+	FreezeService_ListFreezes_FullMethodName            = "/tracker.freeze.v1alpha1.FreezeService/ListFreezes"
+	FreezeService_GetActiveFreezes_FullMethodName       = "/tracker.freeze.v1alpha1.FreezeService/GetActiveFreezes"
+	FreezeService_RequestFreezeException_FullMethodName = "/tracker.freeze.v1alpha1.FreezeService/RequestFreezeException"
+	FreezeService_ApproveFreezeException_FullMethodName = "/tracker.freeze.v1alpha1.FreezeService/ApproveFreezeException"
+)
+
+// FreezeServiceClient is the client API for FreezeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FreezeService declares the change freezes, periods during which deployments are refused
+type FreezeServiceClient interface {
+	CreateFreeze(ctx context.Context, in *CreateFreezeRequest, opts ...grpc.CallOption) (*CreateFreezeResponse, error)
+	GetFreeze(ctx context.Context, in *GetFreezeRequest, opts ...grpc.CallOption) (*GetFreezeResponse, error)
+	UpdateFreeze(ctx context.Context, in *UpdateFreezeRequest, opts ...grpc.CallOption) (*UpdateFreezeResponse, error)
+	DeleteFreeze(ctx context.Context, in *DeleteFreezeRequest, opts ...grpc.CallOption) (*DeleteFreezeResponse, error)
+	ListFreezes(ctx context.Context, in *ListFreezesRequest, opts ...grpc.CallOption) (*ListFreezesResponse, error)
+	// GetActiveFreezes returns the freezes in force at a date, now by default
+	GetActiveFreezes(ctx context.Context, in *GetActiveFreezesRequest, opts ...grpc.CallOption) (*GetActiveFreezesResponse, error)
+	// RequestFreezeException asks to deploy a service during a freeze
+	RequestFreezeException(ctx context.Context, in *RequestFreezeExceptionRequest, opts ...grpc.CallOption) (*RequestFreezeExceptionResponse, error)
+	// ApproveFreezeException approves an exception, someone else than its requester must approve it
+	ApproveFreezeException(ctx context.Context, in *ApproveFreezeExceptionRequest, opts ...grpc.CallOption) (*ApproveFreezeExceptionResponse, error)
+}
+
+type freezeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFreezeServiceClient(cc grpc.ClientConnInterface) FreezeServiceClient {
+	return &freezeServiceClient{cc}
+}
+
+func (c *freezeServiceClient) CreateFreeze(ctx context.Context, in *CreateFreezeRequest, opts ...grpc.CallOption) (*CreateFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFreezeResponse)
+	err := c.cc.Invoke(ctx, FreezeService_CreateFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) GetFreeze(ctx context.Context, in *GetFreezeRequest, opts ...grpc.CallOption) (*GetFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFreezeResponse)
+	err := c.cc.Invoke(ctx, FreezeService_GetFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) UpdateFreeze(ctx context.Context, in *UpdateFreezeRequest, opts ...grpc.CallOption) (*UpdateFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFreezeResponse)
+	err := c.cc.Invoke(ctx, FreezeService_UpdateFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) DeleteFreeze(ctx context.Context, in *DeleteFreezeRequest, opts ...grpc.CallOption) (*DeleteFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFreezeResponse)
+	err := c.cc.Invoke(ctx, FreezeService_DeleteFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) ListFreezes(ctx context.Context, in *ListFreezesRequest, opts ...grpc.CallOption) (*ListFreezesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFreezesResponse)
+	err := c.cc.Invoke(ctx, FreezeService_ListFreezes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) GetActiveFreezes(ctx context.Context, in *GetActiveFreezesRequest, opts ...grpc.CallOption) (*GetActiveFreezesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveFreezesResponse)
+	err := c.cc.Invoke(ctx, FreezeService_GetActiveFreezes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) RequestFreezeException(ctx context.Context, in *RequestFreezeExceptionRequest, opts ...grpc.CallOption) (*RequestFreezeExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestFreezeExceptionResponse)
+	err := c.cc.Invoke(ctx, FreezeService_RequestFreezeException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freezeServiceClient) ApproveFreezeException(ctx context.Context, in *ApproveFreezeExceptionRequest, opts ...grpc.CallOption) (*ApproveFreezeExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveFreezeExceptionResponse)
+	err := c.cc.Invoke(ctx, FreezeService_ApproveFreezeException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FreezeServiceServer is the server API for FreezeService service.
+// All implementations must embed UnimplementedFreezeServiceServer
+// for forward compatibility.
+//
+// FreezeService declares the change freezes, periods during which deployments are refused
+type FreezeServiceServer interface {
+	CreateFreeze(context.Context, *CreateFreezeRequest) (*CreateFreezeResponse, error)
+	GetFreeze(context.Context, *GetFreezeRequest) (*GetFreezeResponse, error)
+	UpdateFreeze(context.Context, *UpdateFreezeRequest) (*UpdateFreezeResponse, error)
+	DeleteFreeze(context.Context, *DeleteFreezeRequest) (*DeleteFreezeResponse, error)
+	ListFreezes(context.Context, *ListFreezesRequest) (*ListFreezesResponse, error)
+	// GetActiveFreezes returns the freezes in force at a date, now by default
+	GetActiveFreezes(context.Context, *GetActiveFreezesRequest) (*GetActiveFreezesResponse, error)
+	// RequestFreezeException asks to deploy a service during a freeze
+	RequestFreezeException(context.Context, *RequestFreezeExceptionRequest) (*RequestFreezeExceptionResponse, error)
+	// ApproveFreezeException approves an exception, someone else than its requester must approve it
+	ApproveFreezeException(context.Context, *ApproveFreezeExceptionRequest) (*ApproveFreezeExceptionResponse, error)
+	mustEmbedUnimplementedFreezeServiceServer()
+}
+
+// UnimplementedFreezeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFreezeServiceServer struct{}
+
+func (UnimplementedFreezeServiceServer) CreateFreeze(context.Context, *CreateFreezeRequest) (*CreateFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFreeze not implemented")
+}
+func (UnimplementedFreezeServiceServer) GetFreeze(context.Context, *GetFreezeRequest) (*GetFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeze not implemented")
+}
+func (UnimplementedFreezeServiceServer) UpdateFreeze(context.Context, *UpdateFreezeRequest) (*UpdateFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreeze not implemented")
+}
+func (UnimplementedFreezeServiceServer) DeleteFreeze(context.Context, *DeleteFreezeRequest) (*DeleteFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFreeze not implemented")
+}
+func (UnimplementedFreezeServiceServer) ListFreezes(context.Context, *ListFreezesRequest) (*ListFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFreezes not implemented")
+}
+func (UnimplementedFreezeServiceServer) GetActiveFreezes(context.Context, *GetActiveFreezesRequest) (*GetActiveFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveFreezes not implemented")
+}
+func (UnimplementedFreezeServiceServer) RequestFreezeException(context.Context, *RequestFreezeExceptionRequest) (*RequestFreezeExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestFreezeException not implemented")
+}
+func (UnimplementedFreezeServiceServer) ApproveFreezeException(context.Context, *ApproveFreezeExceptionRequest) (*ApproveFreezeExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFreezeException not implemented")
+}
+func (UnimplementedFreezeServiceServer) mustEmbedUnimplementedFreezeServiceServer() {}
+func (UnimplementedFreezeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeFreezeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FreezeServiceServer will
+// result in compilation errors.
+type UnsafeFreezeServiceServer interface {
+	mustEmbedUnimplementedFreezeServiceServer()
+}
+
+func RegisterFreezeServiceServer(s grpc.ServiceRegistrar, srv FreezeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFreezeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FreezeService_ServiceDesc, srv)
+}
+
+func _FreezeService_CreateFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).CreateFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_CreateFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).CreateFreeze(ctx, req.(*CreateFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_GetFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).GetFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_GetFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).GetFreeze(ctx, req.(*GetFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_UpdateFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).UpdateFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_UpdateFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).UpdateFreeze(ctx, req.(*UpdateFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_DeleteFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).DeleteFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_DeleteFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).DeleteFreeze(ctx, req.(*DeleteFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_ListFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).ListFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_ListFreezes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).ListFreezes(ctx, req.(*ListFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_GetActiveFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).GetActiveFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_GetActiveFreezes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).GetActiveFreezes(ctx, req.(*GetActiveFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_RequestFreezeException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestFreezeExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).RequestFreezeException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_RequestFreezeException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).RequestFreezeException(ctx, req.(*RequestFreezeExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreezeService_ApproveFreezeException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFreezeExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreezeServiceServer).ApproveFreezeException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreezeService_ApproveFreezeException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreezeServiceServer).ApproveFreezeException(ctx, req.(*ApproveFreezeExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FreezeService_ServiceDesc is the grpc.ServiceDesc for FreezeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FreezeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tracker.freeze.v1alpha1.FreezeService",
+	HandlerType: (*FreezeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFreeze",
+			Handler:    _FreezeService_CreateFreeze_Handler,
+		},
+		{
+			MethodName: "GetFreeze",
+			Handler:    _FreezeService_GetFreeze_Handler,
+		},
+		{
+			MethodName: "UpdateFreeze",
+			Handler:    _FreezeService_UpdateFreeze_Handler,
+		},
+		{
+			MethodName: "DeleteFreeze",
+			Handler:    _FreezeService_DeleteFreeze_Handler,
+		},
+		{
+			MethodName: "ListFreezes",
+			Handler:    _FreezeService_ListFreezes_Handler,
+		},
+		{
+			MethodName: "GetActiveFreezes",
+			Handler:    _FreezeService_GetActiveFreezes_Handler,
+		},
+		{
+			MethodName: "RequestFreezeException",
+			Handler:    _FreezeService_RequestFreezeException_Handler,
+		},
+		{
+			MethodName: "ApproveFreezeException",
+			Handler:    _FreezeService_ApproveFreezeException_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/freeze/v1alpha1/freeze.proto",
+}
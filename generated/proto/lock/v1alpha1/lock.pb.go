@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.10
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: proto/lock/v1alpha1/lock.proto
 
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -25,15 +26,136 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// LockMode tells whether a lock can be held by several holders at once
+type LockMode int32
+
+const (
+	// exclusive
+	LockMode_LOCK_MODE_UNSPECIFIED LockMode = 0
+	// the only holder of the service, environment and resource
+	LockMode_exclusive LockMode = 1
+	// held alongside other shared locks, never alongside an exclusive one
+	LockMode_shared LockMode = 2
+)
+
+// Enum value maps for LockMode.
+var (
+	LockMode_name = map[int32]string{
+		0: "LOCK_MODE_UNSPECIFIED",
+		1: "exclusive",
+		2: "shared",
+	}
+	LockMode_value = map[string]int32{
+		"LOCK_MODE_UNSPECIFIED": 0,
+		"exclusive":             1,
+		"shared":                2,
+	}
+)
+
+func (x LockMode) Enum() *LockMode {
+	p := new(LockMode)
+	*p = x
+	return p
+}
+
+func (x LockMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_lock_v1alpha1_lock_proto_enumTypes[0].Descriptor()
+}
+
+func (LockMode) Type() protoreflect.EnumType {
+	return &file_proto_lock_v1alpha1_lock_proto_enumTypes[0]
+}
+
+func (x LockMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockMode.Descriptor instead.
+func (LockMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{0}
+}
+
+type LockHistoryAction int32
+
+const (
+	LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED LockHistoryAction = 0
+	LockHistoryAction_acquired                        LockHistoryAction = 1
+	LockHistoryAction_updated                         LockHistoryAction = 2
+	LockHistoryAction_renewed                         LockHistoryAction = 3
+	LockHistoryAction_released                        LockHistoryAction = 4
+	LockHistoryAction_expired                         LockHistoryAction = 5
+	LockHistoryAction_force_released                  LockHistoryAction = 6
+)
+
+// Enum value maps for LockHistoryAction.
+var (
+	LockHistoryAction_name = map[int32]string{
+		0: "LOCK_HISTORY_ACTION_UNSPECIFIED",
+		1: "acquired",
+		2: "updated",
+		3: "renewed",
+		4: "released",
+		5: "expired",
+		6: "force_released",
+	}
+	LockHistoryAction_value = map[string]int32{
+		"LOCK_HISTORY_ACTION_UNSPECIFIED": 0,
+		"acquired":                        1,
+		"updated":                         2,
+		"renewed":                         3,
+		"released":                        4,
+		"expired":                         5,
+		"force_released":                  6,
+	}
+)
+
+func (x LockHistoryAction) Enum() *LockHistoryAction {
+	p := new(LockHistoryAction)
+	*p = x
+	return p
+}
+
+func (x LockHistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockHistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_lock_v1alpha1_lock_proto_enumTypes[1].Descriptor()
+}
+
+func (LockHistoryAction) Type() protoreflect.EnumType {
+	return &file_proto_lock_v1alpha1_lock_proto_enumTypes[1]
+}
+
+func (x LockHistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockHistoryAction.Descriptor instead.
+func (LockHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{1}
+}
+
 type Lock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 	Who           string                 `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Environment   string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`        // Environment where the lock applies
-	Resource      string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`              // Resource type (deployment, operation)
-	EventId       string                 `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Associated event ID
+	Environment   string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`                           // Environment where the lock applies
+	Resource      string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`                                 // Resource type (deployment, operation)
+	EventId       string                 `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                    // Associated event ID
+	Ttl           *durationpb.Duration   `protobuf:"bytes,8,opt,name=ttl,proto3" json:"ttl,omitempty"`                                           // Lifetime granted by each creation or renewal, unset if the lock never expires
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`              // The lock is released once this date has passed
+	Waiters       []*LockWaiter          `protobuf:"bytes,10,rep,name=waiters,proto3" json:"waiters,omitempty"`                                  // Callers of AcquireLock queued for this lock, in order
+	Mode          LockMode               `protobuf:"varint,11,opt,name=mode,proto3,enum=tracker.lock.v1alpha1.LockMode" json:"mode,omitempty"`   // exclusive when unspecified
+	BundleId      string                 `protobuf:"bytes,12,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`                // Bundle the lock was taken with, see CreateLockBundle
+	ReleaseId     string                 `protobuf:"bytes,13,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`             // Release of the bundle, events of this release share the lock
+	ReservationId string                 `protobuf:"bytes,14,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Reservation the lock was taken for, see CreateReservation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,20 +239,148 @@ func (x *Lock) GetEventId() string {
 	return ""
 }
 
-type CreateLockRequest struct {
+func (x *Lock) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Lock) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Lock) GetWaiters() []*LockWaiter {
+	if x != nil {
+		return x.Waiters
+	}
+	return nil
+}
+
+func (x *Lock) GetMode() LockMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LockMode_LOCK_MODE_UNSPECIFIED
+}
+
+func (x *Lock) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *Lock) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *Lock) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type LockWaiter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Who           string                 `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
-	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
-	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
-	EventId       string                 `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	WaiterId      string                 `protobuf:"bytes,1,opt,name=waiter_id,json=waiterId,proto3" json:"waiter_id,omitempty"`
+	Who           string                 `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Position      uint32                 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` // 1 is the next to get the lock
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockWaiter) Reset() {
+	*x = LockWaiter{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockWaiter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockWaiter) ProtoMessage() {}
+
+func (x *LockWaiter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockWaiter.ProtoReflect.Descriptor instead.
+func (*LockWaiter) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LockWaiter) GetWaiterId() string {
+	if x != nil {
+		return x.WaiterId
+	}
+	return ""
+}
+
+func (x *LockWaiter) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *LockWaiter) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *LockWaiter) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LockWaiter) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type CreateLockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Who         string                 `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
+	Environment string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource    string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	EventId     string                 `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The lock expires unless renewed within ttl, it never expires when unset
+	Ttl           *durationpb.Duration `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Mode          LockMode             `protobuf:"varint,8,opt,name=mode,proto3,enum=tracker.lock.v1alpha1.LockMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLockRequest) Reset() {
 	*x = CreateLockRequest{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[1]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +392,7 @@ func (x *CreateLockRequest) String() string {
 func (*CreateLockRequest) ProtoMessage() {}
 
 func (x *CreateLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[1]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +405,7 @@ func (x *CreateLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLockRequest.ProtoReflect.Descriptor instead.
 func (*CreateLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{1}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateLockRequest) GetService() string {
@@ -193,6 +443,20 @@ func (x *CreateLockRequest) GetEventId() string {
 	return ""
 }
 
+func (x *CreateLockRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *CreateLockRequest) GetMode() LockMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LockMode_LOCK_MODE_UNSPECIFIED
+}
+
 type CreateLockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lock          *Lock                  `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
@@ -202,7 +466,7 @@ type CreateLockResponse struct {
 
 func (x *CreateLockResponse) Reset() {
 	*x = CreateLockResponse{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[2]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +478,7 @@ func (x *CreateLockResponse) String() string {
 func (*CreateLockResponse) ProtoMessage() {}
 
 func (x *CreateLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[2]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +491,7 @@ func (x *CreateLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLockResponse.ProtoReflect.Descriptor instead.
 func (*CreateLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{2}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateLockResponse) GetLock() *Lock {
@@ -246,7 +510,7 @@ type GetLockRequest struct {
 
 func (x *GetLockRequest) Reset() {
 	*x = GetLockRequest{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[3]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +522,7 @@ func (x *GetLockRequest) String() string {
 func (*GetLockRequest) ProtoMessage() {}
 
 func (x *GetLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[3]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +535,7 @@ func (x *GetLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLockRequest.ProtoReflect.Descriptor instead.
 func (*GetLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{3}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLockRequest) GetId() string {
@@ -290,7 +554,7 @@ type GetLockResponse struct {
 
 func (x *GetLockResponse) Reset() {
 	*x = GetLockResponse{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[4]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +566,7 @@ func (x *GetLockResponse) String() string {
 func (*GetLockResponse) ProtoMessage() {}
 
 func (x *GetLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[4]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +579,7 @@ func (x *GetLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLockResponse.ProtoReflect.Descriptor instead.
 func (*GetLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{4}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLockResponse) GetLock() *Lock {
@@ -326,20 +590,23 @@ func (x *GetLockResponse) GetLock() *Lock {
 }
 
 type UpdateLockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Who           string                 `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
-	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
-	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
-	EventId       string                 `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// service, environment and resource cannot be changed, they are only
+	// accepted when equal to those of the lock
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Who updates the lock, must be its holder: the holder cannot be changed
+	Who           string `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
+	Environment   string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource      string `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	EventId       string `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateLockRequest) Reset() {
 	*x = UpdateLockRequest{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[5]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +618,7 @@ func (x *UpdateLockRequest) String() string {
 func (*UpdateLockRequest) ProtoMessage() {}
 
 func (x *UpdateLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[5]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +631,7 @@ func (x *UpdateLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLockRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{5}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateLockRequest) GetId() string {
@@ -418,7 +685,7 @@ type UpdateLockResponse struct {
 
 func (x *UpdateLockResponse) Reset() {
 	*x = UpdateLockResponse{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[6]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +697,7 @@ func (x *UpdateLockResponse) String() string {
 func (*UpdateLockResponse) ProtoMessage() {}
 
 func (x *UpdateLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[6]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +710,7 @@ func (x *UpdateLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLockResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{6}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateLockResponse) GetLock() *Lock {
@@ -454,15 +721,17 @@ func (x *UpdateLockResponse) GetLock() *Lock {
 }
 
 type UnLockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Who releases the lock, must be its holder
+	Who           string `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UnLockRequest) Reset() {
 	*x = UnLockRequest{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[7]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +743,7 @@ func (x *UnLockRequest) String() string {
 func (*UnLockRequest) ProtoMessage() {}
 
 func (x *UnLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[7]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +756,7 @@ func (x *UnLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnLockRequest.ProtoReflect.Descriptor instead.
 func (*UnLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{7}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UnLockRequest) GetId() string {
@@ -497,6 +766,74 @@ func (x *UnLockRequest) GetId() string {
 	return ""
 }
 
+func (x *UnLockRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+type ForceUnlockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Who forces the release
+	Who           string `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceUnlockRequest) Reset() {
+	*x = ForceUnlockRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceUnlockRequest) ProtoMessage() {}
+
+func (x *ForceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*ForceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ForceUnlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceUnlockRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *ForceUnlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type UnLockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -508,7 +845,7 @@ type UnLockResponse struct {
 
 func (x *UnLockResponse) Reset() {
 	*x = UnLockResponse{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[8]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +857,7 @@ func (x *UnLockResponse) String() string {
 func (*UnLockResponse) ProtoMessage() {}
 
 func (x *UnLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[8]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +870,7 @@ func (x *UnLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnLockResponse.ProtoReflect.Descriptor instead.
 func (*UnLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{8}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UnLockResponse) GetMessage() string {
@@ -558,16 +895,20 @@ func (x *UnLockResponse) GetCount() int64 {
 }
 
 type ListLocksRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	PerPage       *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Page          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	PerPage *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page    *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// sort field, "-" prefixed for descending order (e.g. "-created_at")
+	Sort string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, replaces page
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLocksRequest) Reset() {
 	*x = ListLocksRequest{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[9]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +920,7 @@ func (x *ListLocksRequest) String() string {
 func (*ListLocksRequest) ProtoMessage() {}
 
 func (x *ListLocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[9]
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +933,7 @@ func (x *ListLocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLocksRequest.ProtoReflect.Descriptor instead.
 func (*ListLocksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{9}
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListLocksRequest) GetPerPage() *wrapperspb.UInt32Value {
@@ -609,29 +950,1396 @@ func (x *ListLocksRequest) GetPage() *wrapperspb.Int32Value {
 	return nil
 }
 
-type ListLocksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locks         []*Lock                `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *ListLocksRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListLocksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*Lock                `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocksResponse) Reset() {
+	*x = ListLocksResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocksResponse) ProtoMessage() {}
+
+func (x *ListLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocksResponse.ProtoReflect.Descriptor instead.
+func (*ListLocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListLocksResponse) GetLocks() []*Lock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *ListLocksResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListLocksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LockBundleEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Mode          LockMode               `protobuf:"varint,4,opt,name=mode,proto3,enum=tracker.lock.v1alpha1.LockMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockBundleEntry) Reset() {
+	*x = LockBundleEntry{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockBundleEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockBundleEntry) ProtoMessage() {}
+
+func (x *LockBundleEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockBundleEntry.ProtoReflect.Descriptor instead.
+func (*LockBundleEntry) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LockBundleEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *LockBundleEntry) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *LockBundleEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *LockBundleEntry) GetMode() LockMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LockMode_LOCK_MODE_UNSPECIFIED
+}
+
+type CreateLockBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Locks []*LockBundleEntry     `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	Who   string                 `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	// Events created with this release id share the locks of the bundle
+	ReleaseId string `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// The locks expire unless renewed within ttl, they never expire when unset
+	Ttl           *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLockBundleRequest) Reset() {
+	*x = CreateLockBundleRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLockBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLockBundleRequest) ProtoMessage() {}
+
+func (x *CreateLockBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLockBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateLockBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateLockBundleRequest) GetLocks() []*LockBundleEntry {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *CreateLockBundleRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *CreateLockBundleRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *CreateLockBundleRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type CreateLockBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Locks         []*Lock                `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLockBundleResponse) Reset() {
+	*x = CreateLockBundleResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLockBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLockBundleResponse) ProtoMessage() {}
+
+func (x *CreateLockBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLockBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateLockBundleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateLockBundleResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *CreateLockBundleResponse) GetLocks() []*Lock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type ReleaseLockBundleRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	BundleId string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// Who releases the bundle, must be its holder
+	Who           string `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLockBundleRequest) Reset() {
+	*x = ReleaseLockBundleRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLockBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLockBundleRequest) ProtoMessage() {}
+
+func (x *ReleaseLockBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLockBundleRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLockBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseLockBundleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *ReleaseLockBundleRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+type ReleaseLockBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLockBundleResponse) Reset() {
+	*x = ReleaseLockBundleResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLockBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLockBundleResponse) ProtoMessage() {}
+
+func (x *ReleaseLockBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLockBundleResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLockBundleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReleaseLockBundleResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *ReleaseLockBundleResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Reservation books an environment, or a service of it, for a team over a period
+type Reservation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Reserved service, every service of the environment when empty
+	Service string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// Team holding the reservation
+	Owner     string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Purpose   string                 `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Lock held for the reservation once started
+	LockId        string `protobuf:"bytes,9,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Reservation) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Reservation) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Reservation) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *Reservation) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Reservation) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+type CreateReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Purpose       string                 `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateReservationRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type GetReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationRequest) Reset() {
+	*x = GetReservationRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationRequest) ProtoMessage() {}
+
+func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationResponse) Reset() {
+	*x = GetReservationResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationResponse) ProtoMessage() {}
+
+func (x *GetReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type CancelReservationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Who cancels the reservation, must be its owner
+	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelReservationRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelReservationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListReservationsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Environment string                 `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Reservations of this service, or of its whole environment
+	Service string                  `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Owner   string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PerPage *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// next_page_token of the previous page
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListReservationsRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetPerPage() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerPage
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *ListReservationsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListReservationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RenewLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New lifetime of the lock, the ttl of the lock when unset
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewLockRequest) Reset() {
+	*x = RenewLockRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLockRequest) ProtoMessage() {}
+
+func (x *RenewLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLockRequest.ProtoReflect.Descriptor instead.
+func (*RenewLockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenewLockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenewLockRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type RenewLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lock          *Lock                  `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewLockResponse) Reset() {
+	*x = RenewLockResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLockResponse) ProtoMessage() {}
+
+func (x *RenewLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLockResponse.ProtoReflect.Descriptor instead.
+func (*RenewLockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RenewLockResponse) GetLock() *Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+type AcquireLockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Who         string                 `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	Environment string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource    string                 `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	EventId     string                 `protobuf:"bytes,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// ttl of the lock once granted, it never expires when unset
+	Ttl *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// How long to wait for the lock, 5 minutes when unset
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Resumes the wait of a previous call, keeping its place in the queue
+	WaiterId      string   `protobuf:"bytes,8,opt,name=waiter_id,json=waiterId,proto3" json:"waiter_id,omitempty"`
+	Mode          LockMode `protobuf:"varint,9,opt,name=mode,proto3,enum=tracker.lock.v1alpha1.LockMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireLockRequest) Reset() {
+	*x = AcquireLockRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireLockRequest) ProtoMessage() {}
+
+func (x *AcquireLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireLockRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AcquireLockRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *AcquireLockRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *AcquireLockRequest) GetWaiterId() string {
+	if x != nil {
+		return x.WaiterId
+	}
+	return ""
+}
+
+func (x *AcquireLockRequest) GetMode() LockMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LockMode_LOCK_MODE_UNSPECIFIED
+}
+
+type AcquireLockResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	WaiterId string                 `protobuf:"bytes,1,opt,name=waiter_id,json=waiterId,proto3" json:"waiter_id,omitempty"`
+	// Place in the queue while waiting, 0 once the lock is granted
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// Current holder of the lock while waiting
+	Holder *Lock `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// The lock, once granted
+	Lock          *Lock `protobuf:"bytes,4,opt,name=lock,proto3" json:"lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireLockResponse) Reset() {
+	*x = AcquireLockResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireLockResponse) ProtoMessage() {}
+
+func (x *AcquireLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireLockResponse.ProtoReflect.Descriptor instead.
+func (*AcquireLockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AcquireLockResponse) GetWaiterId() string {
+	if x != nil {
+		return x.WaiterId
+	}
+	return ""
+}
+
+func (x *AcquireLockResponse) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *AcquireLockResponse) GetHolder() *Lock {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+func (x *AcquireLockResponse) GetLock() *Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+// LockHistoryEntry records one change of a lock. Entries are never updated nor deleted.
+type LockHistoryEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LockId      string                 `protobuf:"bytes,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Action      LockHistoryAction      `protobuf:"varint,3,opt,name=action,proto3,enum=tracker.lock.v1alpha1.LockHistoryAction" json:"action,omitempty"`
+	Service     string                 `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Environment string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource    string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Holder of the lock
+	Who     string `protobuf:"bytes,7,opt,name=who,proto3" json:"who,omitempty"`
+	EventId string `protobuf:"bytes,8,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Who made the change, "system" for expiries
+	Actor     string                 `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason    string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Creation date of the lock
+	LockedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	// How long the lock was held, set on release and expiry
+	HeldFor       *durationpb.Duration `protobuf:"bytes,13,opt,name=held_for,json=heldFor,proto3" json:"held_for,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockHistoryEntry) Reset() {
+	*x = LockHistoryEntry{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockHistoryEntry) ProtoMessage() {}
+
+func (x *LockHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LockHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LockHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetAction() LockHistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED
+}
+
+func (x *LockHistoryEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LockHistoryEntry) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+func (x *LockHistoryEntry) GetHeldFor() *durationpb.Duration {
+	if x != nil {
+		return x.HeldFor
+	}
+	return nil
+}
+
+type ListLockHistoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Matches the holder of the lock or the author of the change
+	Who       string                  `protobuf:"bytes,3,opt,name=who,proto3" json:"who,omitempty"`
+	LockId    string                  `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	StartDate string                  `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                  `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PerPage   *wrapperspb.UInt32Value `protobuf:"bytes,7,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page      *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=page,proto3" json:"page,omitempty"`
+	// sort field, "-" prefixed for descending order (e.g. "-timestamp")
+	Sort string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, replaces page
+	PageToken     string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLocksResponse) Reset() {
-	*x = ListLocksResponse{}
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[10]
+func (x *ListLockHistoryRequest) Reset() {
+	*x = ListLockHistoryRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLocksResponse) String() string {
+func (x *ListLockHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLocksResponse) ProtoMessage() {}
+func (*ListLockHistoryRequest) ProtoMessage() {}
 
-func (x *ListLocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[10]
+func (x *ListLockHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,30 +2350,279 @@ func (x *ListLocksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLocksResponse.ProtoReflect.Descriptor instead.
-func (*ListLocksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListLockHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLockHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListLocksResponse) GetLocks() []*Lock {
+func (x *ListLockHistoryRequest) GetService() string {
 	if x != nil {
-		return x.Locks
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetPerPage() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerPage
 	}
 	return nil
 }
 
-func (x *ListLocksResponse) GetTotalCount() uint32 {
+func (x *ListLockHistoryRequest) GetPage() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListLockHistoryRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListLockHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLockHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LockHistoryEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLockHistoryResponse) Reset() {
+	*x = ListLockHistoryResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLockHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLockHistoryResponse) ProtoMessage() {}
+
+func (x *ListLockHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLockHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLockHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListLockHistoryResponse) GetEntries() []*LockHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLockHistoryResponse) GetTotalCount() uint32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
+func (x *ListLockHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request for the changes of the locks matching the filter, unset fields match any lock
+type WatchLocksRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	Resource    string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// resume_token of the last change received, to first get the changes made since
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLocksRequest) Reset() {
+	*x = WatchLocksRequest{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLocksRequest) ProtoMessage() {}
+
+func (x *WatchLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLocksRequest.ProtoReflect.Descriptor instead.
+func (*WatchLocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WatchLocksRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *WatchLocksRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *WatchLocksRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *WatchLocksRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// A change of a lock. The first response of a watch has no change, only the
+// resume_token of the current position.
+type WatchLocksResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Change *LockHistoryEntry      `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	// The lock after the change, as it was before its release
+	Lock          *Lock  `protobuf:"bytes,2,opt,name=lock,proto3" json:"lock,omitempty"`
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLocksResponse) Reset() {
+	*x = WatchLocksResponse{}
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLocksResponse) ProtoMessage() {}
+
+func (x *WatchLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_lock_v1alpha1_lock_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLocksResponse.ProtoReflect.Descriptor instead.
+func (*WatchLocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_lock_v1alpha1_lock_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchLocksResponse) GetChange() *LockHistoryEntry {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *WatchLocksResponse) GetLock() *Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+func (x *WatchLocksResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 var File_proto_lock_v1alpha1_lock_proto protoreflect.FileDescriptor
 
 const file_proto_lock_v1alpha1_lock_proto_rawDesc = "" +
 	"\n" +
-	"\x1eproto/lock/v1alpha1/lock.proto\x12\x15tracker.lock.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17validate/validate.proto\"\xe0\x01\n" +
+	"\x1eproto/lock/v1alpha1/lock.proto\x12\x15tracker.lock.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17validate/validate.proto\"\x9d\x04\n" +
 	"\x04Lock\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12\x10\n" +
@@ -674,13 +2631,32 @@ const file_proto_lock_v1alpha1_lock_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
 	"\venvironment\x18\x05 \x01(\tR\venvironment\x12\x1a\n" +
 	"\bresource\x18\x06 \x01(\tR\bresource\x12\x19\n" +
-	"\bevent_id\x18\a \x01(\tR\aeventId\"\x98\x01\n" +
+	"\bevent_id\x18\a \x01(\tR\aeventId\x12+\n" +
+	"\x03ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\awaiters\x18\n" +
+	" \x03(\v2!.tracker.lock.v1alpha1.LockWaiterR\awaiters\x123\n" +
+	"\x04mode\x18\v \x01(\x0e2\x1f.tracker.lock.v1alpha1.LockModeR\x04mode\x12\x1b\n" +
+	"\tbundle_id\x18\f \x01(\tR\bbundleId\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\r \x01(\tR\treleaseId\x12%\n" +
+	"\x0ereservation_id\x18\x0e \x01(\tR\rreservationId\"\xa4\x01\n" +
+	"\n" +
+	"LockWaiter\x12\x1b\n" +
+	"\twaiter_id\x18\x01 \x01(\tR\bwaiterId\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\rR\bposition\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x84\x02\n" +
 	"\x11CreateLockRequest\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12\x10\n" +
 	"\x03who\x18\x03 \x01(\tR\x03who\x12 \n" +
 	"\venvironment\x18\x04 \x01(\tR\venvironment\x12\x1a\n" +
 	"\bresource\x18\x05 \x01(\tR\bresource\x12\x19\n" +
-	"\bevent_id\x18\x06 \x01(\tR\aeventId\"E\n" +
+	"\bevent_id\x18\x06 \x01(\tR\aeventId\x125\n" +
+	"\x03ttl\x18\a \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x03ttl\x123\n" +
+	"\x04mode\x18\b \x01(\x0e2\x1f.tracker.lock.v1alpha1.LockModeR\x04mode\"E\n" +
 	"\x12CreateLockResponse\x12/\n" +
 	"\x04lock\x18\x01 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\" \n" +
 	"\x0eGetLockRequest\x12\x0e\n" +
@@ -695,29 +2671,187 @@ const file_proto_lock_v1alpha1_lock_proto_rawDesc = "" +
 	"\bresource\x18\x05 \x01(\tR\bresource\x12\x19\n" +
 	"\bevent_id\x18\x06 \x01(\tR\aeventId\"E\n" +
 	"\x12UpdateLockResponse\x12/\n" +
-	"\x04lock\x18\x01 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\"\x1f\n" +
+	"\x04lock\x18\x01 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\"1\n" +
 	"\rUnLockRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\"N\n" +
+	"\x12ForceUnlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"Z\n" +
 	"\x0eUnLockResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
 	"\x02id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
 	"\x05count\x18\n" +
-	" \x01(\x03R\x05count\"|\n" +
+	" \x01(\x03R\x05count\"\xaf\x01\n" +
 	"\x10ListLocksRequest\x127\n" +
 	"\bper_page\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
-	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"g\n" +
+	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x8f\x01\n" +
 	"\x11ListLocksResponse\x121\n" +
 	"\x05locks\x18\x01 \x03(\v2\x1b.tracker.lock.v1alpha1.LockR\x05locks\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
-	"totalCount2\x90\x05\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x9e\x01\n" +
+	"\x0fLockBundleEntry\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12 \n" +
+	"\venvironment\x18\x02 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x123\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1f.tracker.lock.v1alpha1.LockModeR\x04mode\"\xbf\x01\n" +
+	"\x17CreateLockBundleRequest\x12<\n" +
+	"\x05locks\x18\x01 \x03(\v2&.tracker.lock.v1alpha1.LockBundleEntryR\x05locks\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x03 \x01(\tR\treleaseId\x125\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x03ttl\"j\n" +
+	"\x18CreateLockBundleResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x121\n" +
+	"\x05locks\x18\x02 \x03(\v2\x1b.tracker.lock.v1alpha1.LockR\x05locks\"I\n" +
+	"\x18ReleaseLockBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\"N\n" +
+	"\x19ReleaseLockBundleResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xbd\x02\n" +
+	"\vReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\venvironment\x18\x02 \x01(\tR\venvironment\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x18\n" +
+	"\apurpose\x18\x05 \x01(\tR\apurpose\x120\n" +
+	"\x05start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
+	"\alock_id\x18\t \x01(\tR\x06lockId\"\xe6\x01\n" +
+	"\x18CreateReservationRequest\x12 \n" +
+	"\venvironment\x18\x01 \x01(\tR\venvironment\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x18\n" +
+	"\apurpose\x18\x04 \x01(\tR\apurpose\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"a\n" +
+	"\x19CreateReservationResponse\x12D\n" +
+	"\vreservation\x18\x01 \x01(\v2\".tracker.lock.v1alpha1.ReservationR\vreservation\"'\n" +
+	"\x15GetReservationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"\x16GetReservationResponse\x12D\n" +
+	"\vreservation\x18\x01 \x01(\v2\".tracker.lock.v1alpha1.ReservationR\vreservation\"@\n" +
+	"\x18CancelReservationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\"+\n" +
+	"\x19CancelReservationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc3\x01\n" +
+	"\x17ListReservationsRequest\x12 \n" +
+	"\venvironment\x18\x01 \x01(\tR\venvironment\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x127\n" +
+	"\bper_page\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xab\x01\n" +
+	"\x18ListReservationsResponse\x12F\n" +
+	"\freservations\x18\x01 \x03(\v2\".tracker.lock.v1alpha1.ReservationR\freservations\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"Y\n" +
+	"\x10RenewLockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x03ttl\"D\n" +
+	"\x11RenewLockResponse\x12/\n" +
+	"\x04lock\x18\x01 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\"\xe1\x02\n" +
+	"\x12AcquireLockRequest\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bresource\x18\x04 \x01(\tR\bresource\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\tR\aeventId\x125\n" +
+	"\x03ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x03ttl\x12=\n" +
+	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\atimeout\x12\x1b\n" +
+	"\twaiter_id\x18\b \x01(\tR\bwaiterId\x123\n" +
+	"\x04mode\x18\t \x01(\x0e2\x1f.tracker.lock.v1alpha1.LockModeR\x04mode\"\xb4\x01\n" +
+	"\x13AcquireLockResponse\x12\x1b\n" +
+	"\twaiter_id\x18\x01 \x01(\tR\bwaiterId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\rR\bposition\x123\n" +
+	"\x06holder\x18\x03 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x06holder\x12/\n" +
+	"\x04lock\x18\x04 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\"\xd9\x03\n" +
+	"\x10LockHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\alock_id\x18\x02 \x01(\tR\x06lockId\x12@\n" +
+	"\x06action\x18\x03 \x01(\x0e2(.tracker.lock.v1alpha1.LockHistoryActionR\x06action\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12 \n" +
+	"\venvironment\x18\x05 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bresource\x18\x06 \x01(\tR\bresource\x12\x10\n" +
+	"\x03who\x18\a \x01(\tR\x03who\x12\x19\n" +
+	"\bevent_id\x18\b \x01(\tR\aeventId\x12\x14\n" +
+	"\x05actor\x18\t \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x128\n" +
+	"\ttimestamp\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x127\n" +
+	"\tlocked_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\blockedAt\x124\n" +
+	"\bheld_for\x18\r \x01(\v2\x19.google.protobuf.DurationR\aheldFor\"\xd6\x02\n" +
+	"\x16ListLockHistoryRequest\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12 \n" +
+	"\venvironment\x18\x02 \x01(\tR\venvironment\x12\x10\n" +
+	"\x03who\x18\x03 \x01(\tR\x03who\x12\x17\n" +
+	"\alock_id\x18\x04 \x01(\tR\x06lockId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x127\n" +
+	"\bper_page\x18\a \x01(\v2\x1c.google.protobuf.UInt32ValueR\aperPage\x12/\n" +
+	"\x04page\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x12\x12\n" +
+	"\x04sort\x18\t \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\"\xa5\x01\n" +
+	"\x17ListLockHistoryResponse\x12A\n" +
+	"\aentries\x18\x01 \x03(\v2'.tracker.lock.v1alpha1.LockHistoryEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x8e\x01\n" +
+	"\x11WatchLocksRequest\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12 \n" +
+	"\venvironment\x18\x02 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\xa9\x01\n" +
+	"\x12WatchLocksResponse\x12?\n" +
+	"\x06change\x18\x01 \x01(\v2'.tracker.lock.v1alpha1.LockHistoryEntryR\x06change\x12/\n" +
+	"\x04lock\x18\x02 \x01(\v2\x1b.tracker.lock.v1alpha1.LockR\x04lock\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken*@\n" +
+	"\bLockMode\x12\x19\n" +
+	"\x15LOCK_MODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\texclusive\x10\x01\x12\n" +
+	"\n" +
+	"\x06shared\x10\x02*\x8f\x01\n" +
+	"\x11LockHistoryAction\x12#\n" +
+	"\x1fLOCK_HISTORY_ACTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bacquired\x10\x01\x12\v\n" +
+	"\aupdated\x10\x02\x12\v\n" +
+	"\arenewed\x10\x03\x12\f\n" +
+	"\breleased\x10\x04\x12\v\n" +
+	"\aexpired\x10\x05\x12\x12\n" +
+	"\x0eforce_released\x10\x062\xd0\x11\n" +
 	"\vLockService\x12\x80\x01\n" +
 	"\n" +
 	"CreateLock\x12(.tracker.lock.v1alpha1.CreateLockRequest\x1a).tracker.lock.v1alpha1.CreateLockResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1alpha1/lock\x12y\n" +
 	"\aGetLock\x12%.tracker.lock.v1alpha1.GetLockRequest\x1a&.tracker.lock.v1alpha1.GetLockResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1alpha1/lock/{id}\x12\x85\x01\n" +
 	"\n" +
-	"UpdateLock\x12(.tracker.lock.v1alpha1.UpdateLockRequest\x1a).tracker.lock.v1alpha1.UpdateLockResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1alpha1/lock/{id}\x12x\n" +
-	"\x06UnLock\x12$.tracker.lock.v1alpha1.UnLockRequest\x1a%.tracker.lock.v1alpha1.UnLockResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1alpha1/unlock/{id}\x12\x80\x01\n" +
-	"\tListLocks\x12'.tracker.lock.v1alpha1.ListLocksRequest\x1a(.tracker.lock.v1alpha1.ListLocksResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1alpha1/locks/listB\x15Z\x13proto/lock/v1alpha1b\x06proto3"
+	"UpdateLock\x12(.tracker.lock.v1alpha1.UpdateLockRequest\x1a).tracker.lock.v1alpha1.UpdateLockResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1alpha1/lock/{id}\x12v\n" +
+	"\x06UnLock\x12$.tracker.lock.v1alpha1.UnLockRequest\x1a%.tracker.lock.v1alpha1.UnLockResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1alpha1/lock/{id}\x12\x90\x01\n" +
+	"\vForceUnlock\x12).tracker.lock.v1alpha1.ForceUnlockRequest\x1a%.tracker.lock.v1alpha1.UnLockResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1alpha1/lock/{id}/force-unlock\x12\x80\x01\n" +
+	"\tListLocks\x12'.tracker.lock.v1alpha1.ListLocksRequest\x1a(.tracker.lock.v1alpha1.ListLocksResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1alpha1/locks/list\x12\x95\x01\n" +
+	"\x0fListLockHistory\x12-.tracker.lock.v1alpha1.ListLockHistoryRequest\x1a..tracker.lock.v1alpha1.ListLockHistoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1alpha1/locks/history\x12h\n" +
+	"\vAcquireLock\x12).tracker.lock.v1alpha1.AcquireLockRequest\x1a*.tracker.lock.v1alpha1.AcquireLockResponse\"\x000\x01\x12\x88\x01\n" +
+	"\tRenewLock\x12'.tracker.lock.v1alpha1.RenewLockRequest\x1a(.tracker.lock.v1alpha1.RenewLockResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1alpha1/lock/{id}/renew\x12\x99\x01\n" +
+	"\x10CreateLockBundle\x12..tracker.lock.v1alpha1.CreateLockBundleRequest\x1a/.tracker.lock.v1alpha1.CreateLockBundleResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1alpha1/lock/bundle\x12\xa5\x01\n" +
+	"\x11ReleaseLockBundle\x12/.tracker.lock.v1alpha1.ReleaseLockBundleRequest\x1a0.tracker.lock.v1alpha1.ReleaseLockBundleResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1alpha1/lock/bundle/{bundle_id}\x12\x9c\x01\n" +
+	"\x11CreateReservation\x12/.tracker.lock.v1alpha1.CreateReservationRequest\x1a0.tracker.lock.v1alpha1.CreateReservationResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1alpha1/reservation\x12\x95\x01\n" +
+	"\x0eGetReservation\x12,.tracker.lock.v1alpha1.GetReservationRequest\x1a-.tracker.lock.v1alpha1.GetReservationResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1alpha1/reservation/{id}\x12\x9e\x01\n" +
+	"\x11CancelReservation\x12/.tracker.lock.v1alpha1.CancelReservationRequest\x1a0.tracker.lock.v1alpha1.CancelReservationResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1alpha1/reservation/{id}\x12\x9c\x01\n" +
+	"\x10ListReservations\x12..tracker.lock.v1alpha1.ListReservationsRequest\x1a/.tracker.lock.v1alpha1.ListReservationsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1alpha1/reservations/list\x12e\n" +
+	"\n" +
+	"WatchLocks\x12(.tracker.lock.v1alpha1.WatchLocksRequest\x1a).tracker.lock.v1alpha1.WatchLocksResponse\"\x000\x01B\x15Z\x13proto/lock/v1alpha1b\x06proto3"
 
 var (
 	file_proto_lock_v1alpha1_lock_proto_rawDescOnce sync.Once
@@ -731,46 +2865,133 @@ func file_proto_lock_v1alpha1_lock_proto_rawDescGZIP() []byte {
 	return file_proto_lock_v1alpha1_lock_proto_rawDescData
 }
 
-var file_proto_lock_v1alpha1_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_lock_v1alpha1_lock_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_lock_v1alpha1_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_proto_lock_v1alpha1_lock_proto_goTypes = []any{
-	(*Lock)(nil),                   // 0: tracker.lock.v1alpha1.Lock
-	(*CreateLockRequest)(nil),      // 1: tracker.lock.v1alpha1.CreateLockRequest
-	(*CreateLockResponse)(nil),     // 2: tracker.lock.v1alpha1.CreateLockResponse
-	(*GetLockRequest)(nil),         // 3: tracker.lock.v1alpha1.GetLockRequest
-	(*GetLockResponse)(nil),        // 4: tracker.lock.v1alpha1.GetLockResponse
-	(*UpdateLockRequest)(nil),      // 5: tracker.lock.v1alpha1.UpdateLockRequest
-	(*UpdateLockResponse)(nil),     // 6: tracker.lock.v1alpha1.UpdateLockResponse
-	(*UnLockRequest)(nil),          // 7: tracker.lock.v1alpha1.UnLockRequest
-	(*UnLockResponse)(nil),         // 8: tracker.lock.v1alpha1.UnLockResponse
-	(*ListLocksRequest)(nil),       // 9: tracker.lock.v1alpha1.ListLocksRequest
-	(*ListLocksResponse)(nil),      // 10: tracker.lock.v1alpha1.ListLocksResponse
-	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
-	(*wrapperspb.UInt32Value)(nil), // 12: google.protobuf.UInt32Value
-	(*wrapperspb.Int32Value)(nil),  // 13: google.protobuf.Int32Value
+	(LockMode)(0),                     // 0: tracker.lock.v1alpha1.LockMode
+	(LockHistoryAction)(0),            // 1: tracker.lock.v1alpha1.LockHistoryAction
+	(*Lock)(nil),                      // 2: tracker.lock.v1alpha1.Lock
+	(*LockWaiter)(nil),                // 3: tracker.lock.v1alpha1.LockWaiter
+	(*CreateLockRequest)(nil),         // 4: tracker.lock.v1alpha1.CreateLockRequest
+	(*CreateLockResponse)(nil),        // 5: tracker.lock.v1alpha1.CreateLockResponse
+	(*GetLockRequest)(nil),            // 6: tracker.lock.v1alpha1.GetLockRequest
+	(*GetLockResponse)(nil),           // 7: tracker.lock.v1alpha1.GetLockResponse
+	(*UpdateLockRequest)(nil),         // 8: tracker.lock.v1alpha1.UpdateLockRequest
+	(*UpdateLockResponse)(nil),        // 9: tracker.lock.v1alpha1.UpdateLockResponse
+	(*UnLockRequest)(nil),             // 10: tracker.lock.v1alpha1.UnLockRequest
+	(*ForceUnlockRequest)(nil),        // 11: tracker.lock.v1alpha1.ForceUnlockRequest
+	(*UnLockResponse)(nil),            // 12: tracker.lock.v1alpha1.UnLockResponse
+	(*ListLocksRequest)(nil),          // 13: tracker.lock.v1alpha1.ListLocksRequest
+	(*ListLocksResponse)(nil),         // 14: tracker.lock.v1alpha1.ListLocksResponse
+	(*LockBundleEntry)(nil),           // 15: tracker.lock.v1alpha1.LockBundleEntry
+	(*CreateLockBundleRequest)(nil),   // 16: tracker.lock.v1alpha1.CreateLockBundleRequest
+	(*CreateLockBundleResponse)(nil),  // 17: tracker.lock.v1alpha1.CreateLockBundleResponse
+	(*ReleaseLockBundleRequest)(nil),  // 18: tracker.lock.v1alpha1.ReleaseLockBundleRequest
+	(*ReleaseLockBundleResponse)(nil), // 19: tracker.lock.v1alpha1.ReleaseLockBundleResponse
+	(*Reservation)(nil),               // 20: tracker.lock.v1alpha1.Reservation
+	(*CreateReservationRequest)(nil),  // 21: tracker.lock.v1alpha1.CreateReservationRequest
+	(*CreateReservationResponse)(nil), // 22: tracker.lock.v1alpha1.CreateReservationResponse
+	(*GetReservationRequest)(nil),     // 23: tracker.lock.v1alpha1.GetReservationRequest
+	(*GetReservationResponse)(nil),    // 24: tracker.lock.v1alpha1.GetReservationResponse
+	(*CancelReservationRequest)(nil),  // 25: tracker.lock.v1alpha1.CancelReservationRequest
+	(*CancelReservationResponse)(nil), // 26: tracker.lock.v1alpha1.CancelReservationResponse
+	(*ListReservationsRequest)(nil),   // 27: tracker.lock.v1alpha1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),  // 28: tracker.lock.v1alpha1.ListReservationsResponse
+	(*RenewLockRequest)(nil),          // 29: tracker.lock.v1alpha1.RenewLockRequest
+	(*RenewLockResponse)(nil),         // 30: tracker.lock.v1alpha1.RenewLockResponse
+	(*AcquireLockRequest)(nil),        // 31: tracker.lock.v1alpha1.AcquireLockRequest
+	(*AcquireLockResponse)(nil),       // 32: tracker.lock.v1alpha1.AcquireLockResponse
+	(*LockHistoryEntry)(nil),          // 33: tracker.lock.v1alpha1.LockHistoryEntry
+	(*ListLockHistoryRequest)(nil),    // 34: tracker.lock.v1alpha1.ListLockHistoryRequest
+	(*ListLockHistoryResponse)(nil),   // 35: tracker.lock.v1alpha1.ListLockHistoryResponse
+	(*WatchLocksRequest)(nil),         // 36: tracker.lock.v1alpha1.WatchLocksRequest
+	(*WatchLocksResponse)(nil),        // 37: tracker.lock.v1alpha1.WatchLocksResponse
+	(*timestamppb.Timestamp)(nil),     // 38: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 39: google.protobuf.Duration
+	(*wrapperspb.UInt32Value)(nil),    // 40: google.protobuf.UInt32Value
+	(*wrapperspb.Int32Value)(nil),     // 41: google.protobuf.Int32Value
 }
 var file_proto_lock_v1alpha1_lock_proto_depIdxs = []int32{
-	11, // 0: tracker.lock.v1alpha1.Lock.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: tracker.lock.v1alpha1.CreateLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
-	0,  // 2: tracker.lock.v1alpha1.GetLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
-	0,  // 3: tracker.lock.v1alpha1.UpdateLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
-	12, // 4: tracker.lock.v1alpha1.ListLocksRequest.per_page:type_name -> google.protobuf.UInt32Value
-	13, // 5: tracker.lock.v1alpha1.ListLocksRequest.page:type_name -> google.protobuf.Int32Value
-	0,  // 6: tracker.lock.v1alpha1.ListLocksResponse.locks:type_name -> tracker.lock.v1alpha1.Lock
-	1,  // 7: tracker.lock.v1alpha1.LockService.CreateLock:input_type -> tracker.lock.v1alpha1.CreateLockRequest
-	3,  // 8: tracker.lock.v1alpha1.LockService.GetLock:input_type -> tracker.lock.v1alpha1.GetLockRequest
-	5,  // 9: tracker.lock.v1alpha1.LockService.UpdateLock:input_type -> tracker.lock.v1alpha1.UpdateLockRequest
-	7,  // 10: tracker.lock.v1alpha1.LockService.UnLock:input_type -> tracker.lock.v1alpha1.UnLockRequest
-	9,  // 11: tracker.lock.v1alpha1.LockService.ListLocks:input_type -> tracker.lock.v1alpha1.ListLocksRequest
-	2,  // 12: tracker.lock.v1alpha1.LockService.CreateLock:output_type -> tracker.lock.v1alpha1.CreateLockResponse
-	4,  // 13: tracker.lock.v1alpha1.LockService.GetLock:output_type -> tracker.lock.v1alpha1.GetLockResponse
-	6,  // 14: tracker.lock.v1alpha1.LockService.UpdateLock:output_type -> tracker.lock.v1alpha1.UpdateLockResponse
-	8,  // 15: tracker.lock.v1alpha1.LockService.UnLock:output_type -> tracker.lock.v1alpha1.UnLockResponse
-	10, // 16: tracker.lock.v1alpha1.LockService.ListLocks:output_type -> tracker.lock.v1alpha1.ListLocksResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	38, // 0: tracker.lock.v1alpha1.Lock.created_at:type_name -> google.protobuf.Timestamp
+	39, // 1: tracker.lock.v1alpha1.Lock.ttl:type_name -> google.protobuf.Duration
+	38, // 2: tracker.lock.v1alpha1.Lock.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: tracker.lock.v1alpha1.Lock.waiters:type_name -> tracker.lock.v1alpha1.LockWaiter
+	0,  // 4: tracker.lock.v1alpha1.Lock.mode:type_name -> tracker.lock.v1alpha1.LockMode
+	38, // 5: tracker.lock.v1alpha1.LockWaiter.since:type_name -> google.protobuf.Timestamp
+	39, // 6: tracker.lock.v1alpha1.CreateLockRequest.ttl:type_name -> google.protobuf.Duration
+	0,  // 7: tracker.lock.v1alpha1.CreateLockRequest.mode:type_name -> tracker.lock.v1alpha1.LockMode
+	2,  // 8: tracker.lock.v1alpha1.CreateLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	2,  // 9: tracker.lock.v1alpha1.GetLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	2,  // 10: tracker.lock.v1alpha1.UpdateLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	40, // 11: tracker.lock.v1alpha1.ListLocksRequest.per_page:type_name -> google.protobuf.UInt32Value
+	41, // 12: tracker.lock.v1alpha1.ListLocksRequest.page:type_name -> google.protobuf.Int32Value
+	2,  // 13: tracker.lock.v1alpha1.ListLocksResponse.locks:type_name -> tracker.lock.v1alpha1.Lock
+	0,  // 14: tracker.lock.v1alpha1.LockBundleEntry.mode:type_name -> tracker.lock.v1alpha1.LockMode
+	15, // 15: tracker.lock.v1alpha1.CreateLockBundleRequest.locks:type_name -> tracker.lock.v1alpha1.LockBundleEntry
+	39, // 16: tracker.lock.v1alpha1.CreateLockBundleRequest.ttl:type_name -> google.protobuf.Duration
+	2,  // 17: tracker.lock.v1alpha1.CreateLockBundleResponse.locks:type_name -> tracker.lock.v1alpha1.Lock
+	38, // 18: tracker.lock.v1alpha1.Reservation.start:type_name -> google.protobuf.Timestamp
+	38, // 19: tracker.lock.v1alpha1.Reservation.end:type_name -> google.protobuf.Timestamp
+	38, // 20: tracker.lock.v1alpha1.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	38, // 21: tracker.lock.v1alpha1.CreateReservationRequest.start:type_name -> google.protobuf.Timestamp
+	38, // 22: tracker.lock.v1alpha1.CreateReservationRequest.end:type_name -> google.protobuf.Timestamp
+	20, // 23: tracker.lock.v1alpha1.CreateReservationResponse.reservation:type_name -> tracker.lock.v1alpha1.Reservation
+	20, // 24: tracker.lock.v1alpha1.GetReservationResponse.reservation:type_name -> tracker.lock.v1alpha1.Reservation
+	40, // 25: tracker.lock.v1alpha1.ListReservationsRequest.per_page:type_name -> google.protobuf.UInt32Value
+	20, // 26: tracker.lock.v1alpha1.ListReservationsResponse.reservations:type_name -> tracker.lock.v1alpha1.Reservation
+	39, // 27: tracker.lock.v1alpha1.RenewLockRequest.ttl:type_name -> google.protobuf.Duration
+	2,  // 28: tracker.lock.v1alpha1.RenewLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	39, // 29: tracker.lock.v1alpha1.AcquireLockRequest.ttl:type_name -> google.protobuf.Duration
+	39, // 30: tracker.lock.v1alpha1.AcquireLockRequest.timeout:type_name -> google.protobuf.Duration
+	0,  // 31: tracker.lock.v1alpha1.AcquireLockRequest.mode:type_name -> tracker.lock.v1alpha1.LockMode
+	2,  // 32: tracker.lock.v1alpha1.AcquireLockResponse.holder:type_name -> tracker.lock.v1alpha1.Lock
+	2,  // 33: tracker.lock.v1alpha1.AcquireLockResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	1,  // 34: tracker.lock.v1alpha1.LockHistoryEntry.action:type_name -> tracker.lock.v1alpha1.LockHistoryAction
+	38, // 35: tracker.lock.v1alpha1.LockHistoryEntry.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 36: tracker.lock.v1alpha1.LockHistoryEntry.locked_at:type_name -> google.protobuf.Timestamp
+	39, // 37: tracker.lock.v1alpha1.LockHistoryEntry.held_for:type_name -> google.protobuf.Duration
+	40, // 38: tracker.lock.v1alpha1.ListLockHistoryRequest.per_page:type_name -> google.protobuf.UInt32Value
+	41, // 39: tracker.lock.v1alpha1.ListLockHistoryRequest.page:type_name -> google.protobuf.Int32Value
+	33, // 40: tracker.lock.v1alpha1.ListLockHistoryResponse.entries:type_name -> tracker.lock.v1alpha1.LockHistoryEntry
+	33, // 41: tracker.lock.v1alpha1.WatchLocksResponse.change:type_name -> tracker.lock.v1alpha1.LockHistoryEntry
+	2,  // 42: tracker.lock.v1alpha1.WatchLocksResponse.lock:type_name -> tracker.lock.v1alpha1.Lock
+	4,  // 43: tracker.lock.v1alpha1.LockService.CreateLock:input_type -> tracker.lock.v1alpha1.CreateLockRequest
+	6,  // 44: tracker.lock.v1alpha1.LockService.GetLock:input_type -> tracker.lock.v1alpha1.GetLockRequest
+	8,  // 45: tracker.lock.v1alpha1.LockService.UpdateLock:input_type -> tracker.lock.v1alpha1.UpdateLockRequest
+	10, // 46: tracker.lock.v1alpha1.LockService.UnLock:input_type -> tracker.lock.v1alpha1.UnLockRequest
+	11, // 47: tracker.lock.v1alpha1.LockService.ForceUnlock:input_type -> tracker.lock.v1alpha1.ForceUnlockRequest
+	13, // 48: tracker.lock.v1alpha1.LockService.ListLocks:input_type -> tracker.lock.v1alpha1.ListLocksRequest
+	34, // 49: tracker.lock.v1alpha1.LockService.ListLockHistory:input_type -> tracker.lock.v1alpha1.ListLockHistoryRequest
+	31, // 50: tracker.lock.v1alpha1.LockService.AcquireLock:input_type -> tracker.lock.v1alpha1.AcquireLockRequest
+	29, // 51: tracker.lock.v1alpha1.LockService.RenewLock:input_type -> tracker.lock.v1alpha1.RenewLockRequest
+	16, // 52: tracker.lock.v1alpha1.LockService.CreateLockBundle:input_type -> tracker.lock.v1alpha1.CreateLockBundleRequest
+	18, // 53: tracker.lock.v1alpha1.LockService.ReleaseLockBundle:input_type -> tracker.lock.v1alpha1.ReleaseLockBundleRequest
+	21, // 54: tracker.lock.v1alpha1.LockService.CreateReservation:input_type -> tracker.lock.v1alpha1.CreateReservationRequest
+	23, // 55: tracker.lock.v1alpha1.LockService.GetReservation:input_type -> tracker.lock.v1alpha1.GetReservationRequest
+	25, // 56: tracker.lock.v1alpha1.LockService.CancelReservation:input_type -> tracker.lock.v1alpha1.CancelReservationRequest
+	27, // 57: tracker.lock.v1alpha1.LockService.ListReservations:input_type -> tracker.lock.v1alpha1.ListReservationsRequest
+	36, // 58: tracker.lock.v1alpha1.LockService.WatchLocks:input_type -> tracker.lock.v1alpha1.WatchLocksRequest
+	5,  // 59: tracker.lock.v1alpha1.LockService.CreateLock:output_type -> tracker.lock.v1alpha1.CreateLockResponse
+	7,  // 60: tracker.lock.v1alpha1.LockService.GetLock:output_type -> tracker.lock.v1alpha1.GetLockResponse
+	9,  // 61: tracker.lock.v1alpha1.LockService.UpdateLock:output_type -> tracker.lock.v1alpha1.UpdateLockResponse
+	12, // 62: tracker.lock.v1alpha1.LockService.UnLock:output_type -> tracker.lock.v1alpha1.UnLockResponse
+	12, // 63: tracker.lock.v1alpha1.LockService.ForceUnlock:output_type -> tracker.lock.v1alpha1.UnLockResponse
+	14, // 64: tracker.lock.v1alpha1.LockService.ListLocks:output_type -> tracker.lock.v1alpha1.ListLocksResponse
+	35, // 65: tracker.lock.v1alpha1.LockService.ListLockHistory:output_type -> tracker.lock.v1alpha1.ListLockHistoryResponse
+	32, // 66: tracker.lock.v1alpha1.LockService.AcquireLock:output_type -> tracker.lock.v1alpha1.AcquireLockResponse
+	30, // 67: tracker.lock.v1alpha1.LockService.RenewLock:output_type -> tracker.lock.v1alpha1.RenewLockResponse
+	17, // 68: tracker.lock.v1alpha1.LockService.CreateLockBundle:output_type -> tracker.lock.v1alpha1.CreateLockBundleResponse
+	19, // 69: tracker.lock.v1alpha1.LockService.ReleaseLockBundle:output_type -> tracker.lock.v1alpha1.ReleaseLockBundleResponse
+	22, // 70: tracker.lock.v1alpha1.LockService.CreateReservation:output_type -> tracker.lock.v1alpha1.CreateReservationResponse
+	24, // 71: tracker.lock.v1alpha1.LockService.GetReservation:output_type -> tracker.lock.v1alpha1.GetReservationResponse
+	26, // 72: tracker.lock.v1alpha1.LockService.CancelReservation:output_type -> tracker.lock.v1alpha1.CancelReservationResponse
+	28, // 73: tracker.lock.v1alpha1.LockService.ListReservations:output_type -> tracker.lock.v1alpha1.ListReservationsResponse
+	37, // 74: tracker.lock.v1alpha1.LockService.WatchLocks:output_type -> tracker.lock.v1alpha1.WatchLocksResponse
+	59, // [59:75] is the sub-list for method output_type
+	43, // [43:59] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_lock_v1alpha1_lock_proto_init() }
@@ -783,13 +3004,14 @@ func file_proto_lock_v1alpha1_lock_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_lock_v1alpha1_lock_proto_rawDesc), len(file_proto_lock_v1alpha1_lock_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_lock_v1alpha1_lock_proto_goTypes,
 		DependencyIndexes: file_proto_lock_v1alpha1_lock_proto_depIdxs,
+		EnumInfos:         file_proto_lock_v1alpha1_lock_proto_enumTypes,
 		MessageInfos:      file_proto_lock_v1alpha1_lock_proto_msgTypes,
 	}.Build()
 	File_proto_lock_v1alpha1_lock_proto = out.File
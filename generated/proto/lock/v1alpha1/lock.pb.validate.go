@@ -110,6 +110,106 @@ func (m *Lock) validate(all bool) error {
 
 	// no validation rules for EventId
 
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWaiters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LockValidationError{
+						field:  fmt.Sprintf("Waiters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LockValidationError{
+						field:  fmt.Sprintf("Waiters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LockValidationError{
+					field:  fmt.Sprintf("Waiters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Mode
+
+	// no validation rules for BundleId
+
+	// no validation rules for ReleaseId
+
+	// no validation rules for ReservationId
+
 	if len(errors) > 0 {
 		return LockMultiError(errors)
 	}
@@ -195,6 +295,142 @@ var _ interface {
 	ErrorName() string
 } = LockValidationError{}
 
+// Validate checks the field values on LockWaiter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LockWaiter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockWaiter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LockWaiterMultiError, or
+// nil if none found.
+func (m *LockWaiter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockWaiter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WaiterId
+
+	// no validation rules for Who
+
+	// no validation rules for EventId
+
+	// no validation rules for Position
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockWaiterValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockWaiterValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockWaiterValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LockWaiterMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockWaiterMultiError is an error wrapping multiple validation errors
+// returned by LockWaiter.ValidateAll() if the designated constraints aren't met.
+type LockWaiterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockWaiterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockWaiterMultiError) AllErrors() []error { return m }
+
+// LockWaiterValidationError is the validation error returned by
+// LockWaiter.Validate if the designated constraints aren't met.
+type LockWaiterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockWaiterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockWaiterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockWaiterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockWaiterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockWaiterValidationError) ErrorName() string { return "LockWaiterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockWaiterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockWaiter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockWaiterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockWaiterValidationError{}
+
 // Validate checks the field values on CreateLockRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -227,6 +463,38 @@ func (m *CreateLockRequest) validate(all bool) error {
 
 	// no validation rules for EventId
 
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateLockRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateLockRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Mode
+
 	if len(errors) > 0 {
 		return CreateLockRequestMultiError(errors)
 	}
@@ -956,6 +1224,8 @@ func (m *UnLockRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Who
+
 	if len(errors) > 0 {
 		return UnLockRequestMultiError(errors)
 	}
@@ -1034,66 +1304,48 @@ var _ interface {
 	ErrorName() string
 } = UnLockRequestValidationError{}
 
-// Validate checks the field values on UnLockResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UnLockResponse) Validate() error {
+// Validate checks the field values on ForceUnlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceUnlockRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnLockResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UnLockResponseMultiError,
-// or nil if none found.
-func (m *UnLockResponse) ValidateAll() error {
+// ValidateAll checks the field values on ForceUnlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceUnlockRequestMultiError, or nil if none found.
+func (m *ForceUnlockRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnLockResponse) validate(all bool) error {
+func (m *ForceUnlockRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Id
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = UnLockResponseValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Who
 
-	// no validation rules for Count
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return UnLockResponseMultiError(errors)
+		return ForceUnlockRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UnLockResponse) _validateUuid(uuid string) error {
-	if matched := _lock_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
-	}
-
-	return nil
-}
-
-// UnLockResponseMultiError is an error wrapping multiple validation errors
-// returned by UnLockResponse.ValidateAll() if the designated constraints
+// ForceUnlockRequestMultiError is an error wrapping multiple validation errors
+// returned by ForceUnlockRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UnLockResponseMultiError []error
+type ForceUnlockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnLockResponseMultiError) Error() string {
+func (m ForceUnlockRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1102,11 +1354,11 @@ func (m UnLockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnLockResponseMultiError) AllErrors() []error { return m }
+func (m ForceUnlockRequestMultiError) AllErrors() []error { return m }
 
-// UnLockResponseValidationError is the validation error returned by
-// UnLockResponse.Validate if the designated constraints aren't met.
-type UnLockResponseValidationError struct {
+// ForceUnlockRequestValidationError is the validation error returned by
+// ForceUnlockRequest.Validate if the designated constraints aren't met.
+type ForceUnlockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1114,22 +1366,24 @@ type UnLockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnLockResponseValidationError) Field() string { return e.field }
+func (e ForceUnlockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnLockResponseValidationError) Reason() string { return e.reason }
+func (e ForceUnlockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnLockResponseValidationError) Cause() error { return e.cause }
+func (e ForceUnlockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnLockResponseValidationError) Key() bool { return e.key }
+func (e ForceUnlockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnLockResponseValidationError) ErrorName() string { return "UnLockResponseValidationError" }
+func (e ForceUnlockRequestValidationError) ErrorName() string {
+	return "ForceUnlockRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UnLockResponseValidationError) Error() string {
+func (e ForceUnlockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1141,14 +1395,14 @@ func (e UnLockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnLockResponse.%s: %s%s",
+		"invalid %sForceUnlockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnLockResponseValidationError{}
+var _ error = ForceUnlockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1156,14 +1410,138 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnLockResponseValidationError{}
+} = ForceUnlockRequestValidationError{}
 
-// Validate checks the field values on ListLocksRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListLocksRequest) Validate() error {
-	return m.validate(false)
-}
+// Validate checks the field values on UnLockResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnLockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnLockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnLockResponseMultiError,
+// or nil if none found.
+func (m *UnLockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnLockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UnLockResponseValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return UnLockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnLockResponse) _validateUuid(uuid string) error {
+	if matched := _lock_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnLockResponseMultiError is an error wrapping multiple validation errors
+// returned by UnLockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnLockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnLockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnLockResponseMultiError) AllErrors() []error { return m }
+
+// UnLockResponseValidationError is the validation error returned by
+// UnLockResponse.Validate if the designated constraints aren't met.
+type UnLockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnLockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnLockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnLockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnLockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnLockResponseValidationError) ErrorName() string { return "UnLockResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnLockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnLockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnLockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnLockResponseValidationError{}
+
+// Validate checks the field values on ListLocksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLocksRequest) Validate() error {
+	return m.validate(false)
+}
 
 // ValidateAll checks the field values on ListLocksRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -1238,6 +1616,10 @@ func (m *ListLocksRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Sort
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
 		return ListLocksRequestMultiError(errors)
 	}
@@ -1374,6 +1756,8 @@ func (m *ListLocksResponse) validate(all bool) error {
 
 	// no validation rules for TotalCount
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListLocksResponseMultiError(errors)
 	}
@@ -1453,3 +1837,3250 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListLocksResponseValidationError{}
+
+// Validate checks the field values on LockBundleEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LockBundleEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockBundleEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockBundleEntryMultiError, or nil if none found.
+func (m *LockBundleEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockBundleEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Environment
+
+	// no validation rules for Resource
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return LockBundleEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockBundleEntryMultiError is an error wrapping multiple validation errors
+// returned by LockBundleEntry.ValidateAll() if the designated constraints
+// aren't met.
+type LockBundleEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockBundleEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockBundleEntryMultiError) AllErrors() []error { return m }
+
+// LockBundleEntryValidationError is the validation error returned by
+// LockBundleEntry.Validate if the designated constraints aren't met.
+type LockBundleEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockBundleEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockBundleEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockBundleEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockBundleEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockBundleEntryValidationError) ErrorName() string { return "LockBundleEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockBundleEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockBundleEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockBundleEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockBundleEntryValidationError{}
+
+// Validate checks the field values on CreateLockBundleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLockBundleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLockBundleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLockBundleRequestMultiError, or nil if none found.
+func (m *CreateLockBundleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLockBundleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateLockBundleRequestValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateLockBundleRequestValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateLockBundleRequestValidationError{
+					field:  fmt.Sprintf("Locks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Who
+
+	// no validation rules for ReleaseId
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateLockBundleRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateLockBundleRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateLockBundleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLockBundleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateLockBundleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateLockBundleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLockBundleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLockBundleRequestMultiError) AllErrors() []error { return m }
+
+// CreateLockBundleRequestValidationError is the validation error returned by
+// CreateLockBundleRequest.Validate if the designated constraints aren't met.
+type CreateLockBundleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLockBundleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLockBundleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLockBundleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLockBundleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLockBundleRequestValidationError) ErrorName() string {
+	return "CreateLockBundleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLockBundleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLockBundleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLockBundleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLockBundleRequestValidationError{}
+
+// Validate checks the field values on CreateLockBundleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLockBundleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLockBundleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLockBundleResponseMultiError, or nil if none found.
+func (m *CreateLockBundleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLockBundleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BundleId
+
+	for idx, item := range m.GetLocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateLockBundleResponseValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateLockBundleResponseValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateLockBundleResponseValidationError{
+					field:  fmt.Sprintf("Locks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateLockBundleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLockBundleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateLockBundleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateLockBundleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLockBundleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLockBundleResponseMultiError) AllErrors() []error { return m }
+
+// CreateLockBundleResponseValidationError is the validation error returned by
+// CreateLockBundleResponse.Validate if the designated constraints aren't met.
+type CreateLockBundleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLockBundleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLockBundleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLockBundleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLockBundleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLockBundleResponseValidationError) ErrorName() string {
+	return "CreateLockBundleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLockBundleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLockBundleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLockBundleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLockBundleResponseValidationError{}
+
+// Validate checks the field values on ReleaseLockBundleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseLockBundleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseLockBundleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseLockBundleRequestMultiError, or nil if none found.
+func (m *ReleaseLockBundleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseLockBundleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BundleId
+
+	// no validation rules for Who
+
+	if len(errors) > 0 {
+		return ReleaseLockBundleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseLockBundleRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseLockBundleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseLockBundleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseLockBundleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseLockBundleRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseLockBundleRequestValidationError is the validation error returned by
+// ReleaseLockBundleRequest.Validate if the designated constraints aren't met.
+type ReleaseLockBundleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseLockBundleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseLockBundleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseLockBundleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseLockBundleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseLockBundleRequestValidationError) ErrorName() string {
+	return "ReleaseLockBundleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseLockBundleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseLockBundleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseLockBundleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseLockBundleRequestValidationError{}
+
+// Validate checks the field values on ReleaseLockBundleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseLockBundleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseLockBundleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseLockBundleResponseMultiError, or nil if none found.
+func (m *ReleaseLockBundleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseLockBundleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BundleId
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReleaseLockBundleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseLockBundleResponseMultiError is an error wrapping multiple validation
+// errors returned by ReleaseLockBundleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReleaseLockBundleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseLockBundleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseLockBundleResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseLockBundleResponseValidationError is the validation error returned by
+// ReleaseLockBundleResponse.Validate if the designated constraints aren't met.
+type ReleaseLockBundleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseLockBundleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseLockBundleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseLockBundleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseLockBundleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseLockBundleResponseValidationError) ErrorName() string {
+	return "ReleaseLockBundleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseLockBundleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseLockBundleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseLockBundleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseLockBundleResponseValidationError{}
+
+// Validate checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reservation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReservationMultiError, or
+// nil if none found.
+func (m *Reservation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reservation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Environment
+
+	// no validation rules for Service
+
+	// no validation rules for Owner
+
+	// no validation rules for Purpose
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservationValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservationValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LockId
+
+	if len(errors) > 0 {
+		return ReservationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservationMultiError is an error wrapping multiple validation errors
+// returned by Reservation.ValidateAll() if the designated constraints aren't met.
+type ReservationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationMultiError) AllErrors() []error { return m }
+
+// ReservationValidationError is the validation error returned by
+// Reservation.Validate if the designated constraints aren't met.
+type ReservationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationValidationError) ErrorName() string { return "ReservationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationValidationError{}
+
+// Validate checks the field values on CreateReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReservationRequestMultiError, or nil if none found.
+func (m *CreateReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Environment
+
+	// no validation rules for Service
+
+	// no validation rules for Owner
+
+	// no validation rules for Purpose
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReservationRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReservationRequestValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReservationRequestValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReservationRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReservationRequestValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReservationRequestValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReservationRequestMultiError) AllErrors() []error { return m }
+
+// CreateReservationRequestValidationError is the validation error returned by
+// CreateReservationRequest.Validate if the designated constraints aren't met.
+type CreateReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReservationRequestValidationError) ErrorName() string {
+	return "CreateReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReservationRequestValidationError{}
+
+// Validate checks the field values on CreateReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReservationResponseMultiError, or nil if none found.
+func (m *CreateReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReservation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReservationResponseValidationError{
+					field:  "Reservation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReservationResponseValidationError{
+					field:  "Reservation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReservation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReservationResponseValidationError{
+				field:  "Reservation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReservationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReservationResponseMultiError) AllErrors() []error { return m }
+
+// CreateReservationResponseValidationError is the validation error returned by
+// CreateReservationResponse.Validate if the designated constraints aren't met.
+type CreateReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReservationResponseValidationError) ErrorName() string {
+	return "CreateReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReservationResponseValidationError{}
+
+// Validate checks the field values on GetReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReservationRequestMultiError, or nil if none found.
+func (m *GetReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReservationRequestMultiError) AllErrors() []error { return m }
+
+// GetReservationRequestValidationError is the validation error returned by
+// GetReservationRequest.Validate if the designated constraints aren't met.
+type GetReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReservationRequestValidationError) ErrorName() string {
+	return "GetReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReservationRequestValidationError{}
+
+// Validate checks the field values on GetReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReservationResponseMultiError, or nil if none found.
+func (m *GetReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReservation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReservationResponseValidationError{
+					field:  "Reservation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReservationResponseValidationError{
+					field:  "Reservation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReservation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReservationResponseValidationError{
+				field:  "Reservation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReservationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReservationResponseMultiError) AllErrors() []error { return m }
+
+// GetReservationResponseValidationError is the validation error returned by
+// GetReservationResponse.Validate if the designated constraints aren't met.
+type GetReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReservationResponseValidationError) ErrorName() string {
+	return "GetReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReservationResponseValidationError{}
+
+// Validate checks the field values on CancelReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelReservationRequestMultiError, or nil if none found.
+func (m *CancelReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Owner
+
+	if len(errors) > 0 {
+		return CancelReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelReservationRequestMultiError) AllErrors() []error { return m }
+
+// CancelReservationRequestValidationError is the validation error returned by
+// CancelReservationRequest.Validate if the designated constraints aren't met.
+type CancelReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelReservationRequestValidationError) ErrorName() string {
+	return "CancelReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelReservationRequestValidationError{}
+
+// Validate checks the field values on CancelReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelReservationResponseMultiError, or nil if none found.
+func (m *CancelReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelReservationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CancelReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelReservationResponseMultiError) AllErrors() []error { return m }
+
+// CancelReservationResponseValidationError is the validation error returned by
+// CancelReservationResponse.Validate if the designated constraints aren't met.
+type CancelReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelReservationResponseValidationError) ErrorName() string {
+	return "CancelReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelReservationResponseValidationError{}
+
+// Validate checks the field values on ListReservationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReservationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReservationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReservationsRequestMultiError, or nil if none found.
+func (m *ListReservationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReservationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Environment
+
+	// no validation rules for Service
+
+	// no validation rules for Owner
+
+	if all {
+		switch v := interface{}(m.GetPerPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReservationsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReservationsRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReservationsRequestValidationError{
+				field:  "PerPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListReservationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReservationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReservationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReservationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReservationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReservationsRequestMultiError) AllErrors() []error { return m }
+
+// ListReservationsRequestValidationError is the validation error returned by
+// ListReservationsRequest.Validate if the designated constraints aren't met.
+type ListReservationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReservationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReservationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReservationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReservationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReservationsRequestValidationError) ErrorName() string {
+	return "ListReservationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReservationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReservationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReservationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReservationsRequestValidationError{}
+
+// Validate checks the field values on ListReservationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReservationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReservationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReservationsResponseMultiError, or nil if none found.
+func (m *ListReservationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReservationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReservations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReservationsResponseValidationError{
+						field:  fmt.Sprintf("Reservations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReservationsResponseValidationError{
+						field:  fmt.Sprintf("Reservations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReservationsResponseValidationError{
+					field:  fmt.Sprintf("Reservations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReservationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReservationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReservationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReservationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReservationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReservationsResponseMultiError) AllErrors() []error { return m }
+
+// ListReservationsResponseValidationError is the validation error returned by
+// ListReservationsResponse.Validate if the designated constraints aren't met.
+type ListReservationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReservationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReservationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReservationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReservationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReservationsResponseValidationError) ErrorName() string {
+	return "ListReservationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReservationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReservationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReservationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReservationsResponseValidationError{}
+
+// Validate checks the field values on RenewLockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenewLockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewLockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewLockRequestMultiError, or nil if none found.
+func (m *RenewLockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewLockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RenewLockRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := RenewLockRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenewLockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewLockRequestMultiError is an error wrapping multiple validation errors
+// returned by RenewLockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenewLockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewLockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewLockRequestMultiError) AllErrors() []error { return m }
+
+// RenewLockRequestValidationError is the validation error returned by
+// RenewLockRequest.Validate if the designated constraints aren't met.
+type RenewLockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewLockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewLockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewLockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewLockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewLockRequestValidationError) ErrorName() string { return "RenewLockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenewLockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewLockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewLockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewLockRequestValidationError{}
+
+// Validate checks the field values on RenewLockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenewLockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewLockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewLockResponseMultiError, or nil if none found.
+func (m *RenewLockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewLockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenewLockResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenewLockResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenewLockResponseValidationError{
+				field:  "Lock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenewLockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewLockResponseMultiError is an error wrapping multiple validation errors
+// returned by RenewLockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RenewLockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewLockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewLockResponseMultiError) AllErrors() []error { return m }
+
+// RenewLockResponseValidationError is the validation error returned by
+// RenewLockResponse.Validate if the designated constraints aren't met.
+type RenewLockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewLockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewLockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewLockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewLockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewLockResponseValidationError) ErrorName() string {
+	return "RenewLockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewLockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewLockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewLockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewLockResponseValidationError{}
+
+// Validate checks the field values on AcquireLockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcquireLockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcquireLockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcquireLockRequestMultiError, or nil if none found.
+func (m *AcquireLockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcquireLockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Who
+
+	// no validation rules for Environment
+
+	// no validation rules for Resource
+
+	// no validation rules for EventId
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AcquireLockRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := AcquireLockRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AcquireLockRequestValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := AcquireLockRequestValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for WaiterId
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return AcquireLockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcquireLockRequestMultiError is an error wrapping multiple validation errors
+// returned by AcquireLockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AcquireLockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcquireLockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcquireLockRequestMultiError) AllErrors() []error { return m }
+
+// AcquireLockRequestValidationError is the validation error returned by
+// AcquireLockRequest.Validate if the designated constraints aren't met.
+type AcquireLockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcquireLockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcquireLockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcquireLockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcquireLockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcquireLockRequestValidationError) ErrorName() string {
+	return "AcquireLockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcquireLockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcquireLockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcquireLockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcquireLockRequestValidationError{}
+
+// Validate checks the field values on AcquireLockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcquireLockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcquireLockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcquireLockResponseMultiError, or nil if none found.
+func (m *AcquireLockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcquireLockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WaiterId
+
+	// no validation rules for Position
+
+	if all {
+		switch v := interface{}(m.GetHolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcquireLockResponseValidationError{
+					field:  "Holder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcquireLockResponseValidationError{
+					field:  "Holder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcquireLockResponseValidationError{
+				field:  "Holder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcquireLockResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcquireLockResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcquireLockResponseValidationError{
+				field:  "Lock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcquireLockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcquireLockResponseMultiError is an error wrapping multiple validation
+// errors returned by AcquireLockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcquireLockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcquireLockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcquireLockResponseMultiError) AllErrors() []error { return m }
+
+// AcquireLockResponseValidationError is the validation error returned by
+// AcquireLockResponse.Validate if the designated constraints aren't met.
+type AcquireLockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcquireLockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcquireLockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcquireLockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcquireLockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcquireLockResponseValidationError) ErrorName() string {
+	return "AcquireLockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcquireLockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcquireLockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcquireLockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcquireLockResponseValidationError{}
+
+// Validate checks the field values on LockHistoryEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LockHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockHistoryEntryMultiError, or nil if none found.
+func (m *LockHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LockId
+
+	// no validation rules for Action
+
+	// no validation rules for Service
+
+	// no validation rules for Environment
+
+	// no validation rules for Resource
+
+	// no validation rules for Who
+
+	// no validation rules for EventId
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockHistoryEntryValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLockedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "LockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "LockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLockedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockHistoryEntryValidationError{
+				field:  "LockedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHeldFor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "HeldFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockHistoryEntryValidationError{
+					field:  "HeldFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHeldFor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockHistoryEntryValidationError{
+				field:  "HeldFor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LockHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockHistoryEntryMultiError is an error wrapping multiple validation errors
+// returned by LockHistoryEntry.ValidateAll() if the designated constraints
+// aren't met.
+type LockHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockHistoryEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockHistoryEntryMultiError) AllErrors() []error { return m }
+
+// LockHistoryEntryValidationError is the validation error returned by
+// LockHistoryEntry.Validate if the designated constraints aren't met.
+type LockHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockHistoryEntryValidationError) ErrorName() string { return "LockHistoryEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockHistoryEntryValidationError{}
+
+// Validate checks the field values on ListLockHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLockHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLockHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLockHistoryRequestMultiError, or nil if none found.
+func (m *ListLockHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLockHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Environment
+
+	// no validation rules for Who
+
+	// no validation rules for LockId
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if all {
+		switch v := interface{}(m.GetPerPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLockHistoryRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLockHistoryRequestValidationError{
+					field:  "PerPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLockHistoryRequestValidationError{
+				field:  "PerPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLockHistoryRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLockHistoryRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLockHistoryRequestValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sort
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListLockHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLockHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLockHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLockHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLockHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLockHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListLockHistoryRequestValidationError is the validation error returned by
+// ListLockHistoryRequest.Validate if the designated constraints aren't met.
+type ListLockHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLockHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLockHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLockHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLockHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLockHistoryRequestValidationError) ErrorName() string {
+	return "ListLockHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLockHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLockHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLockHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLockHistoryRequestValidationError{}
+
+// Validate checks the field values on ListLockHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLockHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLockHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLockHistoryResponseMultiError, or nil if none found.
+func (m *ListLockHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLockHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLockHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLockHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLockHistoryResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListLockHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLockHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLockHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLockHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLockHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLockHistoryResponseMultiError) AllErrors() []error { return m }
+
+// ListLockHistoryResponseValidationError is the validation error returned by
+// ListLockHistoryResponse.Validate if the designated constraints aren't met.
+type ListLockHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLockHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLockHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLockHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLockHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLockHistoryResponseValidationError) ErrorName() string {
+	return "ListLockHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLockHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLockHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLockHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLockHistoryResponseValidationError{}
+
+// Validate checks the field values on WatchLocksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchLocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchLocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchLocksRequestMultiError, or nil if none found.
+func (m *WatchLocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchLocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Environment
+
+	// no validation rules for Resource
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchLocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchLocksRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchLocksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchLocksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchLocksRequestMultiError) AllErrors() []error { return m }
+
+// WatchLocksRequestValidationError is the validation error returned by
+// WatchLocksRequest.Validate if the designated constraints aren't met.
+type WatchLocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchLocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchLocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchLocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchLocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchLocksRequestValidationError) ErrorName() string {
+	return "WatchLocksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchLocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchLocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchLocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchLocksRequestValidationError{}
+
+// Validate checks the field values on WatchLocksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchLocksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchLocksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchLocksResponseMultiError, or nil if none found.
+func (m *WatchLocksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchLocksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchLocksResponseValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchLocksResponseValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchLocksResponseValidationError{
+				field:  "Change",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchLocksResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchLocksResponseValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchLocksResponseValidationError{
+				field:  "Lock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchLocksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchLocksResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchLocksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLocksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchLocksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchLocksResponseMultiError) AllErrors() []error { return m }
+
+// WatchLocksResponseValidationError is the validation error returned by
+// WatchLocksResponse.Validate if the designated constraints aren't met.
+type WatchLocksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchLocksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchLocksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchLocksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchLocksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchLocksResponseValidationError) ErrorName() string {
+	return "WatchLocksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchLocksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchLocksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchLocksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchLocksResponseValidationError{}
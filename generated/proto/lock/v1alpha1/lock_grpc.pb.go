@@ -19,11 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LockService_CreateLock_FullMethodName = "/tracker.lock.v1alpha1.LockService/CreateLock"
-	LockService_GetLock_FullMethodName    = "/tracker.lock.v1alpha1.LockService/GetLock"
-	LockService_UpdateLock_FullMethodName = "/tracker.lock.v1alpha1.LockService/UpdateLock"
-	LockService_UnLock_FullMethodName     = "/tracker.lock.v1alpha1.LockService/UnLock"
-	LockService_ListLocks_FullMethodName  = "/tracker.lock.v1alpha1.LockService/ListLocks"
+	LockService_CreateLock_FullMethodName        = "/tracker.lock.v1alpha1.LockService/CreateLock"
+	LockService_GetLock_FullMethodName           = "/tracker.lock.v1alpha1.LockService/GetLock"
+	LockService_UpdateLock_FullMethodName        = "/tracker.lock.v1alpha1.LockService/UpdateLock"
+	LockService_UnLock_FullMethodName            = "/tracker.lock.v1alpha1.LockService/UnLock"
+	LockService_ForceUnlock_FullMethodName       = "/tracker.lock.v1alpha1.LockService/ForceUnlock"
+	LockService_ListLocks_FullMethodName         = "/tracker.lock.v1alpha1.LockService/ListLocks"
+	LockService_ListLockHistory_FullMethodName   = "/tracker.lock.v1alpha1.LockService/ListLockHistory"
+	LockService_AcquireLock_FullMethodName       = "/tracker.lock.v1alpha1.LockService/AcquireLock"
+	LockService_RenewLock_FullMethodName         = "/tracker.lock.v1alpha1.LockService/RenewLock"
+	LockService_CreateLockBundle_FullMethodName  = "/tracker.lock.v1alpha1.LockService/CreateLockBundle"
+	LockService_ReleaseLockBundle_FullMethodName = "/tracker.lock.v1alpha1.LockService/ReleaseLockBundle"
+	LockService_CreateReservation_FullMethodName = "/tracker.lock.v1alpha1.LockService/CreateReservation"
+	LockService_GetReservation_FullMethodName    = "/tracker.lock.v1alpha1.LockService/GetReservation"
+	LockService_CancelReservation_FullMethodName = "/tracker.lock.v1alpha1.LockService/CancelReservation"
+	LockService_ListReservations_FullMethodName  = "/tracker.lock.v1alpha1.LockService/ListReservations"
+	LockService_WatchLocks_FullMethodName        = "/tracker.lock.v1alpha1.LockService/WatchLocks"
 )
 
 // LockServiceClient is the client API for LockService service.
@@ -33,8 +44,38 @@ type LockServiceClient interface {
 	CreateLock(ctx context.Context, in *CreateLockRequest, opts ...grpc.CallOption) (*CreateLockResponse, error)
 	GetLock(ctx context.Context, in *GetLockRequest, opts ...grpc.CallOption) (*GetLockResponse, error)
 	UpdateLock(ctx context.Context, in *UpdateLockRequest, opts ...grpc.CallOption) (*UpdateLockResponse, error)
+	// UnLock releases a lock, only its holder can release it
 	UnLock(ctx context.Context, in *UnLockRequest, opts ...grpc.CallOption) (*UnLockResponse, error)
+	// ForceUnlock releases the lock of someone else, the reason is kept in the
+	// lock history and the changelog of the event
+	ForceUnlock(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*UnLockResponse, error)
 	ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error)
+	// ListLockHistory returns the audit trail of the locks: every acquire, update, renewal, release and expiry
+	ListLockHistory(ctx context.Context, in *ListLockHistoryRequest, opts ...grpc.CallOption) (*ListLockHistoryResponse, error)
+	// AcquireLock waits in a FIFO queue until the lock is free, then takes it.
+	// It streams the queue position until the lock is granted. Over REST it is
+	// served as a long poll on POST /api/v1alpha1/lock/acquire.
+	AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AcquireLockResponse], error)
+	// RenewLock pushes back the expiry of a lock created with a ttl, as a heartbeat
+	RenewLock(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error)
+	// CreateLockBundle takes every lock of a release train, or none of them
+	CreateLockBundle(ctx context.Context, in *CreateLockBundleRequest, opts ...grpc.CallOption) (*CreateLockBundleResponse, error)
+	// ReleaseLockBundle releases every lock of a bundle, only its holder can release it
+	ReleaseLockBundle(ctx context.Context, in *ReleaseLockBundleRequest, opts ...grpc.CallOption) (*ReleaseLockBundleResponse, error)
+	// CreateReservation books an environment, or a service of it, for a team.
+	// The reservation becomes a lock held by its owner at its start.
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error)
+	// CancelReservation deletes a reservation, and releases its lock when it has started.
+	// Only its owner can cancel it.
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
+	// ListReservations returns the reservations not ended yet, soonest first
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// WatchLocks streams the changes of the locks matching the filter, as
+	// recorded in the lock history. The changes are stored and resumed as those
+	// of WatchEvents. Over REST it is served as Server-Sent Events on
+	// GET /api/v1alpha1/locks/watch.
+	WatchLocks(ctx context.Context, in *WatchLocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchLocksResponse], error)
 }
 
 type lockServiceClient struct {
@@ -85,6 +126,16 @@ func (c *lockServiceClient) UnLock(ctx context.Context, in *UnLockRequest, opts
 	return out, nil
 }
 
+func (c *lockServiceClient) ForceUnlock(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*UnLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnLockResponse)
+	err := c.cc.Invoke(ctx, LockService_ForceUnlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lockServiceClient) ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLocksResponse)
@@ -95,6 +146,124 @@ func (c *lockServiceClient) ListLocks(ctx context.Context, in *ListLocksRequest,
 	return out, nil
 }
 
+func (c *lockServiceClient) ListLockHistory(ctx context.Context, in *ListLockHistoryRequest, opts ...grpc.CallOption) (*ListLockHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLockHistoryResponse)
+	err := c.cc.Invoke(ctx, LockService_ListLockHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AcquireLockResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LockService_ServiceDesc.Streams[0], LockService_AcquireLock_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AcquireLockRequest, AcquireLockResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LockService_AcquireLockClient = grpc.ServerStreamingClient[AcquireLockResponse]
+
+func (c *lockServiceClient) RenewLock(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewLockResponse)
+	err := c.cc.Invoke(ctx, LockService_RenewLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) CreateLockBundle(ctx context.Context, in *CreateLockBundleRequest, opts ...grpc.CallOption) (*CreateLockBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLockBundleResponse)
+	err := c.cc.Invoke(ctx, LockService_CreateLockBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) ReleaseLockBundle(ctx context.Context, in *ReleaseLockBundleRequest, opts ...grpc.CallOption) (*ReleaseLockBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLockBundleResponse)
+	err := c.cc.Invoke(ctx, LockService_ReleaseLockBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, LockService_CreateReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationResponse)
+	err := c.cc.Invoke(ctx, LockService_GetReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelReservationResponse)
+	err := c.cc.Invoke(ctx, LockService_CancelReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, LockService_ListReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockServiceClient) WatchLocks(ctx context.Context, in *WatchLocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchLocksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LockService_ServiceDesc.Streams[1], LockService_WatchLocks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchLocksRequest, WatchLocksResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LockService_WatchLocksClient = grpc.ServerStreamingClient[WatchLocksResponse]
+
 // LockServiceServer is the server API for LockService service.
 // All implementations must embed UnimplementedLockServiceServer
 // for forward compatibility.
@@ -102,8 +271,38 @@ type LockServiceServer interface {
 	CreateLock(context.Context, *CreateLockRequest) (*CreateLockResponse, error)
 	GetLock(context.Context, *GetLockRequest) (*GetLockResponse, error)
 	UpdateLock(context.Context, *UpdateLockRequest) (*UpdateLockResponse, error)
+	// UnLock releases a lock, only its holder can release it
 	UnLock(context.Context, *UnLockRequest) (*UnLockResponse, error)
+	// ForceUnlock releases the lock of someone else, the reason is kept in the
+	// lock history and the changelog of the event
+	ForceUnlock(context.Context, *ForceUnlockRequest) (*UnLockResponse, error)
 	ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error)
+	// ListLockHistory returns the audit trail of the locks: every acquire, update, renewal, release and expiry
+	ListLockHistory(context.Context, *ListLockHistoryRequest) (*ListLockHistoryResponse, error)
+	// AcquireLock waits in a FIFO queue until the lock is free, then takes it.
+	// It streams the queue position until the lock is granted. Over REST it is
+	// served as a long poll on POST /api/v1alpha1/lock/acquire.
+	AcquireLock(*AcquireLockRequest, grpc.ServerStreamingServer[AcquireLockResponse]) error
+	// RenewLock pushes back the expiry of a lock created with a ttl, as a heartbeat
+	RenewLock(context.Context, *RenewLockRequest) (*RenewLockResponse, error)
+	// CreateLockBundle takes every lock of a release train, or none of them
+	CreateLockBundle(context.Context, *CreateLockBundleRequest) (*CreateLockBundleResponse, error)
+	// ReleaseLockBundle releases every lock of a bundle, only its holder can release it
+	ReleaseLockBundle(context.Context, *ReleaseLockBundleRequest) (*ReleaseLockBundleResponse, error)
+	// CreateReservation books an environment, or a service of it, for a team.
+	// The reservation becomes a lock held by its owner at its start.
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error)
+	// CancelReservation deletes a reservation, and releases its lock when it has started.
+	// Only its owner can cancel it.
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+	// ListReservations returns the reservations not ended yet, soonest first
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// WatchLocks streams the changes of the locks matching the filter, as
+	// recorded in the lock history. The changes are stored and resumed as those
+	// of WatchEvents. Over REST it is served as Server-Sent Events on
+	// GET /api/v1alpha1/locks/watch.
+	WatchLocks(*WatchLocksRequest, grpc.ServerStreamingServer[WatchLocksResponse]) error
 	mustEmbedUnimplementedLockServiceServer()
 }
 
@@ -126,9 +325,42 @@ func (UnimplementedLockServiceServer) UpdateLock(context.Context, *UpdateLockReq
 func (UnimplementedLockServiceServer) UnLock(context.Context, *UnLockRequest) (*UnLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnLock not implemented")
 }
+func (UnimplementedLockServiceServer) ForceUnlock(context.Context, *ForceUnlockRequest) (*UnLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
+}
 func (UnimplementedLockServiceServer) ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLocks not implemented")
 }
+func (UnimplementedLockServiceServer) ListLockHistory(context.Context, *ListLockHistoryRequest) (*ListLockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockHistory not implemented")
+}
+func (UnimplementedLockServiceServer) AcquireLock(*AcquireLockRequest, grpc.ServerStreamingServer[AcquireLockResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AcquireLock not implemented")
+}
+func (UnimplementedLockServiceServer) RenewLock(context.Context, *RenewLockRequest) (*RenewLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLock not implemented")
+}
+func (UnimplementedLockServiceServer) CreateLockBundle(context.Context, *CreateLockBundleRequest) (*CreateLockBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLockBundle not implemented")
+}
+func (UnimplementedLockServiceServer) ReleaseLockBundle(context.Context, *ReleaseLockBundleRequest) (*ReleaseLockBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLockBundle not implemented")
+}
+func (UnimplementedLockServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (UnimplementedLockServiceServer) GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservation not implemented")
+}
+func (UnimplementedLockServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedLockServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (UnimplementedLockServiceServer) WatchLocks(*WatchLocksRequest, grpc.ServerStreamingServer[WatchLocksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLocks not implemented")
+}
 func (UnimplementedLockServiceServer) mustEmbedUnimplementedLockServiceServer() {}
 func (UnimplementedLockServiceServer) testEmbeddedByValue()                     {}
 
@@ -222,6 +454,24 @@ func _LockService_UnLock_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LockService_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).ForceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_ForceUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).ForceUnlock(ctx, req.(*ForceUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LockService_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListLocksRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +490,172 @@ func _LockService_ListLocks_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LockService_ListLockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).ListLockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_ListLockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).ListLockHistory(ctx, req.(*ListLockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_AcquireLock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AcquireLockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LockServiceServer).AcquireLock(m, &grpc.GenericServerStream[AcquireLockRequest, AcquireLockResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LockService_AcquireLockServer = grpc.ServerStreamingServer[AcquireLockResponse]
+
+func _LockService_RenewLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).RenewLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_RenewLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).RenewLock(ctx, req.(*RenewLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_CreateLockBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLockBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).CreateLockBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_CreateLockBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).CreateLockBundle(ctx, req.(*CreateLockBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_ReleaseLockBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLockBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).ReleaseLockBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_ReleaseLockBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).ReleaseLockBundle(ctx, req.(*ReleaseLockBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).CreateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_CreateReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_GetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).GetReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_GetReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).GetReservation(ctx, req.(*GetReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_CancelReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockService_ListReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockService_WatchLocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LockServiceServer).WatchLocks(m, &grpc.GenericServerStream[WatchLocksRequest, WatchLocksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LockService_WatchLocksServer = grpc.ServerStreamingServer[WatchLocksResponse]
+
 // LockService_ServiceDesc is the grpc.ServiceDesc for LockService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,11 +679,58 @@ var LockService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnLock",
 			Handler:    _LockService_UnLock_Handler,
 		},
+		{
+			MethodName: "ForceUnlock",
+			Handler:    _LockService_ForceUnlock_Handler,
+		},
 		{
 			MethodName: "ListLocks",
 			Handler:    _LockService_ListLocks_Handler,
 		},
+		{
+			MethodName: "ListLockHistory",
+			Handler:    _LockService_ListLockHistory_Handler,
+		},
+		{
+			MethodName: "RenewLock",
+			Handler:    _LockService_RenewLock_Handler,
+		},
+		{
+			MethodName: "CreateLockBundle",
+			Handler:    _LockService_CreateLockBundle_Handler,
+		},
+		{
+			MethodName: "ReleaseLockBundle",
+			Handler:    _LockService_ReleaseLockBundle_Handler,
+		},
+		{
+			MethodName: "CreateReservation",
+			Handler:    _LockService_CreateReservation_Handler,
+		},
+		{
+			MethodName: "GetReservation",
+			Handler:    _LockService_GetReservation_Handler,
+		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _LockService_CancelReservation_Handler,
+		},
+		{
+			MethodName: "ListReservations",
+			Handler:    _LockService_ListReservations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AcquireLock",
+			Handler:       _LockService_AcquireLock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchLocks",
+			Handler:       _LockService_WatchLocks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/lock/v1alpha1/lock.proto",
 }
@@ -4,18 +4,34 @@ import (
 	"os"
 )
 
+// Storage backends supported by the stores package
+const (
+	StorageMongo    = "mongo"
+	StorageMemory   = "memory"
+	StoragePostgres = "postgres"
+	StorageEmbedded = "embedded"
+)
+
 type Database struct {
-	EventCollection   string
-	LockCollection    string
-	CatalogCollection string
-	Host              string
-	Port              string
-	Name              string
-	Username          string
-	Password          string
-	CAFile            string
-	CertFile          string
-	KeyFile           string
+	Storage               string
+	PostgresDSN           string
+	DataDir               string
+	EventCollection       string
+	LockCollection        string
+	LockHistoryCollection string
+	ReservationCollection string
+	FreezeCollection      string
+	CatalogCollection     string
+	ChangeCollection      string
+	IdempotencyCollection string
+	Host                  string
+	Port                  string
+	Name                  string
+	Username              string
+	Password              string
+	CAFile                string
+	CertFile              string
+	KeyFile               string
 }
 
 type General struct {
@@ -31,17 +47,34 @@ var ConfigGeneral = General{
 }
 
 var ConfigDatabase = Database{
-	EventCollection:   "events",
-	LockCollection:    "locks",
-	CatalogCollection: "catalog",
-	Host:              "127.0.0.1",
-	Port:              "27017",
-	Name:              "tracker",
+	Storage:               StorageMongo,
+	PostgresDSN:           "postgres://127.0.0.1:5432/tracker",
+	DataDir:               "data",
+	EventCollection:       "events",
+	LockCollection:        "locks",
+	LockHistoryCollection: "lock_history",
+	ReservationCollection: "reservations",
+	FreezeCollection:      "freezes",
+	CatalogCollection:     "catalog",
+	ChangeCollection:      "changes",
+	IdempotencyCollection: "idempotency",
+	Host:                  "127.0.0.1",
+	Port:                  "27017",
+	Name:                  "tracker",
 }
 
 func init() {
 
 	// database confgiuration
+	if os.Getenv("DB_STORAGE") != "" {
+		ConfigDatabase.Storage = os.Getenv("DB_STORAGE")
+	}
+	if os.Getenv("DB_POSTGRES_DSN") != "" {
+		ConfigDatabase.PostgresDSN = os.Getenv("DB_POSTGRES_DSN")
+	}
+	if os.Getenv("DB_DATA_DIR") != "" {
+		ConfigDatabase.DataDir = os.Getenv("DB_DATA_DIR")
+	}
 	if os.Getenv("DB_HOST") != "" {
 		ConfigDatabase.Host = os.Getenv("DB_HOST")
 	}
@@ -7,6 +7,9 @@ import (
 )
 
 func TestDefaultConfigValues(t *testing.T) {
+	assert.Equal(t, ConfigDatabase.Storage, StorageMongo)
+	assert.Equal(t, ConfigDatabase.PostgresDSN, "postgres://127.0.0.1:5432/tracker")
+	assert.Equal(t, ConfigDatabase.DataDir, "data")
 	assert.Equal(t, ConfigDatabase.Host, "127.0.0.1")
 	assert.Equal(t, ConfigDatabase.Name, "tracker")
 	assert.Equal(t, ConfigDatabase.Port, "27017")
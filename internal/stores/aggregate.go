@@ -0,0 +1,335 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AggregateFields are the attributes of the events Aggregate can group by
+var AggregateFields = []string{"environment", "type", "status", "priority", "source", "owner", "service"}
+
+// AggregateOptions tells Aggregate how to split the matching events: by
+// bucket of creation date (UTC, weeks start on Monday) and by attributes
+type AggregateOptions struct {
+	Bucket  eventv1alpha1.TimeBucket
+	GroupBy []string
+}
+
+// AggregateResult holds the statistics of the events of a bucket and group
+type AggregateResult struct {
+	// Start of the bucket, zero without bucket
+	Bucket time.Time
+	// Values of the attributes of AggregateOptions.GroupBy, in the same order
+	Group     []string
+	Count     int64
+	Successes int64
+	Failures  int64
+	// Percentiles of metadata.duration, over the Measured events having one
+	Measured      int64
+	P50, P90, P99 time.Duration
+}
+
+// eventAggregator accumulates the matching events of Aggregate, decoding only
+// the fields it needs
+type eventAggregator struct {
+	opts      AggregateOptions
+	results   map[string]*AggregateResult
+	durations map[string][]time.Duration
+}
+
+func newEventAggregator(opts AggregateOptions) *eventAggregator {
+	return &eventAggregator{
+		opts:      opts,
+		results:   map[string]*AggregateResult{},
+		durations: map[string][]time.Duration{},
+	}
+}
+
+func (a *eventAggregator) add(raw bson.Raw) error {
+	key, result := a.result(a.bucket(raw), a.group(raw))
+	result.Count++
+	switch eventv1alpha1.Status(rawInt(raw, "attributes", "status")) {
+	case eventv1alpha1.Status_success:
+		result.Successes++
+	case eventv1alpha1.Status_failure:
+		result.Failures++
+	}
+	a.addDuration(key, raw)
+	return nil
+}
+
+// addGroup adds a document of the $group stage of the Mongo store, whose _id
+// holds the parts of the creation date and the grouped attributes
+func (a *eventAggregator) addGroup(raw bson.Raw) error {
+	id, ok := raw.Lookup("_id").DocumentOK()
+	if !ok {
+		return fmt.Errorf("aggregate group has no _id: %s", raw)
+	}
+	var bucket time.Time
+	if a.opts.Bucket != eventv1alpha1.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		date := time.Unix(0, 0).UTC()
+		if year := rawInt(id, "year"); year != 0 {
+			day := max(rawInt(id, "day"), 1)
+			date = time.Date(int(year), time.Month(rawInt(id, "month")), int(day), int(rawInt(id, "hour")), 0, 0, 0, time.UTC)
+		}
+		bucket = truncateToBucket(date, a.opts.Bucket)
+	}
+	// plusieurs jours du $group peuvent tomber dans la même semaine
+	_, result := a.result(bucket, a.group(id))
+	result.Count += rawInt(raw, "count")
+	result.Successes += rawInt(raw, "successes")
+	result.Failures += rawInt(raw, "failures")
+	return nil
+}
+
+// addMeasured adds the duration of a matching event to its bucket and group
+func (a *eventAggregator) addMeasured(raw bson.Raw) error {
+	key := aggregateKey(a.bucket(raw), a.group(raw))
+	// un événement créé après le $group n'a pas été compté
+	if _, ok := a.results[key]; ok {
+		a.addDuration(key, raw)
+	}
+	return nil
+}
+
+func (a *eventAggregator) addDuration(key string, raw bson.Raw) {
+	if value, err := raw.LookupErr("metadata", "duration"); err == nil && value.Type == bsontype.EmbeddedDocument {
+		duration := time.Duration(rawInt(raw, "metadata", "duration", "seconds"))*time.Second +
+			time.Duration(rawInt(raw, "metadata", "duration", "nanos"))
+		a.durations[key] = append(a.durations[key], duration)
+	}
+}
+
+// bucket returns the bucket of the creation date of the event, zero without bucket
+func (a *eventAggregator) bucket(raw bson.Raw) time.Time {
+	if a.opts.Bucket == eventv1alpha1.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		return time.Time{}
+	}
+	return truncateToBucket(time.Unix(rawInt(raw, "metadata", "createdat", "seconds"), 0).UTC(), a.opts.Bucket)
+}
+
+// group returns the values of the grouped attributes of the event
+func (a *eventAggregator) group(raw bson.Raw) []string {
+	group := make([]string, len(a.opts.GroupBy))
+	for idx, field := range a.opts.GroupBy {
+		group[idx] = attributeValue(raw, field)
+	}
+	return group
+}
+
+// result returns the key and the statistics of bucket and group, created empty
+func (a *eventAggregator) result(bucket time.Time, group []string) (string, *AggregateResult) {
+	key := aggregateKey(bucket, group)
+	result, ok := a.results[key]
+	if !ok {
+		result = &AggregateResult{Bucket: bucket, Group: group}
+		a.results[key] = result
+	}
+	return key, result
+}
+
+func aggregateKey(bucket time.Time, group []string) string {
+	return bucket.Format(time.RFC3339) + "\x00" + strings.Join(group, "\x00")
+}
+
+// sorted returns the results by bucket, then by group
+func (a *eventAggregator) sorted() []AggregateResult {
+	results := make([]AggregateResult, 0, len(a.results))
+	for key, result := range a.results {
+		if durations := a.durations[key]; len(durations) > 0 {
+			sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+			result.Measured = int64(len(durations))
+			result.P50 = percentile(durations, 50)
+			result.P90 = percentile(durations, 90)
+			result.P99 = percentile(durations, 99)
+		}
+		results = append(results, *result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if !results[i].Bucket.Equal(results[j].Bucket) {
+			return results[i].Bucket.Before(results[j].Bucket)
+		}
+		return strings.Join(results[i].Group, "\x00") < strings.Join(results[j].Group, "\x00")
+	})
+	return results
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// truncateToBucket returns the start of the bucket of date, in UTC
+func truncateToBucket(date time.Time, bucket eventv1alpha1.TimeBucket) time.Time {
+	switch bucket {
+	case eventv1alpha1.TimeBucket_hour:
+		return date.Truncate(time.Hour)
+	case eventv1alpha1.TimeBucket_day:
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	case eventv1alpha1.TimeBucket_week:
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// attributeValue returns an attribute of the event as text, the name of the
+// value for enums
+func attributeValue(raw bson.Raw, field string) string {
+	value, err := raw.LookupErr("attributes", field)
+	if err != nil {
+		value = bson.RawValue{}
+	}
+	switch field {
+	case "environment":
+		return eventv1alpha1.Environment(rawValueInt(value)).String()
+	case "type":
+		return eventv1alpha1.Type(rawValueInt(value)).String()
+	case "status":
+		return eventv1alpha1.Status(rawValueInt(value)).String()
+	case "priority":
+		return eventv1alpha1.Priority(rawValueInt(value)).String()
+	}
+	text, _ := value.StringValueOK()
+	return text
+}
+
+// rawInt returns the integer at path in raw, 0 when missing
+func rawInt(raw bson.Raw, path ...string) int64 {
+	value, err := raw.LookupErr(path...)
+	if err != nil {
+		return 0
+	}
+	return rawValueInt(value)
+}
+
+func rawValueInt(value bson.RawValue) int64 {
+	switch value.Type {
+	case bsontype.Int32:
+		return int64(value.Int32())
+	case bsontype.Int64:
+		return value.Int64()
+	case bsontype.Double:
+		return int64(value.Double())
+	}
+	return 0
+}
+
+// aggregateProjection limits the measured events Aggregate reads to their
+// duration and the fields of their bucket and group
+func aggregateProjection(opts AggregateOptions) bson.D {
+	projection := bson.D{{Key: "metadata.duration", Value: 1}}
+	if opts.Bucket != eventv1alpha1.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		projection = append(projection, bson.E{Key: "metadata.createdat.seconds", Value: 1})
+	}
+	for _, field := range opts.GroupBy {
+		projection = append(projection, bson.E{Key: "attributes." + field, Value: 1})
+	}
+	return projection
+}
+
+// aggregateGroupID is the _id of the $group stage of Aggregate: the parts of
+// the creation date the bucket needs and the grouped attributes
+func aggregateGroupID(opts AggregateOptions) bson.D {
+	createdAt := bson.D{{Key: "$toDate", Value: bson.D{{Key: "$multiply", Value: bson.A{"$metadata.createdat.seconds", 1000}}}}}
+	var parts []string
+	switch opts.Bucket {
+	case eventv1alpha1.TimeBucket_TIME_BUCKET_UNSPECIFIED:
+	case eventv1alpha1.TimeBucket_hour:
+		parts = []string{"year", "month", "day", "hour"}
+	case eventv1alpha1.TimeBucket_day, eventv1alpha1.TimeBucket_week:
+		// les jours sont regroupés en semaines (commençant le lundi) par eventAggregator
+		parts = []string{"year", "month", "day"}
+	default:
+		parts = []string{"year", "month"}
+	}
+	operators := map[string]string{"year": "$year", "month": "$month", "day": "$dayOfMonth", "hour": "$hour"}
+
+	id := bson.D{}
+	for _, part := range parts {
+		id = append(id, bson.E{Key: part, Value: bson.D{{Key: operators[part], Value: createdAt}}})
+	}
+	attributes := bson.D{}
+	for _, field := range opts.GroupBy {
+		attributes = append(attributes, bson.E{Key: field, Value: "$attributes." + field})
+	}
+	return append(id, bson.E{Key: "attributes", Value: attributes})
+}
+
+// statusCount counts the events of a $group stage having status
+func statusCount(status eventv1alpha1.Status) bson.D {
+	return bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$eq", Value: bson.A{"$attributes.status", int32(status)}}}, 1, 0,
+	}}}}}
+}
+
+// Aggregate groups the matching events by bucket and attributes. Mongo counts
+// them in a $group stage, only the durations of the measured events are read
+// for the percentiles.
+func (c *EventStoreClient) Aggregate(ctx context.Context, matchFilter bson.D, opts AggregateOptions) ([]AggregateResult, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: matchFilter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: aggregateGroupID(opts)},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "successes", Value: statusCount(eventv1alpha1.Status_success)},
+			{Key: "failures", Value: statusCount(eventv1alpha1.Status_failure)},
+		}}},
+	}
+	aggregator := newEventAggregator(opts)
+	cursor, err := c.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		if err := aggregator.addGroup(cursor.Current); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	measured := bson.D{{Key: "$and", Value: bson.A{matchFilter, bson.D{
+		{Key: "metadata.duration", Value: bson.D{{Key: "$type", Value: "object"}}},
+	}}}}
+	durations, err := c.collection.Find(ctx, measured, options.Find().SetProjection(aggregateProjection(opts)))
+	if err != nil {
+		return nil, err
+	}
+	defer durations.Close(ctx)
+	for durations.Next(ctx) {
+		if err := aggregator.addMeasured(durations.Current); err != nil {
+			return nil, err
+		}
+	}
+	if err := durations.Err(); err != nil {
+		return nil, err
+	}
+	return aggregator.sorted(), nil
+}
+
+// Aggregate groups the matching events by bucket and attributes
+func (c *DocumentEventStore) Aggregate(ctx context.Context, matchFilter bson.D, opts AggregateOptions) ([]AggregateResult, error) {
+	aggregator := newEventAggregator(opts)
+	if err := c.collection.find(ctx, matchFilter, FindOptions{}, aggregator.add); err != nil {
+		return nil, err
+	}
+	return aggregator.sorted(), nil
+}
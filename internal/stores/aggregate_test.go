@@ -0,0 +1,68 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+)
+
+// TestEventAggregatorGroups folds the days of the $group stage of the Mongo
+// store into weeks, as the other stores do with the events themselves
+func TestEventAggregatorGroups(t *testing.T) {
+	opts := AggregateOptions{Bucket: eventv1alpha1.TimeBucket_week, GroupBy: []string{"environment", "owner"}}
+	assert.Equal(t, bson.D{
+		{Key: "metadata.duration", Value: 1},
+		{Key: "metadata.createdat.seconds", Value: 1},
+		{Key: "attributes.environment", Value: 1},
+		{Key: "attributes.owner", Value: 1},
+	}, aggregateProjection(opts))
+
+	aggregator := newEventAggregator(opts)
+	production := int32(eventv1alpha1.Environment_production)
+	for _, day := range []struct{ day, successes, failures int32 }{{4, 1, 0}, {6, 0, 1}, {10, 1, 0}, {11, 0, 0}} {
+		raw, err := bson.Marshal(bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "year", Value: int32(2024)},
+				{Key: "month", Value: int32(3)},
+				{Key: "day", Value: day.day},
+				{Key: "attributes", Value: bson.D{{Key: "environment", Value: production}}},
+			}},
+			{Key: "count", Value: int32(1)},
+			{Key: "successes", Value: day.successes},
+			{Key: "failures", Value: day.failures},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, aggregator.addGroup(raw))
+	}
+
+	for _, measured := range []struct {
+		createdAt string
+		duration  time.Duration
+	}{
+		{"2024-03-04T10:00:00Z", time.Minute},
+		{"2024-03-06T10:00:00Z", 2 * time.Minute},
+		{"2024-03-10T23:00:00Z", 10 * time.Minute},
+		// créé après le $group, sans compte
+		{"2024-03-18T10:00:00Z", time.Minute},
+	} {
+		raw, err := bson.Marshal(bson.D{
+			{Key: "attributes", Value: bson.D{{Key: "environment", Value: production}}},
+			{Key: "metadata", Value: bson.D{
+				{Key: "createdat", Value: bson.D{{Key: "seconds", Value: mustParse(t, measured.createdAt).Unix()}}},
+				{Key: "duration", Value: bson.D{{Key: "seconds", Value: int64(measured.duration / time.Second)}}},
+			}},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, aggregator.addMeasured(raw))
+	}
+
+	assert.Equal(t, []AggregateResult{
+		{Bucket: mustParse(t, "2024-03-04T00:00:00Z"), Group: []string{"production", ""}, Count: 3, Successes: 2, Failures: 1,
+			Measured: 3, P50: 2 * time.Minute, P90: 10 * time.Minute, P99: 10 * time.Minute},
+		{Bucket: mustParse(t, "2024-03-11T00:00:00Z"), Group: []string{"production", ""}, Count: 1},
+	}, aggregator.sorted(), "weeks start on Monday")
+}
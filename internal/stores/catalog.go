@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
 
@@ -33,6 +34,23 @@ func (c *CatalogStoreClient) List(ctx context.Context) (results []*v1alpha1.Cata
 	return
 }
 
+// Find returns the Catalogs matching filter, sorted and paged by opts
+func (c *CatalogStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.Catalog, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Count counts the Catalogs matching filter
+func (c *CatalogStoreClient) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.CountDocuments(ctx, filter)
+}
+
 // Get an Catalog and creates it.  Returns the server's representation of the Catalog, and an error, if there is any.
 func (c *CatalogStoreClient) Get(ctx context.Context, filter map[string]interface{}) (result *v1alpha1.Catalog, err error) {
 	result = &v1alpha1.Catalog{}
@@ -41,12 +59,40 @@ func (c *CatalogStoreClient) Get(ctx context.Context, filter map[string]interfac
 	return
 }
 
+// Update creates or updates the first matching Catalog if it is still at the
+// revision of catalogUpdate, and returns the Catalog after the update
 func (c *CatalogStoreClient) Update(ctx context.Context, filter map[string]interface{}, catalogUpdate *v1alpha1.Catalog) (result *v1alpha1.Catalog, err error) {
 	result = &v1alpha1.Catalog{}
+	revision := catalogUpdate.Revision
+	catalogUpdate.Revision++
+	defer func() {
+		if err != nil {
+			catalogUpdate.Revision = revision
+		}
+	}()
+
 	updateFilter := bson.D{{Key: "$set", Value: catalogUpdate}}
-	// Options to enable upsert and return the document after update
-	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
-	err = c.collection.FindOneAndUpdate(ctx, filter, updateFilter, opts).Decode(&result)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, atRevision(filter, "revision", revision), updateFilter, opts).Decode(&result)
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return
+	}
+	err = revisionConflict(ctx, c.collection, filter)
+	if !errors.Is(err, ErrNotFound) {
+		return
+	}
+	if revision != 0 {
+		err = ErrRevisionConflict
+		return
+	}
+
+	// No entry yet: create it, leaving untouched one created concurrently in between
+	insertFilter := bson.D{{Key: "$setOnInsert", Value: catalogUpdate}}
+	opts.SetUpsert(true)
+	err = c.collection.FindOneAndUpdate(ctx, filter, insertFilter, opts).Decode(&result)
+	if err == nil && result.Revision != catalogUpdate.Revision {
+		err = ErrRevisionConflict
+	}
 	return
 }
 
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Change is a change published on a watch feed, numbered in publication order
+type Change struct {
+	Feed      string    `bson:"feed"`
+	Sequence  int64     `bson:"sequence"`
+	Data      []byte    `bson:"data"`
+	CreatedAt time.Time `bson:"createdat"`
+}
+
+type ChangeStoreClient struct {
+	collection *mongo.Collection
+}
+
+func NewStoreChange(collection string) (c *ChangeStoreClient) {
+	return &ChangeStoreClient{
+		collection: NewClient(collection),
+	}
+}
+
+// Append stores data as the change following the last one of feed, and
+// deletes the change capacity changes older. The unique index on feed and
+// sequence numbers the changes as they are inserted: a change is stored with
+// its sequence or not at all.
+func (c *ChangeStoreClient) Append(ctx context.Context, feed string, data []byte, capacity int64) (int64, error) {
+	for {
+		last, err := c.Last(ctx, feed)
+		if err != nil {
+			return 0, err
+		}
+		change := &Change{Feed: feed, Sequence: last + 1, Data: data, CreatedAt: time.Now()}
+		_, err = c.collection.InsertOne(ctx, change)
+		if mongo.IsDuplicateKeyError(err) {
+			// une autre instance a pris ce numéro, prendre le suivant
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		_, err = c.collection.DeleteOne(ctx, bson.D{{Key: "feed", Value: feed}, {Key: "sequence", Value: change.Sequence - capacity}})
+		return change.Sequence, err
+	}
+}
+
+// Since returns at most limit changes of feed after the sequence after, in sequence order
+func (c *ChangeStoreClient) Since(ctx context.Context, feed string, after int64, limit int64) (results []*Change, err error) {
+	opts := FindOptions{Sort: []SortField{{Path: "sequence"}}, Limit: limit}
+	cursor, err := c.collection.Find(ctx, changesSince(feed, after), opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Last returns the sequence of the last change appended to feed, 0 when there is none
+func (c *ChangeStoreClient) Last(ctx context.Context, feed string) (int64, error) {
+	change := &Change{}
+	err := c.collection.FindOne(ctx,
+		bson.D{{Key: "feed", Value: feed}},
+		options.FindOne().SetSort(bson.D{{Key: "sequence", Value: -1}}),
+	).Decode(change)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	return change.Sequence, err
+}
+
+// changesSince is the filter matching the changes of feed after the sequence after
+func changesSince(feed string, after int64) bson.D {
+	return bson.D{
+		{Key: "feed", Value: feed},
+		{Key: "sequence", Value: bson.D{{Key: "$gt", Value: after}}},
+	}
+}
@@ -0,0 +1,705 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	freezev1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/google/uuid"
+)
+
+// documentCollection is the storage primitive behind the Document stores. Filters
+// use the Mongo query syntax and documents are BSON, so every implementation
+// decodes the same values as the Mongo stores.
+type documentCollection interface {
+	insertOne(ctx context.Context, v interface{}) error
+	// insertIfNone inserts v unless a document matches filter, atomically. It
+	// returns false and decodes the matching document into existing otherwise.
+	insertIfNone(ctx context.Context, filter interface{}, v interface{}, existing interface{}) (bool, error)
+	// findOne returns ErrNotFound when no document matches
+	findOne(ctx context.Context, filter interface{}, result interface{}) error
+	// find calls decode for every matching document, in insertion order unless
+	// opts sorts them
+	find(ctx context.Context, filter interface{}, opts FindOptions, decode func(raw bson.Raw) error) error
+	count(ctx context.Context, filter interface{}) (int64, error)
+	// setOne merges update into the top level fields of the first matching
+	// document, see memoryCollection.setOne
+	setOne(ctx context.Context, filter interface{}, update interface{}, upsert bool, returnAfter bool, result interface{}) error
+	// modifyOne replaces the first document matching filter by the one modify
+	// builds from it, atomically. modify gets nil when no document matches, the
+	// document it returns is then inserted, and returns nil to write nothing.
+	modifyOne(ctx context.Context, filter interface{}, modify func(doc bson.Raw) (interface{}, error)) error
+	deleteOne(ctx context.Context, filter interface{}) (int64, error)
+}
+
+// findAll decodes the documents matching filter, sorted and paged by opts
+func findAll[T any](ctx context.Context, collection documentCollection, filter interface{}, opts FindOptions) (results []*T, err error) {
+	err = collection.find(ctx, filter, opts, func(raw bson.Raw) error {
+		result := new(T)
+		if err := bson.Unmarshal(raw, result); err != nil {
+			return err
+		}
+		results = append(results, result)
+		return nil
+	})
+	return
+}
+
+// documentFields returns the top level fields of v once encoded
+func documentFields(v interface{}) (bson.D, error) {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields bson.D
+	err = bson.Unmarshal(raw, &fields)
+	return fields, err
+}
+
+// mergeDocument overwrites the top level fields of doc with the ones of update, as a Mongo $set does
+func mergeDocument(doc bson.Raw, update interface{}) (bson.D, error) {
+	var fields bson.D
+	if err := bson.Unmarshal(doc, &fields); err != nil {
+		return nil, err
+	}
+	set, err := documentFields(update)
+	if err != nil {
+		return nil, err
+	}
+	return mergeFields(fields, set), nil
+}
+
+// monthAggregator is implemented by the collections able to group events by
+// month themselves instead of decoding every matching document
+type monthAggregator interface {
+	aggregateByMonth(ctx context.Context, matchFilter bson.D, groupByService bool) ([]MonthlyStatsResult, error)
+}
+
+// DocumentEventStore stores events as BSON documents in a documentCollection
+type DocumentEventStore struct {
+	collection documentCollection
+}
+
+// List returns every Event in insertion order
+func (c *DocumentEventStore) List(ctx context.Context) ([]*eventv1alpha1.Event, error) {
+	return c.Search(ctx, map[string]interface{}{})
+}
+
+// Create assigns an id and a creation date to the Event and stores it
+func (c *DocumentEventStore) Create(ctx context.Context, eventInsert *eventv1alpha1.Event) (*eventv1alpha1.Event, error) {
+	if eventInsert.Metadata == nil {
+		eventInsert.Metadata = &eventv1alpha1.EventMetadata{}
+	}
+	eventInsert.Metadata.Id = uuid.New().String()
+	eventInsert.Metadata.CreatedAt = timestamppb.Now()
+	eventInsert.Metadata.Revision = 1
+
+	if err := c.collection.insertOne(ctx, eventInsert); err != nil {
+		return nil, err
+	}
+	return c.Get(ctx, map[string]interface{}{"metadata.id": eventInsert.Metadata.Id})
+}
+
+// Get returns the first Event matching filter. As with Mongo, the result is never nil.
+func (c *DocumentEventStore) Get(ctx context.Context, filter map[string]interface{}) (*eventv1alpha1.Event, error) {
+	result := &eventv1alpha1.Event{}
+	err := c.collection.findOne(ctx, filter, &result)
+	return result, err
+}
+
+// Search returns the Events matching filter
+func (c *DocumentEventStore) Search(ctx context.Context, filter map[string]interface{}) ([]*eventv1alpha1.Event, error) {
+	return findAll[eventv1alpha1.Event](ctx, c.collection, filter, FindOptions{})
+}
+
+// Find returns the Events matching filter, sorted and paged by opts
+func (c *DocumentEventStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*eventv1alpha1.Event, error) {
+	return findAll[eventv1alpha1.Event](ctx, c.collection, filter, opts)
+}
+
+// Update replaces the fields of the first matching Event if it is still at the
+// revision of eventUpdate, and returns the Event as it was before the update
+func (c *DocumentEventStore) Update(ctx context.Context, filter map[string]interface{}, eventUpdate *eventv1alpha1.Event) (*eventv1alpha1.Event, error) {
+	result := &eventv1alpha1.Event{}
+	if eventUpdate.Metadata == nil {
+		eventUpdate.Metadata = &eventv1alpha1.EventMetadata{}
+	}
+	revision := eventUpdate.Metadata.Revision
+	eventUpdate.Metadata.Revision++
+
+	err := c.collection.modifyOne(ctx, filter, func(doc bson.Raw) (interface{}, error) {
+		if doc == nil {
+			return nil, ErrNotFound
+		}
+		if err := bson.Unmarshal(doc, result); err != nil {
+			return nil, err
+		}
+		if result.GetMetadata().GetRevision() != revision {
+			return nil, ErrRevisionConflict
+		}
+		return mergeDocument(doc, eventUpdate)
+	})
+	if err != nil {
+		eventUpdate.Metadata.Revision = revision
+	}
+	return result, err
+}
+
+// AppendChangelog appends entries to the changelog of the first matching Event
+// and increments its revision, and returns the Event after the update
+func (c *DocumentEventStore) AppendChangelog(ctx context.Context, filter map[string]interface{}, entries ...*eventv1alpha1.ChangelogEntry) (*eventv1alpha1.Event, error) {
+	result := &eventv1alpha1.Event{}
+	err := c.collection.modifyOne(ctx, filter, func(doc bson.Raw) (interface{}, error) {
+		if doc == nil {
+			return nil, ErrNotFound
+		}
+		if err := bson.Unmarshal(doc, result); err != nil {
+			return nil, err
+		}
+		if result.Metadata == nil {
+			result.Metadata = &eventv1alpha1.EventMetadata{}
+		}
+		result.Changelog = append(result.Changelog, entries...)
+		result.Metadata.Revision++
+		return mergeDocument(doc, bson.D{
+			{Key: "changelog", Value: result.Changelog},
+			{Key: "metadata", Value: result.Metadata},
+		})
+	})
+	return result, err
+}
+
+func (c *DocumentEventStore) Delete(ctx context.Context, filter map[string]interface{}) error {
+	_, err := c.collection.deleteOne(ctx, filter)
+	return err
+}
+
+// CountWithFilter counts events matching the given filter
+func (c *DocumentEventStore) CountWithFilter(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// AggregateByMonth groups the matching events by creation month (UTC) and optionally by service
+func (c *DocumentEventStore) AggregateByMonth(ctx context.Context, matchFilter bson.D, groupByService bool) ([]MonthlyStatsResult, error) {
+	if aggregator, ok := c.collection.(monthAggregator); ok {
+		return aggregator.aggregateByMonth(ctx, matchFilter, groupByService)
+	}
+
+	counts := map[MonthlyStatsResult]int64{}
+
+	err := c.collection.find(ctx, matchFilter, FindOptions{}, func(raw bson.Raw) error {
+		var key MonthlyStatsResult
+		if seconds, err := raw.LookupErr("metadata", "createdat", "seconds"); err == nil {
+			if s, ok := seconds.AsInt64OK(); ok {
+				createdAt := time.Unix(s, 0).UTC()
+				key.Year = int32(createdAt.Year())
+				key.Month = int32(createdAt.Month())
+			}
+		}
+		if groupByService {
+			if service, err := raw.LookupErr("attributes", "service"); err == nil {
+				key.Service, _ = service.StringValueOK()
+			}
+		}
+		counts[key]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]MonthlyStatsResult, 0, len(counts))
+	for key, count := range counts {
+		key.Count = count
+		results = append(results, key)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Year != results[j].Year {
+			return results[i].Year < results[j].Year
+		}
+		if results[i].Month != results[j].Month {
+			return results[i].Month < results[j].Month
+		}
+		return results[i].Service < results[j].Service
+	})
+
+	return results, nil
+}
+
+// DocumentLockStore stores locks as BSON documents in a documentCollection
+type DocumentLockStore struct {
+	collection documentCollection
+}
+
+// List returns every Lock in insertion order
+func (c *DocumentLockStore) List(ctx context.Context) ([]*lockv1alpha1.Lock, error) {
+	return findAll[lockv1alpha1.Lock](ctx, c.collection, bson.D{}, FindOptions{})
+}
+
+// Find returns the Locks matching filter, sorted and paged by opts
+func (c *DocumentLockStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.Lock, error) {
+	return findAll[lockv1alpha1.Lock](ctx, c.collection, filter, opts)
+}
+
+// Count counts the Locks matching filter
+func (c *DocumentLockStore) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// Create assigns an id and a creation date to the Lock and stores it
+func (c *DocumentLockStore) Create(ctx context.Context, lockInsert *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error) {
+	lockInsert.Id = uuid.New().String()
+	lockInsert.CreatedAt = timestamppb.Now()
+
+	if err := c.collection.insertOne(ctx, lockInsert); err != nil {
+		return nil, err
+	}
+	return c.Get(ctx, map[string]interface{}{"id": lockInsert.Id})
+}
+
+// Acquire stores the Lock unless a Lock already holds its service, environment
+// and resource or a scope overlapping them, in which case it returns a
+// *LockedError with the holder
+func (c *DocumentLockStore) Acquire(ctx context.Context, lockInsert *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error) {
+	lockInsert.Id = uuid.New().String()
+	lockInsert.CreatedAt = timestamppb.Now()
+
+	holder := &lockv1alpha1.Lock{}
+	inserted, err := c.collection.insertIfNone(ctx, LockConflicts(lockInsert), lockInsert, &holder)
+	if err != nil {
+		return nil, err
+	}
+	if !inserted {
+		return nil, &LockedError{Holder: holder, Requested: lockInsert}
+	}
+	return c.Get(ctx, map[string]interface{}{"id": lockInsert.Id})
+}
+
+// Get returns the first Lock matching filter. As with Mongo, the result is never nil.
+func (c *DocumentLockStore) Get(ctx context.Context, filter map[string]interface{}) (*lockv1alpha1.Lock, error) {
+	result := &lockv1alpha1.Lock{}
+	err := c.collection.findOne(ctx, filter, &result)
+	return result, err
+}
+
+// Unlock deletes the first Lock matching filter and returns the number of deleted locks
+func (c *DocumentLockStore) Unlock(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return c.collection.deleteOne(ctx, filter)
+}
+
+// Update replaces the fields of the first matching Lock and returns the Lock as it was before the update
+func (c *DocumentLockStore) Update(ctx context.Context, filter map[string]interface{}, lockUpdate *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error) {
+	result := &lockv1alpha1.Lock{}
+	err := c.collection.setOne(ctx, filter, lockUpdate, false, false, &result)
+	return result, err
+}
+
+// DocumentLockHistoryStore stores the lock history as BSON documents in a documentCollection
+type DocumentLockHistoryStore struct {
+	collection documentCollection
+}
+
+// Append assigns an id, and a timestamp when it has none, to the entry and stores it
+func (c *DocumentLockHistoryStore) Append(ctx context.Context, entry *lockv1alpha1.LockHistoryEntry) error {
+	newHistoryEntry(entry)
+	return c.collection.insertOne(ctx, entry)
+}
+
+// Find returns the entries matching filter, sorted and paged by opts
+func (c *DocumentLockHistoryStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.LockHistoryEntry, error) {
+	return findAll[lockv1alpha1.LockHistoryEntry](ctx, c.collection, filter, opts)
+}
+
+// Count counts the entries matching filter
+func (c *DocumentLockHistoryStore) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// DocumentChangeStore stores the changes of the watch feeds as BSON documents
+// in a documentCollection
+type DocumentChangeStore struct {
+	collection documentCollection
+}
+
+// Append stores data as the change following the last one of feed, and
+// deletes the change capacity changes older. The change is inserted unless
+// another one took its sequence, atomically: it is stored with its sequence
+// or not at all.
+func (c *DocumentChangeStore) Append(ctx context.Context, feed string, data []byte, capacity int64) (int64, error) {
+	for {
+		last, err := c.Last(ctx, feed)
+		if err != nil {
+			return 0, err
+		}
+		change := &Change{Feed: feed, Sequence: last + 1, Data: data, CreatedAt: time.Now()}
+		var taken Change
+		inserted, err := c.collection.insertIfNone(ctx, changesSince(feed, last), change, &taken)
+		if err != nil {
+			return 0, err
+		}
+		if !inserted {
+			// une autre instance a pris ce numéro, prendre le suivant
+			continue
+		}
+		_, err = c.collection.deleteOne(ctx, bson.D{{Key: "feed", Value: feed}, {Key: "sequence", Value: change.Sequence - capacity}})
+		return change.Sequence, err
+	}
+}
+
+// Since returns at most limit changes of feed after the sequence after, in sequence order
+func (c *DocumentChangeStore) Since(ctx context.Context, feed string, after int64, limit int64) ([]*Change, error) {
+	return findAll[Change](ctx, c.collection, changesSince(feed, after), FindOptions{Sort: []SortField{{Path: "sequence"}}, Limit: limit})
+}
+
+// Last returns the sequence of the last change appended to feed, 0 when there is none
+func (c *DocumentChangeStore) Last(ctx context.Context, feed string) (int64, error) {
+	last, err := findAll[Change](ctx, c.collection, bson.D{{Key: "feed", Value: feed}},
+		FindOptions{Sort: []SortField{{Path: "sequence", Descending: true}}, Limit: 1})
+	if err != nil || len(last) == 0 {
+		return 0, err
+	}
+	return last[0].Sequence, nil
+}
+
+// DocumentIdempotencyStore stores the idempotency records as BSON documents
+// in a documentCollection
+type DocumentIdempotencyStore struct {
+	collection documentCollection
+}
+
+// Reserve inserts record unless an unexpired record holds its key, which it
+// returns then
+func (c *DocumentIdempotencyStore) Reserve(ctx context.Context, record *Idempotency) (*Idempotency, bool, error) {
+	var held *Idempotency
+	err := c.collection.modifyOne(ctx, bson.D{{Key: "key", Value: record.Key}}, func(doc bson.Raw) (interface{}, error) {
+		held = nil
+		if doc != nil {
+			existing := &Idempotency{}
+			if err := bson.Unmarshal(doc, existing); err != nil {
+				return nil, err
+			}
+			if existing.ExpiresAt.After(time.Now()) {
+				held = existing
+				return nil, nil
+			}
+		}
+		return record, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if held != nil {
+		return held, false, nil
+	}
+	return record, true, nil
+}
+
+// Complete records the event created for the reservation record, kept until
+// record.ExpiresAt. It returns ErrNotFound when the reservation was taken over.
+func (c *DocumentIdempotencyStore) Complete(ctx context.Context, record *Idempotency) error {
+	return c.collection.modifyOne(ctx, idempotencyRecord(record), func(doc bson.Raw) (interface{}, error) {
+		if doc == nil {
+			return nil, ErrNotFound
+		}
+		return mergeDocument(doc, bson.D{
+			{Key: "eventid", Value: record.EventId},
+			{Key: "expiresat", Value: record.ExpiresAt},
+		})
+	})
+}
+
+// Release deletes the reservation record when it created no event
+func (c *DocumentIdempotencyStore) Release(ctx context.Context, record *Idempotency) error {
+	_, err := c.collection.deleteOne(ctx, append(idempotencyRecord(record), bson.E{Key: "eventid", Value: ""}))
+	return err
+}
+
+// DocumentReservationStore stores reservations as BSON documents in a documentCollection
+type DocumentReservationStore struct {
+	collection documentCollection
+}
+
+// Create assigns an id and a creation date to the Reservation and stores it
+// unless it overlaps another one
+func (c *DocumentReservationStore) Create(ctx context.Context, reservation *lockv1alpha1.Reservation) (*lockv1alpha1.Reservation, error) {
+	newReservation(reservation)
+
+	existing := &lockv1alpha1.Reservation{}
+	inserted, err := c.collection.insertIfNone(ctx, ReservationOverlaps(reservation), reservation, &existing)
+	if err != nil {
+		return nil, err
+	}
+	if !inserted {
+		return nil, &ReservationOverlapError{Existing: existing}
+	}
+	return c.Get(ctx, map[string]interface{}{"id": reservation.Id})
+}
+
+// Get returns the first Reservation matching filter. As with Mongo, the result is never nil.
+func (c *DocumentReservationStore) Get(ctx context.Context, filter map[string]interface{}) (*lockv1alpha1.Reservation, error) {
+	result := &lockv1alpha1.Reservation{}
+	err := c.collection.findOne(ctx, filter, &result)
+	return result, err
+}
+
+// Find returns the Reservations matching filter, sorted and paged by opts
+func (c *DocumentReservationStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.Reservation, error) {
+	return findAll[lockv1alpha1.Reservation](ctx, c.collection, filter, opts)
+}
+
+// Count counts the Reservations matching filter
+func (c *DocumentReservationStore) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// Update replaces the fields of the first matching Reservation and returns the Reservation after the update
+func (c *DocumentReservationStore) Update(ctx context.Context, filter map[string]interface{}, reservation *lockv1alpha1.Reservation) (*lockv1alpha1.Reservation, error) {
+	result := &lockv1alpha1.Reservation{}
+	err := c.collection.setOne(ctx, filter, reservation, false, true, &result)
+	return result, err
+}
+
+// Delete deletes the first Reservation matching filter and returns the number of deleted reservations
+func (c *DocumentReservationStore) Delete(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return c.collection.deleteOne(ctx, filter)
+}
+
+// DocumentFreezeStore stores freezes as BSON documents in a documentCollection
+type DocumentFreezeStore struct {
+	collection documentCollection
+}
+
+// Create assigns an id and a creation date to the Freeze and stores it
+func (c *DocumentFreezeStore) Create(ctx context.Context, freeze *freezev1alpha1.Freeze) (*freezev1alpha1.Freeze, error) {
+	newFreeze(freeze)
+	if err := c.collection.insertOne(ctx, freeze); err != nil {
+		return nil, err
+	}
+	return c.Get(ctx, map[string]interface{}{"id": freeze.Id})
+}
+
+// Get returns the first Freeze matching filter. As with Mongo, the result is never nil.
+func (c *DocumentFreezeStore) Get(ctx context.Context, filter map[string]interface{}) (*freezev1alpha1.Freeze, error) {
+	result := &freezev1alpha1.Freeze{}
+	err := c.collection.findOne(ctx, filter, &result)
+	return result, err
+}
+
+// Find returns the Freezes matching filter, sorted and paged by opts
+func (c *DocumentFreezeStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*freezev1alpha1.Freeze, error) {
+	return findAll[freezev1alpha1.Freeze](ctx, c.collection, filter, opts)
+}
+
+// Count counts the Freezes matching filter
+func (c *DocumentFreezeStore) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// Update replaces the fields of the first matching Freeze but its exceptions,
+// and returns the Freeze after the update
+func (c *DocumentFreezeStore) Update(ctx context.Context, filter map[string]interface{}, freeze *freezev1alpha1.Freeze) (*freezev1alpha1.Freeze, error) {
+	set, err := freezeDefinition(freeze)
+	if err != nil {
+		return nil, err
+	}
+	result := &freezev1alpha1.Freeze{}
+	err = c.collection.setOne(ctx, filter, set, false, true, &result)
+	return result, err
+}
+
+// AddException appends exception to the exceptions of the Freeze id and
+// returns the Freeze after the update
+func (c *DocumentFreezeStore) AddException(ctx context.Context, id string, exception *freezev1alpha1.FreezeException) (*freezev1alpha1.Freeze, error) {
+	result := &freezev1alpha1.Freeze{}
+	err := c.collection.modifyOne(ctx, bson.D{{Key: "id", Value: id}}, func(doc bson.Raw) (interface{}, error) {
+		if doc == nil {
+			return nil, ErrNotFound
+		}
+		if err := bson.Unmarshal(doc, result); err != nil {
+			return nil, err
+		}
+		result.Exceptions = append(result.Exceptions, exception)
+		return mergeDocument(doc, bson.D{{Key: "exceptions", Value: result.Exceptions}})
+	})
+	return result, err
+}
+
+// ApproveException approves the exception exceptionId of the Freeze id if it
+// is still pending, and returns the Freeze after the update
+func (c *DocumentFreezeStore) ApproveException(ctx context.Context, id string, exceptionId string, approvedBy string, approvedAt *timestamppb.Timestamp) (*freezev1alpha1.Freeze, error) {
+	result := &freezev1alpha1.Freeze{}
+	err := c.collection.modifyOne(ctx, bson.D{{Key: "id", Value: id}}, func(doc bson.Raw) (interface{}, error) {
+		if doc == nil {
+			return nil, ErrNotFound
+		}
+		if err := bson.Unmarshal(doc, result); err != nil {
+			return nil, err
+		}
+		index := slices.IndexFunc(result.Exceptions, func(exception *freezev1alpha1.FreezeException) bool {
+			return exception.Id == exceptionId && exception.ApprovedBy == ""
+		})
+		if index < 0 {
+			return nil, ErrNotFound
+		}
+		result.Exceptions[index].ApprovedBy = approvedBy
+		result.Exceptions[index].ApprovedAt = approvedAt
+		return mergeDocument(doc, bson.D{{Key: "exceptions", Value: result.Exceptions}})
+	})
+	return result, err
+}
+
+// Delete deletes the first Freeze matching filter and returns the number of deleted freezes
+func (c *DocumentFreezeStore) Delete(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return c.collection.deleteOne(ctx, filter)
+}
+
+// DocumentCatalogStore stores catalog entries as BSON documents in a documentCollection
+type DocumentCatalogStore struct {
+	collection documentCollection
+}
+
+// List returns every Catalog in insertion order
+func (c *DocumentCatalogStore) List(ctx context.Context) ([]*catalogv1alpha1.Catalog, error) {
+	return findAll[catalogv1alpha1.Catalog](ctx, c.collection, bson.D{}, FindOptions{})
+}
+
+// Find returns the Catalogs matching filter, sorted and paged by opts
+func (c *DocumentCatalogStore) Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*catalogv1alpha1.Catalog, error) {
+	return findAll[catalogv1alpha1.Catalog](ctx, c.collection, filter, opts)
+}
+
+// Count counts the Catalogs matching filter
+func (c *DocumentCatalogStore) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.count(ctx, filter)
+}
+
+// Get returns the first Catalog matching filter. As with Mongo, the result is never nil.
+func (c *DocumentCatalogStore) Get(ctx context.Context, filter map[string]interface{}) (*catalogv1alpha1.Catalog, error) {
+	result := &catalogv1alpha1.Catalog{}
+	err := c.collection.findOne(ctx, filter, &result)
+	return result, err
+}
+
+// Update upserts the Catalog matching filter if it is still at the revision of
+// catalogUpdate, and returns it after the update
+func (c *DocumentCatalogStore) Update(ctx context.Context, filter map[string]interface{}, catalogUpdate *catalogv1alpha1.Catalog) (*catalogv1alpha1.Catalog, error) {
+	result := &catalogv1alpha1.Catalog{}
+	revision := catalogUpdate.Revision
+	catalogUpdate.Revision++
+
+	err := c.collection.modifyOne(ctx, filter, func(doc bson.Raw) (interface{}, error) {
+		var updated interface{}
+		if doc == nil {
+			if revision != 0 {
+				return nil, ErrRevisionConflict
+			}
+			set, err := documentFields(catalogUpdate)
+			if err != nil {
+				return nil, err
+			}
+			if updated, err = upsertDocument(filter, set); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := bson.Unmarshal(doc, result); err != nil {
+				return nil, err
+			}
+			if result.Revision != revision {
+				return nil, ErrRevisionConflict
+			}
+			merged, err := mergeDocument(doc, catalogUpdate)
+			if err != nil {
+				return nil, err
+			}
+			updated = merged
+		}
+
+		raw, err := bson.Marshal(updated)
+		if err != nil {
+			return nil, err
+		}
+		result = &catalogv1alpha1.Catalog{}
+		return updated, bson.Unmarshal(raw, result)
+	})
+	if err != nil {
+		catalogUpdate.Revision = revision
+	}
+	return result, err
+}
+
+func (c *DocumentCatalogStore) Delete(ctx context.Context, filter map[string]interface{}) error {
+	_, err := c.collection.deleteOne(ctx, filter)
+	return err
+}
+
+// DocumentLinksStore stores links as BSON documents in a documentCollection
+type DocumentLinksStore struct {
+	collection documentCollection
+}
+
+// List returns the links sorted by group and name
+func (c *DocumentLinksStore) List(ctx context.Context) ([]*LinkItem, error) {
+	results, err := findAll[LinkItem](ctx, c.collection, bson.D{}, FindOptions{
+		Sort: []SortField{{Path: "group"}, {Path: "name"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list links: %w", err)
+	}
+	return results, nil
+}
+
+func (c *DocumentLinksStore) Create(ctx context.Context, item *LinkItem) (*LinkItem, error) {
+	now := time.Now().Unix()
+	item.ID = primitive.NewObjectID()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+	if err := c.collection.insertOne(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to create link: %w", err)
+	}
+	return item, nil
+}
+
+func (c *DocumentLinksStore) Update(ctx context.Context, id string, item *LinkItem) (*LinkItem, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link id %s: %w", id, err)
+	}
+	item.UpdatedAt = time.Now().Unix()
+	update := bson.D{
+		{Key: "group", Value: item.Group},
+		{Key: "name", Value: item.Name},
+		{Key: "url", Value: item.URL},
+		{Key: "description", Value: item.Description},
+		{Key: "icon", Value: item.Icon},
+		{Key: "color", Value: item.Color},
+		{Key: "logo", Value: item.Logo},
+		{Key: "updated_at", Value: item.UpdatedAt},
+	}
+	var result LinkItem
+	if err := c.collection.setOne(ctx, bson.D{{Key: "_id", Value: oid}}, update, false, true, &result); err != nil {
+		return nil, fmt.Errorf("failed to update link %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+func (c *DocumentLinksStore) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid link id %s: %w", id, err)
+	}
+	if _, err := c.collection.deleteOne(ctx, bson.D{{Key: "_id", Value: oid}}); err != nil {
+		return fmt.Errorf("failed to delete link %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bananaops/tracker/internal/config"
+)
+
+// EmbeddedDatabaseFile is the name of the bbolt file created in the data directory
+const EmbeddedDatabaseFile = "tracker.db"
+
+var embeddedDatabase *bolt.DB
+var embeddedOnce sync.Once
+
+// NewEmbeddedClient returns the bbolt database shared by every store, opening
+// (and creating) it in the configured data directory on first use
+func NewEmbeddedClient() *bolt.DB {
+	embeddedOnce.Do(func() {
+		db, err := openEmbedded(config.ConfigDatabase.DataDir)
+		if err != nil {
+			log.Fatalf("error open db %s", err)
+		}
+		embeddedDatabase = db
+	})
+	return embeddedDatabase
+}
+
+func openEmbedded(dataDir string) (*bolt.DB, error) {
+	if err := os.MkdirAll(dataDir, 0o750); err != nil {
+		return nil, err
+	}
+	// bbolt locks the file: fail fast when another tracker already uses it
+	return bolt.Open(filepath.Join(dataDir, EmbeddedDatabaseFile), 0o600, &bolt.Options{Timeout: time.Second})
+}
+
+// embeddedCollection stores the BSON documents of one collection in a bbolt
+// bucket. Keys are a big endian sequence, so a cursor walks the documents in
+// insertion order like the memory and Mongo stores, and filters are evaluated
+// with the same matcher as the memory stores.
+type embeddedCollection struct {
+	db     *bolt.DB
+	bucket []byte
+}
+
+func newEmbeddedCollection(db *bolt.DB, name string) *embeddedCollection {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(name))
+		return err
+	})
+	if err != nil {
+		log.Fatalf("error create bucket %s: %s", name, err)
+	}
+	return &embeddedCollection{db: db, bucket: []byte(name)}
+}
+
+// scan calls fn with the key and the document of every document matching
+// filter, in insertion order, until fn returns false
+func (c *embeddedCollection) scan(tx *bolt.Tx, filter interface{}, fn func(key []byte, raw bson.Raw) (bool, error)) error {
+	query, err := normalizeFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	cursor := tx.Bucket(c.bucket).Cursor()
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		raw := bson.Raw(value)
+		ok, err := matchRaw(raw, query)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		next, err := fn(key, raw)
+		if err != nil || !next {
+			return err
+		}
+	}
+	return nil
+}
+
+// first returns a copy of the key and the document of the first document matching filter, nil if none
+func (c *embeddedCollection) first(tx *bolt.Tx, filter interface{}) (key []byte, doc bson.Raw, err error) {
+	err = c.scan(tx, filter, func(k []byte, raw bson.Raw) (bool, error) {
+		key = append([]byte(nil), k...)
+		doc = append(bson.Raw(nil), raw...)
+		return false, nil
+	})
+	return key, doc, err
+}
+
+func (c *embeddedCollection) put(tx *bolt.Tx, doc bson.Raw) error {
+	bucket := tx.Bucket(c.bucket)
+	seq, err := bucket.NextSequence()
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return bucket.Put(key, doc)
+}
+
+func (c *embeddedCollection) insertOne(ctx context.Context, v interface{}) error {
+	raw, err := toDocument(v)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return c.put(tx, raw)
+	})
+}
+
+func (c *embeddedCollection) insertIfNone(ctx context.Context, filter interface{}, v interface{}, existing interface{}) (bool, error) {
+	raw, err := toDocument(v)
+	if err != nil {
+		return false, err
+	}
+
+	var doc bson.Raw
+	// bbolt runs one write transaction at a time
+	err = c.db.Update(func(tx *bolt.Tx) (err error) {
+		_, doc, err = c.first(tx, filter)
+		if err != nil || doc != nil {
+			return err
+		}
+		return c.put(tx, raw)
+	})
+	if err != nil {
+		return false, err
+	}
+	if doc != nil {
+		return false, bson.Unmarshal(doc, existing)
+	}
+	return true, nil
+}
+
+func (c *embeddedCollection) findOne(ctx context.Context, filter interface{}, result interface{}) error {
+	var doc bson.Raw
+	err := c.db.View(func(tx *bolt.Tx) (err error) {
+		_, doc, err = c.first(tx, filter)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return ErrNotFound
+	}
+	return bson.Unmarshal(doc, result)
+}
+
+func (c *embeddedCollection) find(ctx context.Context, filter interface{}, opts FindOptions, decode func(raw bson.Raw) error) error {
+	var docs []bson.Raw
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return c.scan(tx, filter, func(_ []byte, raw bson.Raw) (bool, error) {
+			// bbolt memory is only valid during the transaction
+			docs = append(docs, append(bson.Raw(nil), raw...))
+			return true, nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	docs, err = sortDocuments(docs, opts)
+	if err != nil {
+		return err
+	}
+	for _, raw := range docs {
+		if err := decode(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *embeddedCollection) count(ctx context.Context, filter interface{}) (count int64, err error) {
+	err = c.db.View(func(tx *bolt.Tx) error {
+		return c.scan(tx, filter, func(_ []byte, _ bson.Raw) (bool, error) {
+			count++
+			return true, nil
+		})
+	})
+	return count, err
+}
+
+// setOne has the semantics of memoryCollection.setOne, within one bbolt transaction
+func (c *embeddedCollection) setOne(ctx context.Context, filter interface{}, update interface{}, upsert bool, returnAfter bool, result interface{}) error {
+	var set bson.D
+	raw, err := bson.Marshal(update)
+	if err != nil {
+		return err
+	}
+	if err := bson.Unmarshal(raw, &set); err != nil {
+		return err
+	}
+
+	var before, after bson.Raw
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		key, doc, err := c.first(tx, filter)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			if !upsert {
+				return ErrNotFound
+			}
+			after, err = upsertDocument(filter, set)
+			if err != nil {
+				return err
+			}
+			return c.put(tx, after)
+		}
+
+		var fields bson.D
+		if err := bson.Unmarshal(doc, &fields); err != nil {
+			return err
+		}
+		after, err = bson.Marshal(mergeFields(fields, set))
+		if err != nil {
+			return err
+		}
+		before = doc
+		return tx.Bucket(c.bucket).Put(key, after)
+	})
+	if err != nil {
+		return err
+	}
+
+	if returnAfter {
+		return bson.Unmarshal(after, result)
+	}
+	if before == nil {
+		return ErrNotFound
+	}
+	return bson.Unmarshal(before, result)
+}
+
+// modifyOne has the semantics of memoryCollection.modifyOne, within one bbolt transaction
+func (c *embeddedCollection) modifyOne(ctx context.Context, filter interface{}, modify func(doc bson.Raw) (interface{}, error)) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		key, doc, err := c.first(tx, filter)
+		if err != nil {
+			return err
+		}
+
+		replacement, err := modify(doc)
+		if err != nil || replacement == nil {
+			return err
+		}
+		updated, err := toDocument(replacement)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return c.put(tx, updated)
+		}
+		return tx.Bucket(c.bucket).Put(key, updated)
+	})
+}
+
+func (c *embeddedCollection) deleteOne(ctx context.Context, filter interface{}) (deleted int64, err error) {
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		key, _, err := c.first(tx, filter)
+		if err != nil || key == nil {
+			return err
+		}
+		deleted = 1
+		return tx.Bucket(c.bucket).Delete(key)
+	})
+	return deleted, err
+}
+
+// NewEmbeddedStoreEvent returns an event store kept in the embedded database
+func NewEmbeddedStoreEvent(collection string) *DocumentEventStore {
+	return &DocumentEventStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreLock returns a lock store kept in the embedded database
+func NewEmbeddedStoreLock(collection string) *DocumentLockStore {
+	return &DocumentLockStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreLockHistory returns a lock history store kept in the embedded database
+func NewEmbeddedStoreLockHistory(collection string) *DocumentLockHistoryStore {
+	return &DocumentLockHistoryStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreReservation returns a reservation store kept in the embedded database
+func NewEmbeddedStoreReservation(collection string) *DocumentReservationStore {
+	return &DocumentReservationStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreFreeze returns a freeze store kept in the embedded database
+func NewEmbeddedStoreFreeze(collection string) *DocumentFreezeStore {
+	return &DocumentFreezeStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreCatalog returns a catalog store kept in the embedded database
+func NewEmbeddedStoreCatalog(collection string) *DocumentCatalogStore {
+	return &DocumentCatalogStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreLinks returns a links store kept in the embedded database
+func NewEmbeddedStoreLinks(collection string) *DocumentLinksStore {
+	return &DocumentLinksStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreChange returns a store of the watch feed changes kept in the embedded database
+func NewEmbeddedStoreChange(collection string) *DocumentChangeStore {
+	return &DocumentChangeStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
+
+// NewEmbeddedStoreIdempotency returns a store of the idempotency records kept in the embedded database
+func NewEmbeddedStoreIdempotency(collection string) *DocumentIdempotencyStore {
+	return &DocumentIdempotencyStore{
+		collection: newEmbeddedCollection(NewEmbeddedClient(), collection),
+	}
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func TestEmbeddedStores(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	db, err := openEmbedded(dataDir)
+	assert.NoError(t, err)
+
+	events := &DocumentEventStore{collection: newEmbeddedCollection(db, "events")}
+	locks := &DocumentLockStore{collection: newEmbeddedCollection(db, "locks")}
+	catalogs := &DocumentCatalogStore{collection: newEmbeddedCollection(db, "catalog")}
+	links := &DocumentLinksStore{collection: newEmbeddedCollection(db, "links")}
+
+	event := testEvent(t)
+	assert.NoError(t, events.collection.insertOne(ctx, event))
+	created, err := events.Create(ctx, &eventv1alpha1.Event{Title: "second", Attributes: &eventv1alpha1.EventAttributes{Service: "billing"}})
+	assert.NoError(t, err)
+
+	update := proto.Clone(event).(*eventv1alpha1.Event)
+	update.Attributes.Status = eventv1alpha1.Status_failure
+	before, err := events.Update(ctx, map[string]interface{}{"metadata.id": "id-1"}, update)
+	assert.NoError(t, err)
+	assert.Equal(t, eventv1alpha1.Status_success, before.Attributes.Status)
+
+	lock, err := locks.Create(ctx, &lockv1alpha1.Lock{Service: "payments", Environment: "production", Resource: "deployment", Who: "alice"})
+	assert.NoError(t, err)
+	unlocked, err := locks.Unlock(ctx, map[string]interface{}{"id": lock.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), unlocked)
+	_, err = locks.Create(ctx, &lockv1alpha1.Lock{Service: "billing", Environment: "production", Resource: "deployment", Who: "bob"})
+	assert.NoError(t, err)
+
+	catalog := &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-a"}
+	_, err = catalogs.Update(ctx, map[string]interface{}{"name": "payments"}, catalog)
+	assert.NoError(t, err)
+
+	link, err := links.Create(ctx, &LinkItem{Group: "Tools", Name: "grafana", URL: "https://grafana"})
+	assert.NoError(t, err)
+
+	// everything is still there once the file is reopened
+	assert.NoError(t, db.Close())
+	db, err = openEmbedded(dataDir)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	events = &DocumentEventStore{collection: newEmbeddedCollection(db, "events")}
+	locks = &DocumentLockStore{collection: newEmbeddedCollection(db, "locks")}
+	catalogs = &DocumentCatalogStore{collection: newEmbeddedCollection(db, "catalog")}
+	links = &DocumentLinksStore{collection: newEmbeddedCollection(db, "links")}
+
+	all, err := events.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.True(t, proto.Equal(update, all[0]), "event should round-trip, got %v", all[0])
+	assert.Equal(t, created.Metadata.Id, all[1].Metadata.Id)
+
+	count, err := events.CountWithFilter(ctx, bson.D{{Key: "attributes.service", Value: "billing"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	allLocks, err := locks.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, allLocks, 1)
+	assert.Equal(t, "bob", allLocks[0].Who)
+
+	got, err := catalogs.Get(ctx, map[string]interface{}{"name": "payments"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(catalog, got), "catalog should round-trip, got %v", got)
+
+	allLinks, err := links.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, allLinks, 1)
+	assert.Equal(t, link.ID, allLinks[0].ID)
+	assert.NoError(t, links.Delete(ctx, link.ID.Hex()))
+
+	_, err = events.Get(ctx, map[string]interface{}{"metadata.id": "unknown"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEmbeddedLockStoreAcquire(t *testing.T) {
+	db, err := openEmbedded(t.TempDir())
+	assert.NoError(t, err)
+	defer db.Close()
+
+	testAcquireConcurrently(t, &DocumentLockStore{collection: newEmbeddedCollection(db, "locks")})
+	testAcquireScopes(t, &DocumentLockStore{collection: newEmbeddedCollection(db, "scoped_locks")})
+	testAcquireModes(t, &DocumentLockStore{collection: newEmbeddedCollection(db, "shared_locks")})
+	testReservationOverlaps(t, &DocumentReservationStore{collection: newEmbeddedCollection(db, "reservations")})
+	testFreezesAt(t, &DocumentFreezeStore{collection: newEmbeddedCollection(db, "freezes")})
+	testChanges(t, &DocumentChangeStore{collection: newEmbeddedCollection(db, "changes")})
+	testIdempotency(t, &DocumentIdempotencyStore{collection: newEmbeddedCollection(db, "idempotency")})
+	testAppendChangelogConcurrently(t, &DocumentEventStore{collection: newEmbeddedCollection(db, "events")})
+}
+
+func TestEmbeddedFileLocked(t *testing.T) {
+	dataDir := t.TempDir()
+
+	db, err := openEmbedded(dataDir)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = openEmbedded(dataDir)
+	assert.Error(t, err, "a second tracker cannot open the same data directory")
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
@@ -43,6 +44,7 @@ func (c *EventStoreClient) Create(ctx context.Context, eventInsert *v1alpha1.Eve
 	id := uuid.New()
 	eventInsert.Metadata.Id = id.String()
 	eventInsert.Metadata.CreatedAt = timestamppb.Now()
+	eventInsert.Metadata.Revision = 1
 
 	_, err = c.collection.InsertOne(context.TODO(), eventInsert)
 	if err != nil {
@@ -87,13 +89,72 @@ func (c *EventStoreClient) Search(ctx context.Context, filter map[string]interfa
 	return
 }
 
+// Find returns the Events matching filter, sorted and paged by opts
+func (c *EventStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.Event, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Update replaces the fields of the first matching Event if it is still at the
+// revision of eventUpdate, and returns the Event as it was before the update
 func (c *EventStoreClient) Update(ctx context.Context, filter map[string]interface{}, eventUpdate *v1alpha1.Event) (result *v1alpha1.Event, err error) {
 	result = &v1alpha1.Event{}
+	if eventUpdate.Metadata == nil {
+		eventUpdate.Metadata = &v1alpha1.EventMetadata{}
+	}
+	revision := eventUpdate.Metadata.Revision
+	eventUpdate.Metadata.Revision++
+
 	updateFilter := bson.D{{Key: "$set", Value: eventUpdate}}
-	err = c.collection.FindOneAndUpdate(context.TODO(), filter, updateFilter).Decode(&result)
+	err = c.collection.FindOneAndUpdate(context.TODO(), atRevision(filter, "metadata.revision", revision), updateFilter).Decode(&result)
+	if err != nil {
+		eventUpdate.Metadata.Revision = revision
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = revisionConflict(ctx, c.collection, filter)
+	}
+	return
+}
+
+// AppendChangelog appends entries to the changelog of the first matching Event
+// and increments its revision with a single update pipeline
+func (c *EventStoreClient) AppendChangelog(ctx context.Context, filter map[string]interface{}, entries ...*v1alpha1.ChangelogEntry) (result *v1alpha1.Event, err error) {
+	result = &v1alpha1.Event{}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.D{
+		{Key: "changelog", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+			bson.D{{Key: "$ifNull", Value: bson.A{"$changelog", bson.A{}}}},
+			bson.D{{Key: "$literal", Value: entries}},
+		}}}},
+		{Key: "metadata.revision", Value: bson.D{{Key: "$add", Value: bson.A{
+			bson.D{{Key: "$ifNull", Value: bson.A{"$metadata.revision", int64(0)}}},
+			int64(1),
+		}}}},
+	}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
 	return
 }
 
+// revisionConflict tells why an update restricted to a revision matched
+// nothing: ErrRevisionConflict when a document matches filter at another
+// revision, ErrNotFound when none does
+func revisionConflict(ctx context.Context, collection *mongo.Collection, filter map[string]interface{}) error {
+	count, err := collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrRevisionConflict
+	}
+	return ErrNotFound
+}
+
 func (c *EventStoreClient) Delete(ctx context.Context, filter map[string]interface{}) (err error) {
 	_, err = c.collection.DeleteOne(context.TODO(), filter)
 	return
@@ -0,0 +1,476 @@
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// normalizeFilter round-trips a filter (map, bson.D, bson.M...) through BSON so
+// pointers, enums and integer widths look exactly like they do in stored documents
+func normalizeFilter(filter interface{}) (bson.D, error) {
+	if filter == nil {
+		return bson.D{}, nil
+	}
+	raw, err := bson.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	var normalized bson.D
+	if err := bson.Unmarshal(raw, &normalized); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	return normalized, nil
+}
+
+// matchDocument evaluates the subset of the Mongo query language used by tracker
+// against a decoded document
+func matchDocument(doc bson.M, filter bson.D) (bool, error) {
+	for _, e := range filter {
+		switch e.Key {
+		case "$and", "$or", "$nor":
+			clauses, ok := e.Value.(bson.A)
+			if !ok {
+				return false, fmt.Errorf("%s expects an array", e.Key)
+			}
+			matched := 0
+			for _, clause := range clauses {
+				sub, ok := asDocument(clause)
+				if !ok {
+					return false, fmt.Errorf("%s expects an array of documents", e.Key)
+				}
+				m, err := matchDocument(doc, sub)
+				if err != nil {
+					return false, err
+				}
+				if m {
+					matched++
+				}
+			}
+			switch e.Key {
+			case "$and":
+				if matched != len(clauses) {
+					return false, nil
+				}
+			case "$or":
+				if matched == 0 {
+					return false, nil
+				}
+			case "$nor":
+				if matched != 0 {
+					return false, nil
+				}
+			}
+		default:
+			m, err := matchField(doc, e.Key, e.Value)
+			if err != nil || !m {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// matchRaw decodes a stored document and evaluates filter against it
+func matchRaw(raw bson.Raw, filter bson.D) (bool, error) {
+	var doc bson.M
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return false, err
+	}
+	return matchDocument(doc, filter)
+}
+
+func matchField(doc bson.M, path string, cond interface{}) (bool, error) {
+	values, found := lookupPath(doc, strings.Split(path, "."))
+
+	ops, isOperator := asDocument(cond)
+	if !isOperator || len(ops) == 0 || !strings.HasPrefix(ops[0].Key, "$") {
+		return matchEquals(values, found, cond), nil
+	}
+
+	for _, op := range ops {
+		var matched bool
+		switch op.Key {
+		case "$eq":
+			matched = matchEquals(values, found, op.Value)
+		case "$ne":
+			matched = !matchEquals(values, found, op.Value)
+		case "$gt", "$gte", "$lt", "$lte":
+			matched = matchCompare(values, op.Key, op.Value)
+		case "$in", "$nin":
+			list, ok := op.Value.(bson.A)
+			if !ok {
+				return false, fmt.Errorf("%s expects an array", op.Key)
+			}
+			for _, candidate := range list {
+				if matchEquals(values, found, candidate) {
+					matched = true
+					break
+				}
+			}
+			if op.Key == "$nin" {
+				matched = !matched
+			}
+		case "$exists":
+			matched = found == truthy(op.Value)
+		case "$regex":
+			re, err := compileRegex(op.Value, ops)
+			if err != nil {
+				return false, err
+			}
+			for _, v := range values {
+				if s, ok := v.(string); ok && re.MatchString(s) {
+					matched = true
+					break
+				}
+			}
+		case "$options":
+			// consumed by $regex
+			matched = true
+		default:
+			return false, fmt.Errorf("unsupported operator %s on %s", op.Key, path)
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// lookupPath resolves a dotted path, descending into arrays of documents the way
+// Mongo does. The returned values include the elements of a terminal array.
+func lookupPath(value interface{}, path []string) (values []interface{}, found bool) {
+	if len(path) == 0 {
+		values = append(values, value)
+		if arr, ok := value.(bson.A); ok {
+			values = append(values, arr...)
+		}
+		return values, true
+	}
+
+	switch v := value.(type) {
+	case bson.M:
+		child, ok := v[path[0]]
+		if !ok {
+			return nil, false
+		}
+		return lookupPath(child, path[1:])
+	case bson.D:
+		for _, e := range v {
+			if e.Key == path[0] {
+				return lookupPath(e.Value, path[1:])
+			}
+		}
+		return nil, false
+	case bson.A:
+		if idx, err := strconv.Atoi(path[0]); err == nil {
+			if idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			return lookupPath(v[idx], path[1:])
+		}
+		for _, elem := range v {
+			vals, ok := lookupPath(elem, path)
+			if ok {
+				found = true
+				values = append(values, vals...)
+			}
+		}
+		return values, found
+	}
+	return nil, false
+}
+
+func matchEquals(values []interface{}, found bool, cond interface{}) bool {
+	if cond == nil {
+		if !found {
+			return true
+		}
+		for _, v := range values {
+			if v == nil {
+				return true
+			}
+		}
+		return false
+	}
+	if re, ok := cond.(primitive.Regex); ok {
+		compiled, err := regexp.Compile(regexFlags(re.Options) + re.Pattern)
+		if err != nil {
+			return false
+		}
+		for _, v := range values {
+			if s, ok := v.(string); ok && compiled.MatchString(s) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range values {
+		if c, ok := compareValues(v, cond); ok && c == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func matchCompare(values []interface{}, op string, cond interface{}) bool {
+	for _, v := range values {
+		c, ok := compareValues(v, cond)
+		if !ok {
+			continue
+		}
+		switch op {
+		case "$gt":
+			if c > 0 {
+				return true
+			}
+		case "$gte":
+			if c >= 0 {
+				return true
+			}
+		case "$lt":
+			if c < 0 {
+				return true
+			}
+		case "$lte":
+			if c <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compareValues orders two BSON values of the same type class. The boolean is
+// false when the values cannot be compared (e.g. a string against a number).
+func compareValues(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	switch va := a.(type) {
+	case string:
+		vb, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(va, vb), true
+	case bool:
+		vb, ok := b.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case va == vb:
+			return 0, true
+		case !va:
+			return -1, true
+		}
+		return 1, true
+	case primitive.ObjectID:
+		vb, ok := b.(primitive.ObjectID)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(va.Hex(), vb.Hex()), true
+	case primitive.DateTime:
+		vb, ok := b.(primitive.DateTime)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case va < vb:
+			return -1, true
+		case va > vb:
+			return 1, true
+		}
+		return 0, true
+	case nil:
+		if b == nil {
+			return 0, true
+		}
+		return 0, false
+	}
+
+	// documents and arrays only support equality
+	ra, errA := bson.Marshal(bson.M{"v": a})
+	rb, errB := bson.Marshal(bson.M{"v": b})
+	if errA != nil || errB != nil || string(ra) != string(rb) {
+		return 0, false
+	}
+	return 0, true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func truthy(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	if f, ok := toFloat(v); ok {
+		return f != 0
+	}
+	return v != nil
+}
+
+func asDocument(v interface{}) (bson.D, bool) {
+	switch d := v.(type) {
+	case bson.D:
+		return d, true
+	case bson.M:
+		doc := make(bson.D, 0, len(d))
+		for k, val := range d {
+			doc = append(doc, bson.E{Key: k, Value: val})
+		}
+		return doc, true
+	}
+	return nil, false
+}
+
+func compileRegex(pattern interface{}, ops bson.D) (*regexp.Regexp, error) {
+	var options string
+	for _, op := range ops {
+		if op.Key == "$options" {
+			options, _ = op.Value.(string)
+		}
+	}
+	switch p := pattern.(type) {
+	case string:
+		return regexp.Compile(regexFlags(options) + p)
+	case primitive.Regex:
+		if options == "" {
+			options = p.Options
+		}
+		return regexp.Compile(regexFlags(options) + p.Pattern)
+	}
+	return nil, fmt.Errorf("$regex expects a string")
+}
+
+func regexFlags(options string) string {
+	var flags string
+	for _, o := range options {
+		switch o {
+		case 'i', 'm', 's':
+			flags += string(o)
+		}
+	}
+	if flags == "" {
+		return ""
+	}
+	return "(?" + flags + ")"
+}
+
+// sortDocuments orders docs like a Mongo sort on opts.Sort, then applies
+// opts.Skip and opts.Limit. Without sort fields docs keep their order.
+func sortDocuments(docs []bson.Raw, opts FindOptions) ([]bson.Raw, error) {
+	if len(opts.Sort) > 0 {
+		keys := make([][]interface{}, len(docs))
+		for i, raw := range docs {
+			var doc bson.M
+			if err := bson.Unmarshal(raw, &doc); err != nil {
+				return nil, err
+			}
+			keys[i] = make([]interface{}, len(opts.Sort))
+			for j, field := range opts.Sort {
+				if values, found := lookupPath(doc, strings.Split(field.Path, ".")); found && len(values) > 0 {
+					keys[i][j] = values[0]
+				}
+			}
+		}
+
+		order := make([]int, len(docs))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			for j, field := range opts.Sort {
+				c := compareSortValues(keys[order[a]][j], keys[order[b]][j])
+				if c == 0 {
+					continue
+				}
+				if field.Descending {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+
+		sorted := make([]bson.Raw, len(docs))
+		for i, idx := range order {
+			sorted[i] = docs[idx]
+		}
+		docs = sorted
+	}
+
+	if opts.Skip > 0 {
+		if opts.Skip >= int64(len(docs)) {
+			return nil, nil
+		}
+		docs = docs[opts.Skip:]
+	}
+	if opts.Limit > 0 && opts.Limit < int64(len(docs)) {
+		docs = docs[:opts.Limit]
+	}
+	return docs, nil
+}
+
+// sortTypeOrder ranks BSON types the way Mongo orders them in a sort
+func sortTypeOrder(v interface{}) int {
+	if _, ok := toFloat(v); ok {
+		return 1
+	}
+	switch v.(type) {
+	case nil:
+		return 0
+	case string:
+		return 2
+	case bson.M, bson.D:
+		return 3
+	case bson.A:
+		return 4
+	case primitive.ObjectID:
+		return 5
+	case bool:
+		return 6
+	case primitive.DateTime:
+		return 7
+	}
+	return 8
+}
+
+func compareSortValues(a, b interface{}) int {
+	ta, tb := sortTypeOrder(a), sortTypeOrder(b)
+	if ta != tb {
+		return ta - tb
+	}
+	c, _ := compareValues(a, b)
+	return c
+}
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/bananaops/tracker/internal/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testDocument(t *testing.T, v interface{}) bson.M {
+	raw, err := bson.Marshal(v)
+	assert.NoError(t, err)
+	var doc bson.M
+	assert.NoError(t, bson.Unmarshal(raw, &doc))
+	return doc
+}
+
+func TestMatchDocument(t *testing.T) {
+
+	event := &v1alpha1.Event{
+		Title: "deploy",
+		Attributes: &v1alpha1.EventAttributes{
+			Service:      "payments",
+			Type:         v1alpha1.Type_deployment,
+			Environment:  v1alpha1.Environment_production,
+			Status:       v1alpha1.Status_success,
+			StartDate:    timestamppb.New(timestamppb.Now().AsTime().Add(-3600e9)),
+			StakeHolders: []string{"alice", "bob"},
+		},
+		Metadata: &v1alpha1.EventMetadata{Id: "id-1", CreatedAt: timestamppb.Now()},
+	}
+	doc := testDocument(t, event)
+	id := "id-1"
+
+	testCases := []struct {
+		name     string
+		filter   interface{}
+		excepted bool
+	}{
+		{
+			name:     "OK - Test equality on nested string",
+			filter:   map[string]interface{}{"attributes.service": "payments"},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test equality with a pointer",
+			filter:   map[string]interface{}{"metadata.id": &id},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test equality with an enum",
+			filter:   map[string]interface{}{"attributes.environment": v1alpha1.Environment_production},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test equality mismatch",
+			filter:   map[string]interface{}{"attributes.service": "billing"},
+			excepted: false,
+		},
+		{
+			name:     "OK - Test equality on array element",
+			filter:   bson.D{{Key: "attributes.stakeholders", Value: "bob"}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test missing field matches null",
+			filter:   bson.D{{Key: "metadata.slackid2", Value: nil}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test $in",
+			filter:   bson.D{{Key: "attributes.type", Value: bson.D{{Key: "$in", Value: []int32{1, 2}}}}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test $nin",
+			filter:   bson.D{{Key: "attributes.type", Value: bson.D{{Key: "$nin", Value: []int32{1, 2}}}}},
+			excepted: false,
+		},
+		{
+			name:     "OK - Test $ne",
+			filter:   bson.D{{Key: "attributes.status", Value: bson.D{{Key: "$ne", Value: v1alpha1.Status_failure}}}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test $exists",
+			filter:   bson.D{{Key: "metadata.createdat", Value: bson.D{{Key: "$exists", Value: true}}}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test $regex with options",
+			filter:   bson.D{{Key: "title", Value: bson.D{{Key: "$regex", Value: "^DEP"}, {Key: "$options", Value: "i"}}}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test primitive.Regex",
+			filter:   bson.D{{Key: "title", Value: primitive.Regex{Pattern: "loy$"}}},
+			excepted: true,
+		},
+		{
+			name: "OK - Test $or",
+			filter: bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "attributes.service", Value: "billing"}},
+				bson.D{{Key: "attributes.service", Value: "payments"}},
+			}}},
+			excepted: true,
+		},
+		{
+			name: "OK - Test $and",
+			filter: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "attributes.service", Value: "payments"}},
+				bson.D{{Key: "title", Value: "rollback"}},
+			}}},
+			excepted: false,
+		},
+		{
+			name: "OK - Test range with int64 bounds against stored seconds",
+			filter: bson.D{{Key: "metadata.createdat.seconds", Value: bson.D{
+				{Key: "$gte", Value: event.Metadata.CreatedAt.Seconds - 10},
+				{Key: "$lte", Value: event.Metadata.CreatedAt.Seconds + 10},
+			}}},
+			excepted: true,
+		},
+		{
+			name:     "OK - Test number does not compare with string",
+			filter:   bson.D{{Key: "attributes.service", Value: bson.D{{Key: "$gt", Value: 1}}}},
+			excepted: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		query, err := normalizeFilter(testCase.filter)
+		assert.NoError(t, err, testCase.name)
+		matched, err := matchDocument(doc, query)
+		assert.NoError(t, err, testCase.name)
+		assert.Equal(t, testCase.excepted, matched, testCase.name)
+	}
+}
+
+func TestMatchDocumentUtilsFilters(t *testing.T) {
+
+	event := &v1alpha1.Event{
+		Attributes: &v1alpha1.EventAttributes{
+			Service:     "payments",
+			Source:      "github",
+			Environment: v1alpha1.Environment_production,
+			Priority:    v1alpha1.Priority_P1,
+			StartDate:   timestamppb.New(mustParse(t, "2024-03-10T10:00:00Z")),
+		},
+		Metadata: &v1alpha1.EventMetadata{CreatedAt: timestamppb.New(mustParse(t, "2024-03-10T10:00:00Z"))},
+	}
+	doc := testDocument(t, event)
+
+	searchFilter, err := utils.CreateFilter(&v1alpha1.SearchEventsRequest{
+		Service:     "payments",
+		Environment: v1alpha1.Environment_production,
+		StartDate:   "2024-03-01",
+		EndDate:     "2024-03-31",
+		Query:       `service in (payments,billing) and priority!=P2 and source~"GIT*" and not (impact=true or owner!="")`,
+	})
+	assert.NoError(t, err)
+	query, err := normalizeFilter(searchFilter)
+	assert.NoError(t, err)
+	matched, err := matchDocument(doc, query)
+	assert.NoError(t, err)
+	assert.True(t, matched, "CreateFilter output should match")
+
+	statsFilter, err := utils.CreateStatsFilter(&utils.StatsFilter{
+		StartDate:  "2024-03-01",
+		EndDate:    "2024-03-31",
+		Priorities: []int32{int32(v1alpha1.Priority_P2)},
+	})
+	assert.NoError(t, err)
+	query, err = normalizeFilter(statsFilter)
+	assert.NoError(t, err)
+	matched, err = matchDocument(doc, query)
+	assert.NoError(t, err)
+	assert.False(t, matched, "CreateStatsFilter output should not match another priority")
+}
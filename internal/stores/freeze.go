@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/google/uuid"
+)
+
+type FreezeStoreClient struct {
+	collection *mongo.Collection
+}
+
+func NewStoreFreeze(collection string) (c *FreezeStoreClient) {
+	return &FreezeStoreClient{
+		collection: NewClient(collection),
+	}
+}
+
+// Create assigns an id and a creation date to the Freeze and stores it
+func (c *FreezeStoreClient) Create(ctx context.Context, freeze *v1alpha1.Freeze) (*v1alpha1.Freeze, error) {
+	newFreeze(freeze)
+	if _, err := c.collection.InsertOne(ctx, freeze); err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
+
+// Get returns the first Freeze matching filter
+func (c *FreezeStoreClient) Get(ctx context.Context, filter map[string]interface{}) (result *v1alpha1.Freeze, err error) {
+	result = &v1alpha1.Freeze{}
+	err = c.collection.FindOne(ctx, filter).Decode(&result)
+	return
+}
+
+// Find returns the Freezes matching filter, sorted and paged by opts
+func (c *FreezeStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.Freeze, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Count counts the Freezes matching filter
+func (c *FreezeStoreClient) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.CountDocuments(ctx, filter)
+}
+
+// Update replaces the fields of the first matching Freeze but its exceptions,
+// and returns the Freeze after the update
+func (c *FreezeStoreClient) Update(ctx context.Context, filter map[string]interface{}, freeze *v1alpha1.Freeze) (result *v1alpha1.Freeze, err error) {
+	set, err := freezeDefinition(freeze)
+	if err != nil {
+		return nil, err
+	}
+	result = &v1alpha1.Freeze{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, filter, bson.D{{Key: "$set", Value: set}}, opts).Decode(&result)
+	return
+}
+
+// AddException appends exception to the exceptions of the Freeze id and
+// returns the Freeze after the update
+func (c *FreezeStoreClient) AddException(ctx context.Context, id string, exception *v1alpha1.FreezeException) (result *v1alpha1.Freeze, err error) {
+	// Un pipeline plutôt que $push : exceptions vaut null sur un freeze créé sans exception
+	update := bson.A{bson.D{{Key: "$set", Value: bson.D{{Key: "exceptions", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+		bson.D{{Key: "$ifNull", Value: bson.A{"$exceptions", bson.A{}}}},
+		bson.D{{Key: "$literal", Value: bson.A{exception}}},
+	}}}}}}}}
+	result = &v1alpha1.Freeze{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, bson.D{{Key: "id", Value: id}}, update, opts).Decode(&result)
+	return
+}
+
+// ApproveException approves the exception exceptionId of the Freeze id if it
+// is still pending, and returns the Freeze after the update
+func (c *FreezeStoreClient) ApproveException(ctx context.Context, id string, exceptionId string, approvedBy string, approvedAt *timestamppb.Timestamp) (result *v1alpha1.Freeze, err error) {
+	filter := bson.D{
+		{Key: "id", Value: id},
+		{Key: "exceptions", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+			{Key: "id", Value: exceptionId},
+			{Key: "approvedby", Value: ""},
+		}}}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "exceptions.$.approvedby", Value: approvedBy},
+		{Key: "exceptions.$.approvedat", Value: approvedAt},
+	}}}
+	result = &v1alpha1.Freeze{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	return
+}
+
+// Delete deletes the first Freeze matching filter and returns the number of deleted freezes
+func (c *FreezeStoreClient) Delete(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	result, err := c.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// freezeDefinition returns the fields of freeze that Update replaces: all of
+// them but the exceptions, which AddException and ApproveException change
+// atomically
+func freezeDefinition(freeze *v1alpha1.Freeze) (bson.D, error) {
+	fields, err := documentFields(freeze)
+	if err != nil {
+		return nil, err
+	}
+	definition := bson.D{}
+	for _, field := range fields {
+		if field.Key != "exceptions" {
+			definition = append(definition, field)
+		}
+	}
+	return definition, nil
+}
+
+func newFreeze(freeze *v1alpha1.Freeze) {
+	freeze.Id = uuid.New().String()
+	freeze.CreatedAt = timestamppb.Now()
+	freeze.UpdatedAt = freeze.CreatedAt
+}
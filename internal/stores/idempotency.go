@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Idempotency records the creation made with an idempotency key. Its EventId
+// is empty while the creation is in progress.
+type Idempotency struct {
+	Id          string    `bson:"id"`
+	Key         string    `bson:"key"`
+	RequestHash string    `bson:"requesthash"`
+	EventId     string    `bson:"eventid"`
+	CreatedAt   time.Time `bson:"createdat"`
+	ExpiresAt   time.Time `bson:"expiresat"`
+}
+
+type IdempotencyStoreClient struct {
+	collection *mongo.Collection
+}
+
+func NewStoreIdempotency(collection string) (c *IdempotencyStoreClient) {
+	return &IdempotencyStoreClient{
+		collection: NewClient(collection),
+	}
+}
+
+// Reserve inserts record unless an unexpired record holds its key, which it
+// returns then. The unique index on key settles concurrent reservations.
+func (c *IdempotencyStoreClient) Reserve(ctx context.Context, record *Idempotency) (*Idempotency, bool, error) {
+	for {
+		_, err := c.collection.InsertOne(ctx, record)
+		if err == nil {
+			return record, true, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, false, err
+		}
+
+		held := &Idempotency{}
+		err = c.collection.FindOne(ctx, bson.D{{Key: "key", Value: record.Key}}).Decode(held)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// supprimé par l'index TTL entre-temps
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if held.ExpiresAt.After(time.Now()) {
+			return held, false, nil
+		}
+
+		// remplacer l'enregistrement expiré, sauf si une autre requête l'a fait
+		result, err := c.collection.ReplaceOne(ctx, idempotencyRecord(held), record)
+		if err != nil {
+			return nil, false, err
+		}
+		if result.MatchedCount == 1 {
+			return record, true, nil
+		}
+	}
+}
+
+// Complete records the event created for the reservation record, kept until
+// record.ExpiresAt. It returns ErrNotFound when the reservation was taken over.
+func (c *IdempotencyStoreClient) Complete(ctx context.Context, record *Idempotency) error {
+	result, err := c.collection.UpdateOne(ctx, idempotencyRecord(record), bson.D{{Key: "$set", Value: bson.D{
+		{Key: "eventid", Value: record.EventId},
+		{Key: "expiresat", Value: record.ExpiresAt},
+	}}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Release deletes the reservation record when it created no event
+func (c *IdempotencyStoreClient) Release(ctx context.Context, record *Idempotency) error {
+	_, err := c.collection.DeleteOne(ctx, append(idempotencyRecord(record), bson.E{Key: "eventid", Value: ""}))
+	return err
+}
+
+// idempotencyRecord is the filter matching the reservation record
+func idempotencyRecord(record *Idempotency) bson.D {
+	return bson.D{
+		{Key: "key", Value: record.Key},
+		{Key: "id", Value: record.Id},
+	}
+}
@@ -2,9 +2,13 @@ package store
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"sort"
 	"time"
 
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"github.com/bananaops/tracker/internal/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -25,11 +29,36 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 		return err
 	}
 
+	// Index pour la collection lock_history
+	if err := ensureLockHistoryIndexes(ctx, db, logger); err != nil {
+		return err
+	}
+
+	// Index pour la collection reservations
+	if err := ensureReservationIndexes(ctx, db, logger); err != nil {
+		return err
+	}
+
+	// Index pour la collection freezes
+	if err := ensureFreezeIndexes(ctx, db, logger); err != nil {
+		return err
+	}
+
 	// Index pour la collection catalogs
 	if err := ensureCatalogIndexes(ctx, db, logger); err != nil {
 		return err
 	}
 
+	// Index pour la collection changes
+	if err := ensureChangeIndexes(ctx, db, logger); err != nil {
+		return err
+	}
+
+	// Index pour la collection idempotency
+	if err := ensureIdempotencyIndexes(ctx, db, logger); err != nil {
+		return err
+	}
+
 	// Index pour la collection links
 	if err := ensureLinksIndexes(ctx, db, logger); err != nil {
 		return err
@@ -110,11 +139,37 @@ func ensureEventIndexes(ctx context.Context, db *mongo.Database, logger *slog.Lo
 			Keys:    bson.D{{Key: "metadata.createdat.seconds", Value: -1}},
 			Options: options.Index().SetName("idx_createdat"),
 		},
+		// Index texte pour la recherche plein texte (paramètre q de SearchEvents),
+		// pondéré comme utils.TextFieldWeights. default_language "none" : pas de
+		// stemming ni de mots vides, les titres mélangent français et anglais
+		{
+			Keys: bson.D{
+				{Key: "title", Value: "text"},
+				{Key: "attributes.message", Value: "text"},
+				{Key: "changelog.comment", Value: "text"},
+				{Key: "links.ticket", Value: "text"},
+				{Key: "links.pullrequestlink", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_events_text").
+				SetWeights(textIndexWeights()).
+				SetDefaultLanguage("none"),
+		},
 	}
 
 	return createIndexes(ctx, collection, indexes, logger, "events")
 }
 
+// textIndexWeights returns the weights of the fields of the text index
+func textIndexWeights() bson.D {
+	weights := bson.D{}
+	for field, weight := range utils.TextFieldWeights {
+		weights = append(weights, bson.E{Key: field, Value: weight})
+	}
+	sort.Slice(weights, func(i, j int) bool { return weights[i].Key < weights[j].Key })
+	return weights
+}
+
 func ensureLockIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
 	collection := db.Collection("locks")
 
@@ -131,11 +186,32 @@ func ensureLockIndexes(ctx context.Context, db *mongo.Database, logger *slog.Log
 					{Key: "id", Value: bson.D{{Key: "$gt", Value: ""}}},
 				}),
 		},
+		// Index unique sur service, environment et resource : un seul lock exclusif
+		// par ressource, garanti par la base même si deux pipelines verrouillent en
+		// même temps. Les locks partagés (mode 2) n'en font pas partie.
+		{
+			Keys: bson.D{
+				{Key: "service", Value: 1},
+				{Key: "environment", Value: 1},
+				{Key: "resource", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true).
+				SetName("idx_lock_service_env_resource_exclusive").
+				SetPartialFilterExpression(bson.D{
+					{Key: "mode", Value: bson.D{{Key: "$lt", Value: int32(lockv1alpha1.LockMode_shared)}}},
+				}),
+		},
 		// Index sur created_at pour gérer les locks expirés
 		{
 			Keys:    bson.D{{Key: "createdat.seconds", Value: 1}},
 			Options: options.Index().SetName("idx_lock_createdat"),
 		},
+		// Index sur expires_at pour libérer les locks expirés
+		{
+			Keys:    bson.D{{Key: "expiresat.seconds", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_lock_expiresat"),
+		},
 		// Index composé sur environment et resource pour les locks par ressource
 		{
 			Keys: bson.D{
@@ -146,9 +222,136 @@ func ensureLockIndexes(ctx context.Context, db *mongo.Database, logger *slog.Log
 		},
 	}
 
+	// Les locks enregistrés avant les locks partagés n'ont pas de mode : ils sont
+	// exclusifs, mais l'index partiel les ignorerait sans mode explicite
+	backfill, err := collection.UpdateMany(ctx,
+		bson.D{{Key: "mode", Value: bson.D{{Key: "$exists", Value: false}}}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "mode", Value: int32(lockv1alpha1.LockMode_exclusive)}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if backfill.ModifiedCount > 0 {
+		logger.Info("Lock mode backfilled", "collection", "locks", "count", backfill.ModifiedCount)
+	}
+
+	// remplacé par idx_lock_service_env_resource_exclusive avec les locks partagés
+	if _, err := collection.Indexes().DropOne(ctx, "idx_lock_service_env_resource"); err != nil && !isIndexNotFound(err) {
+		logger.Warn("Failed to drop index", "collection", "locks", "index", "idx_lock_service_env_resource", "error", err)
+	}
+
 	return createIndexes(ctx, collection, indexes, logger, "locks")
 }
 
+func ensureLockHistoryIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	collection := db.Collection("lock_history")
+
+	indexes := []mongo.IndexModel{
+		// Index sur timestamp pour parcourir l'historique par date
+		{
+			Keys:    bson.D{{Key: "timestamp.seconds", Value: -1}},
+			Options: options.Index().SetName("idx_lock_history_timestamp"),
+		},
+		// Index composé sur service et environment pour l'historique d'un service
+		{
+			Keys: bson.D{
+				{Key: "service", Value: 1},
+				{Key: "environment", Value: 1},
+				{Key: "timestamp.seconds", Value: -1},
+			},
+			Options: options.Index().SetName("idx_lock_history_service_env"),
+		},
+		// Index sur lockid pour retrouver la vie d'un lock
+		{
+			Keys:    bson.D{{Key: "lockid", Value: 1}},
+			Options: options.Index().SetName("idx_lock_history_lockid"),
+		},
+	}
+
+	return createIndexes(ctx, collection, indexes, logger, "lock_history")
+}
+
+func ensureReservationIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	collection := db.Collection("reservations")
+
+	indexes := []mongo.IndexModel{
+		// Index unique sur id pour les recherches par ID
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_reservation_id"),
+		},
+		// Index composé sur environment et dates pour les chevauchements et les réservations à venir
+		{
+			Keys: bson.D{
+				{Key: "environment", Value: 1},
+				{Key: "end.seconds", Value: 1},
+				{Key: "start.seconds", Value: 1},
+			},
+			Options: options.Index().SetName("idx_reservation_env_period"),
+		},
+	}
+
+	return createIndexes(ctx, collection, indexes, logger, "reservations")
+}
+
+func ensureChangeIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	collection := db.Collection("changes")
+
+	indexes := []mongo.IndexModel{
+		// Index unique sur feed et sequence : un numéro par changement, lus dans l'ordre
+		{
+			Keys: bson.D{
+				{Key: "feed", Value: 1},
+				{Key: "sequence", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetName("idx_change_feed_sequence"),
+		},
+	}
+
+	return createIndexes(ctx, collection, indexes, logger, "changes")
+}
+
+func ensureIdempotencyIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	collection := db.Collection("idempotency")
+
+	indexes := []mongo.IndexModel{
+		// Index unique sur key : une seule création par clé, quelle que soit l'instance
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_idempotency_key"),
+		},
+		// Index TTL sur expiresat : Mongo supprime les clés dont la fenêtre est passée
+		{
+			Keys:    bson.D{{Key: "expiresat", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0).SetName("idx_idempotency_expiresat"),
+		},
+	}
+
+	return createIndexes(ctx, collection, indexes, logger, "idempotency")
+}
+
+func ensureFreezeIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
+	collection := db.Collection("freezes")
+
+	indexes := []mongo.IndexModel{
+		// Index unique sur id pour les recherches par ID
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_freeze_id"),
+		},
+		// Index composé sur environment et fin pour les gels en vigueur
+		{
+			Keys: bson.D{
+				{Key: "environment", Value: 1},
+				{Key: "end.seconds", Value: 1},
+			},
+			Options: options.Index().SetName("idx_freeze_env_end"),
+		},
+	}
+
+	return createIndexes(ctx, collection, indexes, logger, "freezes")
+}
+
 func ensureCatalogIndexes(ctx context.Context, db *mongo.Database, logger *slog.Logger) error {
 	collection := db.Collection("catalogs")
 
@@ -192,6 +395,12 @@ func ensureLinksIndexes(ctx context.Context, db *mongo.Database, logger *slog.Lo
 	return createIndexes(ctx, collection, indexes, logger, "links")
 }
 
+// isIndexNotFound tells whether err reports an index or a collection that does not exist
+func isIndexNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == 27 || cmdErr.Code == 26)
+}
+
 func createIndexes(ctx context.Context, collection *mongo.Collection, indexes []mongo.IndexModel, logger *slog.Logger, collectionName string) error {
 	// Créer un contexte avec timeout pour éviter les blocages
 	ctxTimeout, cancel := context.WithTimeout(ctx, 30*time.Second)
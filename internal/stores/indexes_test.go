@@ -58,11 +58,27 @@ func TestEnsureIndexes(t *testing.T) {
 		}
 	}
 
+	// Un lock enregistré avant les locks partagés, sans mode
+	if _, err := testDB.Collection("locks").InsertOne(ctx, bson.D{{Key: "id", Value: "legacy"}, {Key: "service", Value: "payments"}}); err != nil {
+		t.Fatalf("Failed to insert legacy lock: %v", err)
+	}
+
 	// Créer les index
 	if err := EnsureIndexes(ctx, testDB); err != nil {
 		t.Fatalf("Failed to ensure indexes: %v", err)
 	}
 
+	// Vérifier que le mode des anciens locks est renseigné
+	t.Run("LockModeBackfill", func(t *testing.T) {
+		var legacy bson.M
+		if err := testDB.Collection("locks").FindOne(ctx, bson.D{{Key: "id", Value: "legacy"}}).Decode(&legacy); err != nil {
+			t.Fatalf("Failed to find legacy lock: %v", err)
+		}
+		if legacy["mode"] != int32(1) {
+			t.Errorf("Expected legacy lock to be exclusive, got mode %v", legacy["mode"])
+		}
+	})
+
 	// Vérifier les index de la collection events
 	t.Run("EventIndexes", func(t *testing.T) {
 		indexes := testDB.Collection("events").Indexes()
@@ -89,6 +105,7 @@ func TestEnsureIndexes(t *testing.T) {
 			"idx_priority",
 			"idx_timeline",
 			"idx_createdat",
+			"idx_events_text",
 		}
 
 		indexNames := make(map[string]bool)
@@ -123,7 +140,9 @@ func TestEnsureIndexes(t *testing.T) {
 
 		expectedIndexes := []string{
 			"idx_lock_id",
+			"idx_lock_service_env_resource_exclusive",
 			"idx_lock_createdat",
+			"idx_lock_expiresat",
 			"idx_lock_env_resource",
 		}
 
@@ -143,6 +162,170 @@ func TestEnsureIndexes(t *testing.T) {
 		t.Logf("Found %d indexes for locks collection", len(results))
 	})
 
+	// Vérifier les index de la collection lock_history
+	t.Run("LockHistoryIndexes", func(t *testing.T) {
+		indexes := testDB.Collection("lock_history").Indexes()
+		cursor, err := indexes.List(ctx)
+		if err != nil {
+			t.Fatalf("Failed to list indexes: %v", err)
+		}
+		defer cursor.Close(ctx)
+
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			t.Fatalf("Failed to decode indexes: %v", err)
+		}
+
+		expectedIndexes := []string{
+			"idx_lock_history_timestamp",
+			"idx_lock_history_service_env",
+			"idx_lock_history_lockid",
+		}
+
+		indexNames := make(map[string]bool)
+		for _, idx := range results {
+			if name, ok := idx["name"].(string); ok {
+				indexNames[name] = true
+			}
+		}
+
+		for _, expected := range expectedIndexes {
+			if !indexNames[expected] {
+				t.Errorf("Expected index %s not found", expected)
+			}
+		}
+
+		t.Logf("Found %d indexes for lock_history collection", len(results))
+	})
+
+	// Vérifier les index de la collection reservations
+	t.Run("ReservationIndexes", func(t *testing.T) {
+		indexes := testDB.Collection("reservations").Indexes()
+		cursor, err := indexes.List(ctx)
+		if err != nil {
+			t.Fatalf("Failed to list indexes: %v", err)
+		}
+		defer cursor.Close(ctx)
+
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			t.Fatalf("Failed to decode indexes: %v", err)
+		}
+
+		expectedIndexes := []string{
+			"idx_reservation_id",
+			"idx_reservation_env_period",
+		}
+
+		indexNames := make(map[string]bool)
+		for _, idx := range results {
+			if name, ok := idx["name"].(string); ok {
+				indexNames[name] = true
+			}
+		}
+
+		for _, expected := range expectedIndexes {
+			if !indexNames[expected] {
+				t.Errorf("Expected index %s not found", expected)
+			}
+		}
+
+		t.Logf("Found %d indexes for reservations collection", len(results))
+	})
+
+	// Vérifier les index de la collection freezes
+	t.Run("FreezeIndexes", func(t *testing.T) {
+		indexes := testDB.Collection("freezes").Indexes()
+		cursor, err := indexes.List(ctx)
+		if err != nil {
+			t.Fatalf("Failed to list indexes: %v", err)
+		}
+		defer cursor.Close(ctx)
+
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			t.Fatalf("Failed to decode indexes: %v", err)
+		}
+
+		expectedIndexes := []string{
+			"idx_freeze_id",
+			"idx_freeze_env_end",
+		}
+
+		indexNames := make(map[string]bool)
+		for _, idx := range results {
+			if name, ok := idx["name"].(string); ok {
+				indexNames[name] = true
+			}
+		}
+
+		for _, expected := range expectedIndexes {
+			if !indexNames[expected] {
+				t.Errorf("Expected index %s not found", expected)
+			}
+		}
+
+		t.Logf("Found %d indexes for freezes collection", len(results))
+	})
+
+	// Vérifier les index de la collection changes
+	t.Run("ChangeIndexes", func(t *testing.T) {
+		indexes := testDB.Collection("changes").Indexes()
+		cursor, err := indexes.List(ctx)
+		if err != nil {
+			t.Fatalf("Failed to list indexes: %v", err)
+		}
+		defer cursor.Close(ctx)
+
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			t.Fatalf("Failed to decode indexes: %v", err)
+		}
+
+		indexNames := make(map[string]bool)
+		for _, idx := range results {
+			if name, ok := idx["name"].(string); ok {
+				indexNames[name] = true
+			}
+		}
+
+		if !indexNames["idx_change_feed_sequence"] {
+			t.Errorf("Expected index idx_change_feed_sequence not found")
+		}
+
+		t.Logf("Found %d indexes for changes collection", len(results))
+	})
+
+	// Vérifier les index de la collection idempotency
+	t.Run("IdempotencyIndexes", func(t *testing.T) {
+		indexes := testDB.Collection("idempotency").Indexes()
+		cursor, err := indexes.List(ctx)
+		if err != nil {
+			t.Fatalf("Failed to list indexes: %v", err)
+		}
+		defer cursor.Close(ctx)
+
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			t.Fatalf("Failed to decode indexes: %v", err)
+		}
+
+		indexNames := make(map[string]bool)
+		for _, idx := range results {
+			if name, ok := idx["name"].(string); ok {
+				indexNames[name] = true
+			}
+		}
+
+		for _, expected := range []string{"idx_idempotency_key", "idx_idempotency_expiresat"} {
+			if !indexNames[expected] {
+				t.Errorf("Expected index %s not found", expected)
+			}
+		}
+
+		t.Logf("Found %d indexes for idempotency collection", len(results))
+	})
+
 	// Vérifier les index de la collection catalogs
 	t.Run("CatalogIndexes", func(t *testing.T) {
 		indexes := testDB.Collection("catalogs").Indexes()
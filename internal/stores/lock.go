@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
@@ -9,6 +10,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/google/uuid"
 )
@@ -36,6 +38,23 @@ func (c *LockStoreClient) List(ctx context.Context) (results []*v1alpha1.Lock, e
 	return
 }
 
+// Find returns the Locks matching filter, sorted and paged by opts
+func (c *LockStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.Lock, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Count counts the Locks matching filter
+func (c *LockStoreClient) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.CountDocuments(ctx, filter)
+}
+
 // Create takes the representation of an Lock and creates it.  Returns the server's representation of the Lock, and an error, if there is any.
 func (c *LockStoreClient) Create(ctx context.Context, lockInsert *v1alpha1.Lock) (result *v1alpha1.Lock, err error) {
 
@@ -57,6 +76,88 @@ func (c *LockStoreClient) Create(ctx context.Context, lockInsert *v1alpha1.Lock)
 	return
 }
 
+// Acquire creates the Lock unless one already holds its service, environment and
+// resource or a scope overlapping them. The upsert of an exclusive lock matches
+// on the fields of the unique index idx_lock_service_env_resource_exclusive, so
+// concurrent callers cannot both insert the same key. Scopes and shared locks
+// cannot be enforced by an index: the lock is checked against them before and
+// after its insertion, and withdrawn when another one overlaps it meanwhile.
+func (c *LockStoreClient) Acquire(ctx context.Context, lockInsert *v1alpha1.Lock) (*v1alpha1.Lock, error) {
+	lockInsert.Id = uuid.New().String()
+	lockInsert.CreatedAt = timestamppb.Now()
+
+	holder := &v1alpha1.Lock{}
+	err := c.collection.FindOne(ctx, LockConflicts(lockInsert)).Decode(holder)
+	if err == nil {
+		return nil, &LockedError{Holder: holder, Requested: lockInsert}
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	if lockInsert.Mode == v1alpha1.LockMode_shared {
+		// other shared locks may hold the key, the check below is enough
+		if _, err := c.collection.InsertOne(ctx, lockInsert); err != nil {
+			return nil, err
+		}
+	} else {
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+		err = c.collection.FindOneAndUpdate(ctx, lockKey(lockInsert), bson.D{{Key: "$setOnInsert", Value: lockInsert}}, opts).Decode(holder)
+		if mongo.IsDuplicateKeyError(err) {
+			// lost an upsert race the server did not retry: the winner holds the lock now
+			err = c.collection.FindOne(ctx, lockKey(lockInsert)).Decode(holder)
+		}
+		switch {
+		case errors.Is(err, mongo.ErrNoDocuments):
+		case err != nil:
+			return nil, err
+		default:
+			return nil, &LockedError{Holder: holder, Requested: lockInsert}
+		}
+	}
+
+	// A conflicting lock may have been inserted meanwhile. The locks are
+	// numbered once inserted and the lowest number wins: a lock done checking
+	// already has a lower number, a lock not numbered yet will get a higher
+	// one, so of two conflicting callers exactly one keeps its lock.
+	sequence, err := c.nextSequence(ctx)
+	if err == nil {
+		_, err = c.collection.UpdateOne(ctx, bson.D{{Key: "id", Value: lockInsert.Id}}, bson.D{{Key: "$set", Value: bson.D{{Key: "sequence", Value: sequence}}}})
+	}
+	if err != nil {
+		_, _ = c.collection.DeleteOne(ctx, bson.D{{Key: "id", Value: lockInsert.Id}})
+		return nil, err
+	}
+
+	earlier := bson.D{{Key: "$and", Value: bson.A{LockConflicts(lockInsert), bson.D{{Key: "sequence", Value: bson.D{{Key: "$lt", Value: sequence}}}}}}}
+	err = c.collection.FindOne(ctx, earlier, options.FindOne().SetSort(bson.D{{Key: "sequence", Value: 1}})).Decode(holder)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return lockInsert, nil
+	}
+	if _, errDelete := c.collection.DeleteOne(ctx, bson.D{{Key: "id", Value: lockInsert.Id}}); errDelete != nil {
+		return nil, errDelete
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, &LockedError{Holder: holder, Requested: lockInsert}
+}
+
+// nextSequence numbers the locks of the collection in the order Acquire
+// inserts them, with a counter kept in the counters collection
+func (c *LockStoreClient) nextSequence(ctx context.Context) (int64, error) {
+	counter := struct {
+		Sequence int64 `bson:"sequence"`
+	}{}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := c.collection.Database().Collection("counters").FindOneAndUpdate(ctx,
+		bson.D{{Key: "_id", Value: c.collection.Name()}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "sequence", Value: int64(1)}}}},
+		opts,
+	).Decode(&counter)
+	return counter.Sequence, err
+}
+
 // Get an Lock and creates it.  Returns the server's representation of the Lock, and an error, if there is any.
 func (c *LockStoreClient) Get(ctx context.Context, filter map[string]interface{}) (result *v1alpha1.Lock, err error) {
 	result = &v1alpha1.Lock{}
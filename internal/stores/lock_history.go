@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/google/uuid"
+)
+
+type LockHistoryStoreClient struct {
+	collection *mongo.Collection
+}
+
+func NewStoreLockHistory(collection string) (c *LockHistoryStoreClient) {
+	return &LockHistoryStoreClient{
+		collection: NewClient(collection),
+	}
+}
+
+// Append assigns an id, and a timestamp when it has none, to the entry and stores it
+func (c *LockHistoryStoreClient) Append(ctx context.Context, entry *v1alpha1.LockHistoryEntry) error {
+	newHistoryEntry(entry)
+	_, err := c.collection.InsertOne(ctx, entry)
+	return err
+}
+
+// Find returns the entries matching filter, sorted and paged by opts
+func (c *LockHistoryStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.LockHistoryEntry, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Count counts the entries matching filter
+func (c *LockHistoryStoreClient) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.CountDocuments(ctx, filter)
+}
+
+func newHistoryEntry(entry *v1alpha1.LockHistoryEntry) {
+	entry.Id = uuid.New().String()
+	if entry.Timestamp == nil {
+		entry.Timestamp = timestamppb.Now()
+	}
+}
@@ -0,0 +1,348 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryCollection keeps BSON documents in insertion order, exactly as Mongo
+// would store them, so the in-memory stores decode the same values and
+// evaluate the same filters as the Mongo stores
+type memoryCollection struct {
+	mu   sync.RWMutex
+	docs []bson.Raw
+}
+
+// memoryCollections shares collections by name, like a Mongo database does,
+// so every store opened on the same collection sees the same documents
+var memoryCollections = struct {
+	sync.Mutex
+	byName map[string]*memoryCollection
+}{byName: map[string]*memoryCollection{}}
+
+func newMemoryCollection(name string) *memoryCollection {
+	memoryCollections.Lock()
+	defer memoryCollections.Unlock()
+
+	c, ok := memoryCollections.byName[name]
+	if !ok {
+		c = &memoryCollection{}
+		memoryCollections.byName[name] = c
+	}
+	return c
+}
+
+// toDocument marshals v and adds an _id when it has none, like the Mongo driver
+func toDocument(v interface{}) (bson.Raw, error) {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bson.Raw(raw).LookupErr("_id"); err == nil {
+		return raw, nil
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	doc = append(bson.D{{Key: "_id", Value: primitive.NewObjectID()}}, doc...)
+	return bson.Marshal(doc)
+}
+
+// match returns the indexes of the documents matching filter, at most limit if limit > 0
+func (c *memoryCollection) match(filter interface{}, limit int) ([]int, error) {
+	query, err := normalizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []int
+	for idx, raw := range c.docs {
+		ok, err := matchRaw(raw, query)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matches = append(matches, idx)
+			if limit > 0 && len(matches) == limit {
+				break
+			}
+		}
+	}
+	return matches, nil
+}
+
+func (c *memoryCollection) insertOne(ctx context.Context, v interface{}) error {
+	raw, err := toDocument(v)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.docs = append(c.docs, raw)
+	return nil
+}
+
+func (c *memoryCollection) insertIfNone(ctx context.Context, filter interface{}, v interface{}, existing interface{}) (bool, error) {
+	raw, err := toDocument(v)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := c.match(filter, 1)
+	if err != nil {
+		return false, err
+	}
+	if len(matches) > 0 {
+		return false, bson.Unmarshal(c.docs[matches[0]], existing)
+	}
+	c.docs = append(c.docs, raw)
+	return true, nil
+}
+
+func (c *memoryCollection) findOne(ctx context.Context, filter interface{}, result interface{}) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	matches, err := c.match(filter, 1)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return ErrNotFound
+	}
+	return bson.Unmarshal(c.docs[matches[0]], result)
+}
+
+// find decodes every matching document with decode, in insertion order unless opts sorts them
+func (c *memoryCollection) find(ctx context.Context, filter interface{}, opts FindOptions, decode func(raw bson.Raw) error) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	matches, err := c.match(filter, 0)
+	if err != nil {
+		return err
+	}
+	docs := make([]bson.Raw, len(matches))
+	for i, idx := range matches {
+		docs[i] = c.docs[idx]
+	}
+	docs, err = sortDocuments(docs, opts)
+	if err != nil {
+		return err
+	}
+	for _, raw := range docs {
+		if err := decode(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *memoryCollection) count(ctx context.Context, filter interface{}) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	matches, err := c.match(filter, 0)
+	return int64(len(matches)), err
+}
+
+// setOne applies a {$set: update} to the first matching document, inserting the
+// filter fields plus update when upsert is set and nothing matches. result is
+// decoded from the document before or after the update, as FindOneAndUpdate does.
+func (c *memoryCollection) setOne(ctx context.Context, filter interface{}, update interface{}, upsert bool, returnAfter bool, result interface{}) error {
+	var set bson.D
+	raw, err := bson.Marshal(update)
+	if err != nil {
+		return err
+	}
+	if err := bson.Unmarshal(raw, &set); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := c.match(filter, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(matches) == 0 {
+		if !upsert {
+			return ErrNotFound
+		}
+		inserted, err := upsertDocument(filter, set)
+		if err != nil {
+			return err
+		}
+		c.docs = append(c.docs, inserted)
+		if !returnAfter {
+			return ErrNotFound
+		}
+		return bson.Unmarshal(inserted, result)
+	}
+
+	idx := matches[0]
+	var doc bson.D
+	if err := bson.Unmarshal(c.docs[idx], &doc); err != nil {
+		return err
+	}
+	updated, err := bson.Marshal(mergeFields(doc, set))
+	if err != nil {
+		return err
+	}
+	before := c.docs[idx]
+	c.docs[idx] = updated
+
+	if returnAfter {
+		return bson.Unmarshal(updated, result)
+	}
+	return bson.Unmarshal(before, result)
+}
+
+func (c *memoryCollection) modifyOne(ctx context.Context, filter interface{}, modify func(doc bson.Raw) (interface{}, error)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := c.match(filter, 1)
+	if err != nil {
+		return err
+	}
+	var doc bson.Raw
+	if len(matches) > 0 {
+		doc = c.docs[matches[0]]
+	}
+
+	replacement, err := modify(doc)
+	if err != nil || replacement == nil {
+		return err
+	}
+	updated, err := toDocument(replacement)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		c.docs = append(c.docs, updated)
+	} else {
+		c.docs[matches[0]] = updated
+	}
+	return nil
+}
+
+// upsertDocument builds the document inserted by an upsert: the equality fields
+// of filter merged with set, as Mongo does
+func upsertDocument(filter interface{}, set bson.D) (bson.Raw, error) {
+	base, err := normalizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	for _, e := range base {
+		if _, isOperator := asDocument(e.Value); !isOperator && e.Key[0] != '$' {
+			doc = append(doc, e)
+		}
+	}
+	return toDocument(mergeFields(doc, set))
+}
+
+// mergeFields overwrites the top level fields of doc with the ones of set
+func mergeFields(doc bson.D, set bson.D) bson.D {
+	for _, e := range set {
+		replaced := false
+		for i := range doc {
+			if doc[i].Key == e.Key {
+				doc[i].Value = e.Value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			doc = append(doc, e)
+		}
+	}
+	return doc
+}
+
+func (c *memoryCollection) deleteOne(ctx context.Context, filter interface{}) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := c.match(filter, 1)
+	if err != nil || len(matches) == 0 {
+		return 0, err
+	}
+	idx := matches[0]
+	c.docs = append(c.docs[:idx:idx], c.docs[idx+1:]...)
+	return 1, nil
+}
+
+// NewMemoryStoreEvent returns an event store kept in memory
+func NewMemoryStoreEvent(collection string) *DocumentEventStore {
+	return &DocumentEventStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreLock returns a lock store kept in memory
+func NewMemoryStoreLock(collection string) *DocumentLockStore {
+	return &DocumentLockStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreLockHistory returns a lock history store kept in memory
+func NewMemoryStoreLockHistory(collection string) *DocumentLockHistoryStore {
+	return &DocumentLockHistoryStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreReservation returns a reservation store kept in memory
+func NewMemoryStoreReservation(collection string) *DocumentReservationStore {
+	return &DocumentReservationStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreFreeze returns a freeze store kept in memory
+func NewMemoryStoreFreeze(collection string) *DocumentFreezeStore {
+	return &DocumentFreezeStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreCatalog returns a catalog store kept in memory
+func NewMemoryStoreCatalog(collection string) *DocumentCatalogStore {
+	return &DocumentCatalogStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreLinks returns a links store kept in memory
+func NewMemoryStoreLinks(collection string) *DocumentLinksStore {
+	return &DocumentLinksStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreChange returns a store of the watch feed changes kept in memory
+func NewMemoryStoreChange(collection string) *DocumentChangeStore {
+	return &DocumentChangeStore{
+		collection: newMemoryCollection(collection),
+	}
+}
+
+// NewMemoryStoreIdempotency returns a store of the idempotency records kept in memory
+func NewMemoryStoreIdempotency(collection string) *DocumentIdempotencyStore {
+	return &DocumentIdempotencyStore{
+		collection: newMemoryCollection(collection),
+	}
+}
@@ -0,0 +1,718 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	freezev1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func mustParse(t *testing.T, value string) time.Time {
+	date, err := time.Parse(time.RFC3339, value)
+	assert.NoError(t, err)
+	return date
+}
+
+func TestMemoryEventStore(t *testing.T) {
+	ctx := context.Background()
+	events := NewMemoryStoreEvent(t.Name())
+
+	created, err := events.Create(ctx, &eventv1alpha1.Event{
+		Title: "deploy payments",
+		Attributes: &eventv1alpha1.EventAttributes{
+			Service:     "payments",
+			Environment: eventv1alpha1.Environment_production,
+			Status:      eventv1alpha1.Status_start,
+		},
+		Metadata:  &eventv1alpha1.EventMetadata{},
+		Changelog: []*eventv1alpha1.ChangelogEntry{{User: "alice", ChangeType: eventv1alpha1.ChangeType_created}},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Metadata.Id)
+	assert.NotNil(t, created.Metadata.CreatedAt)
+	assert.Len(t, created.Changelog, 1)
+
+	_, err = events.Create(ctx, &eventv1alpha1.Event{
+		Title:      "deploy billing",
+		Attributes: &eventv1alpha1.EventAttributes{Service: "billing"},
+		Metadata:   &eventv1alpha1.EventMetadata{},
+	})
+	assert.NoError(t, err)
+
+	got, err := events.Get(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy payments", got.Title)
+
+	missing, err := events.Get(ctx, map[string]interface{}{"metadata.id": "unknown"})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotNil(t, missing)
+
+	all, err := events.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	found, err := events.Search(ctx, map[string]interface{}{"attributes.service": "billing"})
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	assert.Equal(t, int64(1), got.Metadata.Revision)
+	update := got
+	update.Attributes.Status = eventv1alpha1.Status_success
+	before, err := events.Update(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id}, update)
+	assert.NoError(t, err)
+	assert.Equal(t, eventv1alpha1.Status_start, before.Attributes.Status, "Update returns the document before the update, as Mongo does")
+	assert.Equal(t, int64(2), update.Metadata.Revision)
+
+	appended, err := events.AppendChangelog(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id},
+		&eventv1alpha1.ChangelogEntry{User: "bob", ChangeType: eventv1alpha1.ChangeType_commented})
+	assert.NoError(t, err)
+	assert.Len(t, appended.Changelog, 2)
+	assert.Equal(t, int64(3), appended.Metadata.Revision)
+
+	stale := proto.Clone(update).(*eventv1alpha1.Event)
+	stale.Title = "lost update"
+	_, err = events.Update(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id}, stale)
+	assert.ErrorIs(t, err, ErrRevisionConflict, "the changelog entry would be lost")
+	assert.Equal(t, int64(2), stale.Metadata.Revision, "a failed update keeps its revision")
+
+	after, err := events.Get(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, eventv1alpha1.Status_success, after.Attributes.Status)
+	assert.Equal(t, "deploy payments", after.Title)
+	assert.Len(t, after.Changelog, 2)
+
+	_, err = events.Update(ctx, map[string]interface{}{"metadata.id": "unknown"}, update)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	count, err := events.CountWithFilter(ctx, bson.D{{Key: "attributes.service", Value: "payments"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	assert.NoError(t, events.Delete(ctx, map[string]interface{}{"metadata.id": created.Metadata.Id}))
+	all, err = events.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestMemoryEventStoreAppendChangelog(t *testing.T) {
+	testAppendChangelogConcurrently(t, NewMemoryStoreEvent(t.Name()))
+}
+
+func TestMemoryEventStoreAggregateByMonth(t *testing.T) {
+	ctx := context.Background()
+	events := NewMemoryStoreEvent(t.Name())
+
+	dates := []struct {
+		createdAt string
+		service   string
+	}{
+		{"2024-01-15T10:00:00Z", "payments"},
+		{"2024-01-20T10:00:00Z", "billing"},
+		{"2024-01-31T23:30:00Z", "payments"},
+		{"2024-02-01T00:30:00Z", "payments"},
+	}
+	for _, d := range dates {
+		raw := &eventv1alpha1.Event{
+			Attributes: &eventv1alpha1.EventAttributes{Service: d.service},
+			Metadata:   &eventv1alpha1.EventMetadata{CreatedAt: timestamppb.New(mustParse(t, d.createdAt))},
+		}
+		// insert directly to control the creation date
+		assert.NoError(t, events.collection.insertOne(ctx, raw))
+	}
+
+	results, err := events.AggregateByMonth(ctx, bson.D{}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []MonthlyStatsResult{
+		{Year: 2024, Month: 1, Count: 3},
+		{Year: 2024, Month: 2, Count: 1},
+	}, results)
+
+	results, err = events.AggregateByMonth(ctx, bson.D{{Key: "attributes.service", Value: "payments"}}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []MonthlyStatsResult{
+		{Year: 2024, Month: 1, Service: "payments", Count: 2},
+		{Year: 2024, Month: 2, Service: "payments", Count: 1},
+	}, results)
+}
+
+func TestMemoryEventStoreAggregate(t *testing.T) {
+	ctx := context.Background()
+	events := NewMemoryStoreEvent(t.Name())
+
+	dates := []struct {
+		createdAt string
+		status    eventv1alpha1.Status
+		duration  time.Duration
+	}{
+		{"2024-03-04T10:00:00Z", eventv1alpha1.Status_success, time.Minute},
+		{"2024-03-06T10:00:00Z", eventv1alpha1.Status_failure, 2 * time.Minute},
+		{"2024-03-10T23:00:00Z", eventv1alpha1.Status_success, 10 * time.Minute},
+		{"2024-03-11T00:30:00Z", eventv1alpha1.Status_start, 0},
+	}
+	for _, d := range dates {
+		raw := &eventv1alpha1.Event{
+			Attributes: &eventv1alpha1.EventAttributes{Status: d.status, Environment: eventv1alpha1.Environment_production},
+			Metadata:   &eventv1alpha1.EventMetadata{CreatedAt: timestamppb.New(mustParse(t, d.createdAt))},
+		}
+		if d.duration > 0 {
+			raw.Metadata.Duration = durationpb.New(d.duration)
+		}
+		// insert directly to control the creation date
+		assert.NoError(t, events.collection.insertOne(ctx, raw))
+	}
+
+	results, err := events.Aggregate(ctx, bson.D{}, AggregateOptions{Bucket: eventv1alpha1.TimeBucket_week, GroupBy: []string{"environment", "owner"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []AggregateResult{
+		{Bucket: mustParse(t, "2024-03-04T00:00:00Z"), Group: []string{"production", ""}, Count: 3, Successes: 2, Failures: 1,
+			Measured: 3, P50: 2 * time.Minute, P90: 10 * time.Minute, P99: 10 * time.Minute},
+		{Bucket: mustParse(t, "2024-03-11T00:00:00Z"), Group: []string{"production", ""}, Count: 1},
+	}, results, "weeks start on Monday")
+
+	results, err = events.Aggregate(ctx, bson.D{}, AggregateOptions{GroupBy: []string{"status"}})
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, []string{"start"}, results[1].Group, "sorted by value")
+	assert.True(t, results[1].Bucket.IsZero())
+}
+
+func TestMemoryLockStore(t *testing.T) {
+	ctx := context.Background()
+	locks := NewMemoryStoreLock(t.Name())
+
+	created, err := locks.Create(ctx, &lockv1alpha1.Lock{Service: "payments", Environment: "production", Resource: "deployment", Who: "alice"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Id)
+
+	_, err = locks.Update(ctx, map[string]interface{}{"id": created.Id}, &lockv1alpha1.Lock{
+		Id: created.Id, Service: "payments", Environment: "production", Resource: "deployment", Who: "alice", EventId: "event-1", CreatedAt: created.CreatedAt,
+	})
+	assert.NoError(t, err)
+
+	byEvent, err := locks.Get(ctx, map[string]interface{}{"eventid": "event-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Id, byEvent.Id)
+
+	count, err := locks.Unlock(ctx, map[string]interface{}{"id": created.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = locks.Unlock(ctx, map[string]interface{}{"id": created.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	missing, err := locks.Get(ctx, map[string]interface{}{"id": created.Id})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Empty(t, missing.Service)
+}
+
+func TestMemoryLockStoreFind(t *testing.T) {
+	ctx := context.Background()
+	locks := NewMemoryStoreLock(t.Name())
+
+	for _, service := range []string{"b", "c", "a", "d"} {
+		_, err := locks.Create(ctx, &lockv1alpha1.Lock{Service: service, Environment: "production", Resource: "deployment"})
+		assert.NoError(t, err)
+	}
+	_, err := locks.Create(ctx, &lockv1alpha1.Lock{Service: "e", Environment: "staging", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	filter := bson.D{{Key: "environment", Value: "production"}}
+	found, err := locks.Find(ctx, filter, FindOptions{Sort: []SortField{{Path: "service", Descending: true}}, Skip: 1, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "b"}, []string{found[0].Service, found[1].Service})
+
+	found, err = locks.Find(ctx, filter, FindOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "b", found[0].Service, "without sort, locks come in insertion order")
+
+	found, err = locks.Find(ctx, filter, FindOptions{Skip: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	count, err := locks.Count(ctx, filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestSortDocuments(t *testing.T) {
+	var docs []bson.Raw
+	for _, doc := range []bson.D{
+		{{Key: "n", Value: "b"}, {Key: "v", Value: int32(2)}},
+		{{Key: "n", Value: "null"}, {Key: "v", Value: nil}},
+		{{Key: "n", Value: "a"}, {Key: "v", Value: "x"}},
+		{{Key: "n", Value: "c"}, {Key: "v", Value: 1.5}},
+		{{Key: "n", Value: "missing"}},
+		{{Key: "n", Value: "d"}, {Key: "v", Value: int64(10)}},
+	} {
+		raw, err := bson.Marshal(doc)
+		assert.NoError(t, err)
+		docs = append(docs, raw)
+	}
+
+	names := func(docs []bson.Raw) (names []string) {
+		for _, doc := range docs {
+			names = append(names, doc.Lookup("n").StringValue())
+		}
+		return names
+	}
+
+	sorted, err := sortDocuments(docs, FindOptions{Sort: []SortField{{Path: "v"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"null", "missing", "c", "b", "d", "a"}, names(sorted), "nulls, then numbers, then strings")
+
+	sorted, err = sortDocuments(docs, FindOptions{Sort: []SortField{{Path: "v", Descending: true}}, Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "d", "b"}, names(sorted))
+}
+
+// testAppendChangelogConcurrently races several AppendChangelog on the same event and checks none is lost
+func testAppendChangelogConcurrently(t *testing.T, events EventStore) {
+	ctx := context.Background()
+	const callers = 20
+
+	created, err := events.Create(ctx, &eventv1alpha1.Event{Title: "deploy payments", Metadata: &eventv1alpha1.EventMetadata{}})
+	assert.NoError(t, err)
+	filter := map[string]interface{}{"metadata.id": created.Metadata.Id}
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := events.AppendChangelog(ctx, filter, &eventv1alpha1.ChangelogEntry{User: fmt.Sprintf("pipeline-%d", i), ChangeType: eventv1alpha1.ChangeType_commented})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	event, err := events.Get(ctx, filter)
+	assert.NoError(t, err)
+	assert.Len(t, event.Changelog, callers)
+	assert.Equal(t, int64(1+callers), event.Metadata.Revision)
+
+	_, err = events.AppendChangelog(ctx, map[string]interface{}{"metadata.id": "unknown"}, &eventv1alpha1.ChangelogEntry{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// testAcquireConcurrently races several Acquire on the same lock and checks only one wins
+func testAcquireConcurrently(t *testing.T, locks LockStore) {
+	ctx := context.Background()
+	const callers = 20
+
+	var wg sync.WaitGroup
+	var winners, losers atomic.Int32
+	holders := make(chan string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := locks.Acquire(ctx, &lockv1alpha1.Lock{Service: "payments", Environment: "production", Resource: "deployment", Who: fmt.Sprintf("pipeline-%d", i)})
+			var locked *LockedError
+			switch {
+			case err == nil:
+				winners.Add(1)
+			case errors.As(err, &locked):
+				losers.Add(1)
+				holders <- locked.Holder.Id
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(holders)
+
+	assert.Equal(t, int32(1), winners.Load())
+	assert.Equal(t, int32(callers-1), losers.Load())
+
+	all, err := locks.Find(ctx, bson.D{{Key: "service", Value: "payments"}}, FindOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, all, 1) {
+		for holder := range holders {
+			assert.Equal(t, all[0].Id, holder, "losers are told who holds the lock")
+		}
+	}
+
+	_, err = locks.Acquire(ctx, &lockv1alpha1.Lock{Service: "payments", Environment: "staging", Resource: "deployment", Who: "alice"})
+	assert.NoError(t, err, "another environment is not locked")
+}
+
+// testAcquireScopes checks wildcard locks conflict with every lock they overlap
+func testAcquireScopes(t *testing.T, locks LockStore) {
+	ctx := context.Background()
+	acquire := func(service, environment, resource string) (*lockv1alpha1.Lock, error) {
+		return locks.Acquire(ctx, &lockv1alpha1.Lock{Service: service, Environment: environment, Resource: resource, Who: "alice"})
+	}
+	holderOf := func(err error) string {
+		var locked *LockedError
+		if assert.ErrorAs(t, err, &locked) {
+			return locked.Holder.Service
+		}
+		return ""
+	}
+
+	api, err := acquire("payments-api", "production", "deployment")
+	assert.NoError(t, err)
+
+	_, err = acquire("payments-*", "production", "deployment")
+	assert.Equal(t, "payments-api", holderOf(err), "a scope cannot cover a held lock")
+	_, err = acquire("payments-*", "production", "*")
+	assert.Equal(t, "payments-api", holderOf(err))
+
+	_, err = acquire("billing", "production", "deployment")
+	assert.NoError(t, err)
+	_, err = acquire("pay*", "production", "operation")
+	assert.NoError(t, err, "another resource does not overlap")
+
+	_, err = acquire("payments-web", "production", "operation")
+	assert.Equal(t, "pay*", holderOf(err), "a lock is checked against the scopes covering it")
+	assert.ErrorContains(t, err, "is covered for operation in production by lock")
+	_, err = acquire("payroll", "production", "deployment")
+	assert.NoError(t, err)
+
+	_, err = acquire("*", "staging", "*")
+	assert.NoError(t, err)
+	_, err = acquire("payments-api", "staging", "deployment")
+	assert.Equal(t, "*", holderOf(err), "the whole environment is locked")
+	_, err = acquire("a.b*", "preproduction", "deployment")
+	assert.NoError(t, err)
+	_, err = acquire("aab", "preproduction", "deployment")
+	assert.NoError(t, err, "scopes are prefixes, not patterns")
+
+	_, err = locks.Unlock(ctx, map[string]interface{}{"id": api.Id})
+	assert.NoError(t, err)
+	_, err = acquire("payments-*", "production", "deployment")
+	assert.NoError(t, err)
+}
+
+// testAcquireModes checks shared locks are held together but never alongside an exclusive one
+func testAcquireModes(t *testing.T, locks LockStore) {
+	ctx := context.Background()
+	acquire := func(service string, mode lockv1alpha1.LockMode) (*lockv1alpha1.Lock, error) {
+		return locks.Acquire(ctx, &lockv1alpha1.Lock{Service: service, Environment: "production", Resource: "deployment", Who: "alice", Mode: mode})
+	}
+
+	audit, err := acquire("payments", lockv1alpha1.LockMode_shared)
+	assert.NoError(t, err)
+	_, err = acquire("payments", lockv1alpha1.LockMode_shared)
+	assert.NoError(t, err, "shared locks are held together")
+
+	var locked *LockedError
+	_, err = acquire("payments", lockv1alpha1.LockMode_exclusive)
+	if assert.ErrorAs(t, err, &locked) {
+		assert.Equal(t, audit.Id, locked.Holder.Id)
+	}
+	_, err = acquire("pay*", lockv1alpha1.LockMode_LOCK_MODE_UNSPECIFIED)
+	assert.ErrorAs(t, err, &locked, "a lock without mode is exclusive")
+
+	_, err = acquire("billing", lockv1alpha1.LockMode_LOCK_MODE_UNSPECIFIED)
+	assert.NoError(t, err)
+	_, err = acquire("billing", lockv1alpha1.LockMode_shared)
+	assert.ErrorAs(t, err, &locked, "a shared lock waits for the exclusive one")
+}
+
+// testReservationOverlaps checks a reservation is refused when it overlaps another one
+func testReservationOverlaps(t *testing.T, reservations ReservationStore) {
+	ctx := context.Background()
+	day := mustParse(t, "2025-03-10T00:00:00Z")
+	reserve := func(service string, from, to int) (*lockv1alpha1.Reservation, error) {
+		return reservations.Create(ctx, &lockv1alpha1.Reservation{
+			Environment: "UAT",
+			Service:     service,
+			Owner:       "team-a",
+			Start:       timestamppb.New(day.Add(time.Duration(from) * time.Hour)),
+			End:         timestamppb.New(day.Add(time.Duration(to) * time.Hour)),
+		})
+	}
+
+	morning, err := reserve("payments", 9, 12)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, morning.Id)
+	_, err = reserve("payments", 12, 14)
+	assert.NoError(t, err, "a reservation may start when another ends")
+	_, err = reserve("billing", 10, 11)
+	assert.NoError(t, err, "other services are free")
+
+	var overlap *ReservationOverlapError
+	_, err = reserve("payments", 11, 13)
+	if assert.ErrorAs(t, err, &overlap) {
+		assert.Equal(t, morning.Id, overlap.Existing.Id)
+	}
+	_, err = reserve("", 8, 10)
+	assert.ErrorAs(t, err, &overlap, "the whole environment overlaps its services")
+
+	_, err = reserve("", 14, 18)
+	assert.NoError(t, err)
+	_, err = reserve("auth", 15, 16)
+	assert.ErrorAs(t, err, &overlap, "a service overlaps the whole environment")
+
+	count, err := reservations.Count(ctx, bson.D{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestMemoryReservationStore(t *testing.T) {
+	testReservationOverlaps(t, NewMemoryStoreReservation(t.Name()))
+}
+
+// testFreezesAt checks the freezes in force are found with their exceptions
+func testFreezesAt(t *testing.T, freezes FreezeStore) {
+	ctx := context.Background()
+	day := mustParse(t, "2025-12-20T00:00:00Z")
+
+	endOfYear, err := freezes.Create(ctx, &freezev1alpha1.Freeze{
+		Name:        "end of year",
+		Environment: "production",
+		SlaLevels:   []string{"critical"},
+		Start:       timestamppb.New(day),
+		End:         timestamppb.New(day.Add(14 * 24 * time.Hour)),
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, endOfYear.Id)
+	_, err = freezes.Create(ctx, &freezev1alpha1.Freeze{
+		Name:        "fridays",
+		Environment: "production",
+		Recurrence:  &freezev1alpha1.Recurrence{Days: []freezev1alpha1.Weekday{freezev1alpha1.Weekday_friday}, StartTime: "16:00"},
+	})
+	assert.NoError(t, err)
+	_, err = freezes.Create(ctx, &freezev1alpha1.Freeze{Name: "staging", Environment: "staging", Start: timestamppb.New(day), End: timestamppb.New(day.Add(time.Hour))})
+	assert.NoError(t, err)
+
+	found, err := freezes.Find(ctx, FreezesAt("production", day.Add(time.Hour)), FindOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, found, 2, "a recurring freeze without period is always a candidate")
+	found, err = freezes.Find(ctx, FreezesAt("production", day.Add(-time.Hour)), FindOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, "fridays", found[0].Name)
+		assert.Equal(t, []freezev1alpha1.Weekday{freezev1alpha1.Weekday_friday}, found[0].Recurrence.Days)
+	}
+
+	updated, err := freezes.AddException(ctx, endOfYear.Id, &freezev1alpha1.FreezeException{Id: "hotfix", Service: "payments", RequestedBy: "alice", Reason: "$exceptions"})
+	assert.NoError(t, err)
+	if assert.Len(t, updated.Exceptions, 1) {
+		assert.Equal(t, "payments", updated.Exceptions[0].Service)
+		assert.Equal(t, "$exceptions", updated.Exceptions[0].Reason)
+	}
+	_, err = freezes.AddException(ctx, "unknown", &freezev1alpha1.FreezeException{Id: "hotfix"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	updated, err = freezes.ApproveException(ctx, endOfYear.Id, "hotfix", "bob", timestamppb.New(day))
+	assert.NoError(t, err)
+	if assert.Len(t, updated.Exceptions, 1) {
+		assert.Equal(t, "bob", updated.Exceptions[0].ApprovedBy)
+	}
+	_, err = freezes.ApproveException(ctx, endOfYear.Id, "hotfix", "carol", timestamppb.New(day))
+	assert.ErrorIs(t, err, ErrNotFound, "the exception is no longer pending")
+
+	// Update ne touche pas aux exceptions, même périmées
+	endOfYear.Exceptions = nil
+	updated, err = freezes.Update(ctx, map[string]interface{}{"id": endOfYear.Id}, endOfYear)
+	assert.NoError(t, err)
+	if assert.Len(t, updated.Exceptions, 1) {
+		assert.Equal(t, "bob", updated.Exceptions[0].ApprovedBy)
+	}
+	assert.Equal(t, []string{"critical"}, updated.SlaLevels)
+
+	deleted, err := freezes.Delete(ctx, map[string]interface{}{"id": endOfYear.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	count, err := freezes.Count(ctx, bson.D{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestMemoryFreezeStore(t *testing.T) {
+	testFreezesAt(t, NewMemoryStoreFreeze(t.Name()))
+}
+
+func testChanges(t *testing.T, changes ChangeStore) {
+	ctx := context.Background()
+
+	last, err := changes.Last(ctx, "events")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), last)
+	for n := range 4 {
+		sequence, err := changes.Append(ctx, "events", []byte{byte(n)}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(n+1), sequence)
+	}
+	_, err = changes.Append(ctx, "locks", []byte{9}, 3)
+	assert.NoError(t, err)
+
+	last, err = changes.Last(ctx, "events")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), last)
+	since, err := changes.Since(ctx, "events", 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, since, 3, "the first change is out of the capacity") {
+		assert.Equal(t, int64(2), since[0].Sequence)
+		assert.Equal(t, []byte{1}, since[0].Data)
+		assert.Equal(t, int64(4), since[2].Sequence)
+	}
+	since, err = changes.Since(ctx, "events", 2, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, since, 1) {
+		assert.Equal(t, int64(3), since[0].Sequence)
+	}
+	since, err = changes.Since(ctx, "locks", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, since, 1, "each feed is numbered on its own")
+
+	// des ajouts concurrents prennent chacun un numéro, sans trou
+	var wg sync.WaitGroup
+	for n := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := changes.Append(ctx, "concurrent", []byte{byte(n)}, 100)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	since, err = changes.Since(ctx, "concurrent", 0, 100)
+	assert.NoError(t, err)
+	if assert.Len(t, since, 8) {
+		for n, change := range since {
+			assert.Equal(t, int64(n+1), change.Sequence)
+		}
+	}
+}
+
+func TestMemoryChangeStore(t *testing.T) {
+	testChanges(t, NewMemoryStoreChange(t.Name()))
+}
+
+func testIdempotency(t *testing.T, idempotency IdempotencyStore) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// les réservations concurrentes d'une même clé n'en retiennent qu'une
+	var wg sync.WaitGroup
+	var reserved atomic.Int32
+	for n := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok, err := idempotency.Reserve(ctx, &Idempotency{Id: fmt.Sprint(n), Key: "run-1", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+			assert.NoError(t, err)
+			if ok {
+				reserved.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), reserved.Load())
+
+	held, ok, err := idempotency.Reserve(ctx, &Idempotency{Id: "retry", Key: "run-1", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, held.EventId, "the creation is in progress")
+
+	held.EventId = "event-1"
+	held.ExpiresAt = now.Add(time.Hour)
+	assert.NoError(t, idempotency.Complete(ctx, held))
+	assert.NoError(t, idempotency.Release(ctx, held), "a completed record is kept")
+	completed, ok, err := idempotency.Reserve(ctx, &Idempotency{Id: "retry", Key: "run-1", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "event-1", completed.EventId)
+	assert.ErrorIs(t, idempotency.Complete(ctx, &Idempotency{Id: "other", Key: "run-1", EventId: "event-2"}), ErrNotFound)
+
+	// une réservation libérée ou expirée est reprise
+	pending := &Idempotency{Id: "pending", Key: "run-2", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+	_, ok, err = idempotency.Reserve(ctx, pending)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, idempotency.Release(ctx, pending))
+	expired := &Idempotency{Id: "expired", Key: "run-2", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(-time.Minute)}
+	_, ok, err = idempotency.Reserve(ctx, expired)
+	assert.NoError(t, err)
+	assert.True(t, ok, "the released key is free")
+	_, ok, err = idempotency.Reserve(ctx, &Idempotency{Id: "next", Key: "run-2", RequestHash: "other", CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(t, err)
+	assert.True(t, ok, "the expired key is free")
+}
+
+func TestMemoryIdempotencyStore(t *testing.T) {
+	testIdempotency(t, NewMemoryStoreIdempotency(t.Name()))
+}
+
+func TestMemoryLockStoreAcquire(t *testing.T) {
+	testAcquireConcurrently(t, NewMemoryStoreLock(t.Name()))
+	testAcquireScopes(t, NewMemoryStoreLock(t.Name()+"/scopes"))
+	testAcquireModes(t, NewMemoryStoreLock(t.Name()+"/modes"))
+}
+
+func TestMemoryCatalogStore(t *testing.T) {
+	ctx := context.Background()
+	catalogs := NewMemoryStoreCatalog(t.Name())
+
+	created, err := catalogs.Update(ctx, map[string]interface{}{"name": "payments"}, &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-a"})
+	assert.NoError(t, err, "Update upserts")
+	assert.Equal(t, "team-a", created.Owner)
+
+	assert.Equal(t, int64(1), created.Revision)
+
+	_, err = catalogs.Update(ctx, map[string]interface{}{"name": "payments"}, &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-c"})
+	assert.ErrorIs(t, err, ErrRevisionConflict, "the entry is no longer at revision 0")
+
+	update := &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-b", Revision: created.Revision}
+	updated, err := catalogs.Update(ctx, map[string]interface{}{"name": "payments"}, update)
+	assert.NoError(t, err)
+	assert.Equal(t, "team-b", updated.Owner, "Update returns the document after the update")
+	assert.Equal(t, int64(2), updated.Revision)
+	assert.Equal(t, int64(2), update.Revision)
+
+	all, err := catalogs.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	assert.NoError(t, catalogs.Delete(ctx, map[string]interface{}{"name": "payments"}))
+	_, err = catalogs.Get(ctx, map[string]interface{}{"name": "payments"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMemoryLinksStore(t *testing.T) {
+	ctx := context.Background()
+	links := NewMemoryStoreLinks(t.Name())
+
+	b, err := links.Create(ctx, &LinkItem{Group: "Tools", Name: "b", URL: "https://b"})
+	assert.NoError(t, err)
+	_, err = links.Create(ctx, &LinkItem{Group: "Tools", Name: "a", URL: "https://a"})
+	assert.NoError(t, err)
+	_, err = links.Create(ctx, &LinkItem{Group: "Docs", Name: "z", URL: "https://z"})
+	assert.NoError(t, err)
+
+	all, err := links.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"z", "a", "b"}, []string{all[0].Name, all[1].Name, all[2].Name})
+
+	updated, err := links.Update(ctx, b.ID.Hex(), &LinkItem{Group: "Tools", Name: "b2", URL: "https://b2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "b2", updated.Name)
+	assert.Equal(t, b.CreatedAt, updated.CreatedAt)
+
+	_, err = links.Update(ctx, "not-an-id", &LinkItem{})
+	assert.Error(t, err)
+
+	assert.NoError(t, links.Delete(ctx, b.ID.Hex()))
+	all, err = links.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+}
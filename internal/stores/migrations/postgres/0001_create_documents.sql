@@ -0,0 +1,13 @@
+-- Every collection (events, locks, catalog, links) lives in a single table and
+-- documents are kept as the extended JSON of their BSON encoding, so they
+-- decode to exactly the same values as with the Mongo backend.
+CREATE TABLE IF NOT EXISTS documents (
+    seq        BIGSERIAL PRIMARY KEY,
+    collection TEXT      NOT NULL,
+    doc        JSONB     NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS documents_collection_seq_idx ON documents (collection, seq);
+
+-- jsonpath filters (doc @? '...') use this index
+CREATE INDEX IF NOT EXISTS documents_doc_idx ON documents USING GIN (doc jsonb_path_ops);
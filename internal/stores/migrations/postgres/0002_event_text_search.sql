@@ -0,0 +1,14 @@
+-- event_text is the text a full-text query searches in an event, weighted like
+-- the Mongo text index: the title (A), the message (B), the changelog comments
+-- (C) and the links (D). The 'simple' configuration neither stems nor drops
+-- stop words, as the Mongo index built with default_language none.
+CREATE OR REPLACE FUNCTION event_text(doc JSONB) RETURNS TSVECTOR
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(doc ->> 'title', '')), 'A')
+        || setweight(to_tsvector('simple', coalesce(doc #>> '{attributes,message}', '')), 'B')
+        || setweight(to_tsvector('simple', jsonb_path_query_array(doc, '$.changelog[*].comment')), 'C')
+        || setweight(to_tsvector('simple', concat_ws(' ', doc #>> '{links,ticket}', doc #>> '{links,pullrequestlink}')), 'D')
+$$;
+
+-- full-text queries (event_text(doc) @@ ...) use this index
+CREATE INDEX IF NOT EXISTS documents_event_text_idx ON documents USING GIN (event_text(doc));
@@ -0,0 +1,625 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"strings"
+	"sync"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"go.mongodb.org/mongo-driver/bson"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/bananaops/tracker/internal/config"
+	"github.com/bananaops/tracker/internal/utils"
+)
+
+//go:embed migrations/postgres/*.sql
+var postgresMigrations embed.FS
+
+// postgresMigrationLock is the advisory lock key held while migrating, so
+// several tracker instances starting together migrate only once
+const postgresMigrationLock = 7_283_501
+
+var postgresDatabase *sql.DB
+var postgresOnce sync.Once
+
+// NewPostgresClient returns the PostgreSQL connection pool shared by every store,
+// connecting and migrating the schema on first use
+func NewPostgresClient() *sql.DB {
+	postgresOnce.Do(func() {
+		db, err := openPostgres(context.Background(), config.ConfigDatabase.PostgresDSN)
+		if err != nil {
+			log.Fatalf("error connect db %s", err)
+		}
+		postgresDatabase = db
+	})
+	return postgresDatabase
+}
+
+func openPostgres(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := migratePostgres(ctx, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+	return db, nil
+}
+
+// migratePostgres applies, in name order, the embedded migrations missing from schema_migrations
+func migratePostgres(ctx context.Context, db *sql.DB) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, postgresMigrationLock); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, postgresMigrationLock) //nolint:errcheck
+
+	_, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    TEXT        PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return err
+	}
+
+	entries, err := fs.ReadDir(postgresMigrations, "migrations/postgres")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		version := entry.Name()
+
+		var applied bool
+		err := conn.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`, version).Scan(&applied)
+		if err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+
+		script, err := postgresMigrations.ReadFile("migrations/postgres/" + version)
+		if err != nil {
+			return err
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			tx.Rollback() //nolint:errcheck
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES ($1)`, version); err != nil {
+			tx.Rollback() //nolint:errcheck
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		log.Printf("applied database migration %s", version)
+	}
+	return nil
+}
+
+// postgresCollection stores the documents of one collection in the documents
+// table, as the relaxed extended JSON of their BSON encoding
+type postgresCollection struct {
+	db   *sql.DB
+	name string
+}
+
+func newPostgresCollection(db *sql.DB, name string) *postgresCollection {
+	return &postgresCollection{db: db, name: name}
+}
+
+func marshalDocument(v interface{}) (string, error) {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	doc, err := bson.MarshalExtJSON(bson.Raw(raw), false, false)
+	return string(doc), err
+}
+
+func unmarshalDocument(doc string) (bson.Raw, error) {
+	var fields bson.D
+	if err := bson.UnmarshalExtJSON([]byte(doc), false, &fields); err != nil {
+		return nil, err
+	}
+	return bson.Marshal(fields)
+}
+
+// query returns the start of a statement on the documents of the collection
+// matching filter, and the arguments bound so far
+func (c *postgresCollection) query(selectClause string, filter interface{}) (*postgresFilter, string, error) {
+	f := &postgresFilter{}
+	collection := f.arg(c.name)
+	where, err := f.where(filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return f, selectClause + ` FROM documents WHERE collection = ` + collection + ` AND (` + where + `)`, nil
+}
+
+func (c *postgresCollection) insertOne(ctx context.Context, v interface{}) error {
+	raw, err := toDocument(v)
+	if err != nil {
+		return err
+	}
+	doc, err := marshalDocument(raw)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.ExecContext(ctx, `INSERT INTO documents (collection, doc) VALUES ($1, $2::jsonb)`, c.name, doc)
+	return err
+}
+
+func (c *postgresCollection) insertIfNone(ctx context.Context, filter interface{}, v interface{}, existing interface{}) (bool, error) {
+	raw, err := toDocument(v)
+	if err != nil {
+		return false, err
+	}
+	doc, err := marshalDocument(raw)
+	if err != nil {
+		return false, err
+	}
+	f, query, err := c.query(`SELECT doc::text`, filter)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// no row to lock when nothing matches yet: serialize the conditional
+	// inserts of the collection until the transaction ends instead
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, c.name); err != nil {
+		return false, err
+	}
+
+	var found string
+	err = tx.QueryRowContext(ctx, query+` ORDER BY seq LIMIT 1`, f.args...).Scan(&found)
+	if err == nil {
+		raw, err := unmarshalDocument(found)
+		if err != nil {
+			return false, err
+		}
+		return false, bson.Unmarshal(raw, existing)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO documents (collection, doc) VALUES ($1, $2::jsonb)`, c.name, doc); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func (c *postgresCollection) findOne(ctx context.Context, filter interface{}, result interface{}) error {
+	f, query, err := c.query(`SELECT doc::text`, filter)
+	if err != nil {
+		return err
+	}
+
+	var doc string
+	err = c.db.QueryRowContext(ctx, query+` ORDER BY seq LIMIT 1`, f.args...).Scan(&doc)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	raw, err := unmarshalDocument(doc)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(raw, result)
+}
+
+func (c *postgresCollection) find(ctx context.Context, filter interface{}, opts FindOptions, decode func(raw bson.Raw) error) error {
+	f, query, err := c.query(`SELECT doc::text`, filter)
+	if err != nil {
+		return err
+	}
+	query += ` ORDER BY ` + f.orderBy(opts.Sort)
+	if opts.Limit > 0 {
+		query += ` LIMIT ` + f.arg(opts.Limit)
+	}
+	if opts.Skip > 0 {
+		query += ` OFFSET ` + f.arg(opts.Skip)
+	}
+
+	rows, err := c.db.QueryContext(ctx, query, f.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var doc string
+		if err := rows.Scan(&doc); err != nil {
+			return err
+		}
+		raw, err := unmarshalDocument(doc)
+		if err != nil {
+			return err
+		}
+		if err := decode(raw); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (c *postgresCollection) count(ctx context.Context, filter interface{}) (int64, error) {
+	f, query, err := c.query(`SELECT count(*)`, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = c.db.QueryRowContext(ctx, query, f.args...).Scan(&count)
+	return count, err
+}
+
+// setOne merges update into the first matching document with the jsonb ||
+// operator, which replaces top level fields like a Mongo $set
+func (c *postgresCollection) setOne(ctx context.Context, filter interface{}, update interface{}, upsert bool, returnAfter bool, result interface{}) error {
+	set, err := marshalDocument(update)
+	if err != nil {
+		return err
+	}
+	f, query, err := c.query(`SELECT seq, doc`, filter)
+	if err != nil {
+		return err
+	}
+	patch := f.arg(set)
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var before, after string
+	err = tx.QueryRowContext(ctx, `WITH target AS (`+query+` ORDER BY seq LIMIT 1 FOR UPDATE)
+		UPDATE documents SET doc = documents.doc || `+patch+`::jsonb
+		FROM target WHERE documents.seq = target.seq
+		RETURNING target.doc::text, documents.doc::text`, f.args...).Scan(&before, &after)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		if !upsert {
+			return ErrNotFound
+		}
+		after, err = c.upsert(ctx, tx, filter, update)
+		if err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		if !returnAfter {
+			return ErrNotFound
+		}
+		raw, err := unmarshalDocument(after)
+		if err != nil {
+			return err
+		}
+		return bson.Unmarshal(raw, result)
+	}
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	doc := before
+	if returnAfter {
+		doc = after
+	}
+	raw, err := unmarshalDocument(doc)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(raw, result)
+}
+
+// modifyOne locks the first matching row until the replacement is written. When
+// none matches, the insertions of the collection are serialized as in insertIfNone.
+func (c *postgresCollection) modifyOne(ctx context.Context, filter interface{}, modify func(doc bson.Raw) (interface{}, error)) error {
+	f, query, err := c.query(`SELECT seq, doc::text`, filter)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var seq int64
+	var found string
+	err = tx.QueryRowContext(ctx, query+` ORDER BY seq LIMIT 1 FOR UPDATE`, f.args...).Scan(&seq, &found)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, c.name); err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, query+` ORDER BY seq LIMIT 1 FOR UPDATE`, f.args...).Scan(&seq, &found)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	var doc bson.Raw
+	if found != "" {
+		if doc, err = unmarshalDocument(found); err != nil {
+			return err
+		}
+	}
+	replacement, err := modify(doc)
+	if err != nil || replacement == nil {
+		return err
+	}
+	raw, err := toDocument(replacement)
+	if err != nil {
+		return err
+	}
+	updated, err := marshalDocument(raw)
+	if err != nil {
+		return err
+	}
+
+	if doc == nil {
+		_, err = tx.ExecContext(ctx, `INSERT INTO documents (collection, doc) VALUES ($1, $2::jsonb)`, c.name, updated)
+	} else {
+		_, err = tx.ExecContext(ctx, `UPDATE documents SET doc = $1::jsonb WHERE seq = $2`, updated, seq)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// upsert inserts the document an upsert of update on filter creates
+func (c *postgresCollection) upsert(ctx context.Context, tx *sql.Tx, filter interface{}, update interface{}) (string, error) {
+	var set bson.D
+	raw, err := bson.Marshal(update)
+	if err != nil {
+		return "", err
+	}
+	if err := bson.Unmarshal(raw, &set); err != nil {
+		return "", err
+	}
+	inserted, err := upsertDocument(filter, set)
+	if err != nil {
+		return "", err
+	}
+	doc, err := marshalDocument(inserted)
+	if err != nil {
+		return "", err
+	}
+
+	var after string
+	err = tx.QueryRowContext(ctx, `INSERT INTO documents (collection, doc) VALUES ($1, $2::jsonb) RETURNING doc::text`, c.name, doc).Scan(&after)
+	return after, err
+}
+
+func (c *postgresCollection) deleteOne(ctx context.Context, filter interface{}) (int64, error) {
+	f, query, err := c.query(`SELECT seq`, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.db.ExecContext(ctx, `DELETE FROM documents WHERE seq = (`+query+` ORDER BY seq LIMIT 1)`, f.args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// aggregateByMonth groups the matching events by creation month (UTC) in SQL,
+// like the $group stage of the Mongo store
+func (c *postgresCollection) aggregateByMonth(ctx context.Context, matchFilter bson.D, groupByService bool) ([]MonthlyStatsResult, error) {
+	service := `''::text`
+	if groupByService {
+		service = `coalesce(doc #>> '{attributes,service}', '')`
+	}
+	f, query, err := c.query(`SELECT to_timestamp((doc #>> '{metadata,createdat,seconds}')::double precision) AT TIME ZONE 'UTC' AS created_at, `+service+` AS service`, matchFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, `SELECT
+			coalesce(extract(year FROM created_at), 0)::int AS year,
+			coalesce(extract(month FROM created_at), 0)::int AS month,
+			service,
+			count(*)
+		FROM (`+query+`) AS matched
+		GROUP BY 1, 2, 3
+		ORDER BY 1, 2, service COLLATE "C"`, f.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []MonthlyStatsResult{}
+	for rows.Next() {
+		var result MonthlyStatsResult
+		if err := rows.Scan(&result.Year, &result.Month, &result.Service, &result.Count); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// textRankWeights are the weights ts_rank gives to the D, C, B and A parts of
+// event_text, in the ratio of utils.TextFieldWeights
+const textRankWeights = `'{0.1, 0.2, 0.5, 1.0}'`
+
+// textSearch matches and ranks the full-text query in SQL, on the
+// event_text index created by the 0002 migration
+func (c *postgresCollection) textSearch(ctx context.Context, filter bson.D, text *utils.TextQuery, opts FindOptions) ([]TextMatch, int64, error) {
+	f, countQuery, err := c.query(`SELECT count(*)`, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	match, _ := textSearchQueries(f, text)
+	var total int64
+	if err := c.db.QueryRowContext(ctx, countQuery+` AND event_text(doc) @@ (`+match+`)`, f.args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	f, query, err := c.query(`SELECT doc`, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	match, rank := textSearchQueries(f, text)
+	statement := `SELECT doc::text, ts_rank(` + textRankWeights + `, event_text(doc), ` + rank + `) AS score
+		FROM (` + query + ` AND event_text(doc) @@ (` + match + `)) AS matched
+		ORDER BY score DESC, ` + f.orderBy([]SortField{{Path: "metadata.createdat.seconds", Descending: true}})
+	if opts.Limit > 0 {
+		statement += ` LIMIT ` + f.arg(opts.Limit)
+	}
+	if opts.Skip > 0 {
+		statement += ` OFFSET ` + f.arg(opts.Skip)
+	}
+
+	rows, err := c.db.QueryContext(ctx, statement, f.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var matches []TextMatch
+	for rows.Next() {
+		var doc string
+		var score float64
+		if err := rows.Scan(&doc, &score); err != nil {
+			return nil, 0, err
+		}
+		raw, err := unmarshalDocument(doc)
+		if err != nil {
+			return nil, 0, err
+		}
+		event := &eventv1alpha1.Event{}
+		if err := bson.Unmarshal(raw, event); err != nil {
+			return nil, 0, err
+		}
+		matches = append(matches, TextMatch{Event: event, Score: score})
+	}
+	return matches, total, rows.Err()
+}
+
+// textSearchQueries returns the tsquery an event must match for text, with
+// the semantics of Mongo $text, and the tsquery of every searched term to rank it
+func textSearchQueries(f *postgresFilter, text *utils.TextQuery) (match string, rank string) {
+	var terms []string
+	if len(text.Words) > 0 {
+		// les mots ne sont que des lettres et des chiffres, sans syntaxe tsquery
+		terms = append(terms, `to_tsquery('simple', `+f.arg(strings.Join(text.Words, " | "))+`)`)
+	}
+	var phrases []string
+	for _, phrase := range text.Phrases {
+		phrases = append(phrases, `phraseto_tsquery('simple', `+f.arg(phrase)+`)`)
+	}
+	rank = strings.Join(append(terms, phrases...), " || ")
+
+	match = rank
+	if len(phrases) > 0 {
+		// avec des phrases, les mots ne comptent que pour le score
+		match = strings.Join(phrases, " && ")
+	}
+	if len(text.Excluded) > 0 {
+		match = `(` + match + `) && !!to_tsquery('simple', ` + f.arg(strings.Join(text.Excluded, " | ")) + `)`
+	}
+	return match, rank
+}
+
+// NewPostgresStoreEvent returns an event store kept in PostgreSQL
+func NewPostgresStoreEvent(collection string) *DocumentEventStore {
+	return &DocumentEventStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreLock returns a lock store kept in PostgreSQL
+func NewPostgresStoreLock(collection string) *DocumentLockStore {
+	return &DocumentLockStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreLockHistory returns a lock history store kept in PostgreSQL
+func NewPostgresStoreLockHistory(collection string) *DocumentLockHistoryStore {
+	return &DocumentLockHistoryStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreReservation returns a reservation store kept in PostgreSQL
+func NewPostgresStoreReservation(collection string) *DocumentReservationStore {
+	return &DocumentReservationStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreFreeze returns a freeze store kept in PostgreSQL
+func NewPostgresStoreFreeze(collection string) *DocumentFreezeStore {
+	return &DocumentFreezeStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreCatalog returns a catalog store kept in PostgreSQL
+func NewPostgresStoreCatalog(collection string) *DocumentCatalogStore {
+	return &DocumentCatalogStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreLinks returns a links store kept in PostgreSQL
+func NewPostgresStoreLinks(collection string) *DocumentLinksStore {
+	return &DocumentLinksStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreChange returns a store of the watch feed changes kept in PostgreSQL
+func NewPostgresStoreChange(collection string) *DocumentChangeStore {
+	return &DocumentChangeStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
+
+// NewPostgresStoreIdempotency returns a store of the idempotency records kept in PostgreSQL
+func NewPostgresStoreIdempotency(collection string) *DocumentIdempotencyStore {
+	return &DocumentIdempotencyStore{
+		collection: newPostgresCollection(NewPostgresClient(), collection),
+	}
+}
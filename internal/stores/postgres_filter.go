@@ -0,0 +1,303 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// postgresFilter translates the Mongo filters built by utils.CreateFilter and
+// utils.CreateStatsFilter into a SQL condition on the documents.doc column.
+//
+// Every field condition becomes a jsonpath predicate (doc @? '<path> ? (...)').
+// jsonpath runs in lax mode, so arrays are matched element by element and a
+// string never compares to a number, like in Mongo. Values are written as
+// jsonpath literals and the jsonpath itself is bound as a query argument.
+type postgresFilter struct {
+	args []interface{}
+}
+
+// arg binds v as the next query argument and returns its placeholder
+func (f *postgresFilter) arg(v interface{}) string {
+	f.args = append(f.args, v)
+	return "$" + strconv.Itoa(len(f.args))
+}
+
+// where returns the SQL condition matching filter
+func (f *postgresFilter) where(filter interface{}) (string, error) {
+	query, err := normalizeFilter(filter)
+	if err != nil {
+		return "", err
+	}
+	return f.document(query)
+}
+
+func (f *postgresFilter) document(filter bson.D) (string, error) {
+	if len(filter) == 0 {
+		return "TRUE", nil
+	}
+
+	var conditions []string
+	for _, e := range filter {
+		var condition string
+		var err error
+		switch e.Key {
+		case "$and", "$or", "$nor":
+			condition, err = f.logical(e.Key, e.Value)
+		default:
+			condition, err = f.field(e.Key, e.Value)
+		}
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+func (f *postgresFilter) logical(operator string, value interface{}) (string, error) {
+	clauses, ok := value.(bson.A)
+	if !ok {
+		return "", fmt.Errorf("%s expects an array", operator)
+	}
+
+	var conditions []string
+	for _, clause := range clauses {
+		sub, ok := asDocument(clause)
+		if !ok {
+			return "", fmt.Errorf("%s expects an array of documents", operator)
+		}
+		condition, err := f.document(sub)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, "("+condition+")")
+	}
+
+	switch operator {
+	case "$and":
+		if len(conditions) == 0 {
+			return "TRUE", nil
+		}
+		return "(" + strings.Join(conditions, " AND ") + ")", nil
+	case "$or":
+		if len(conditions) == 0 {
+			return "FALSE", nil
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", nil
+	default:
+		if len(conditions) == 0 {
+			return "TRUE", nil
+		}
+		return "NOT (" + strings.Join(conditions, " OR ") + ")", nil
+	}
+}
+
+func (f *postgresFilter) field(path string, cond interface{}) (string, error) {
+	ops, isOperator := asDocument(cond)
+	if !isOperator || len(ops) == 0 || !strings.HasPrefix(ops[0].Key, "$") {
+		return f.equals(path, cond)
+	}
+
+	var conditions []string
+	for _, op := range ops {
+		var condition string
+		var err error
+		switch op.Key {
+		case "$eq":
+			condition, err = f.equals(path, op.Value)
+		case "$ne":
+			condition, err = f.equals(path, op.Value)
+			condition = "NOT (" + condition + ")"
+		case "$gt", "$gte", "$lt", "$lte":
+			condition, err = f.compare(path, op.Key, op.Value)
+		case "$in", "$nin":
+			condition, err = f.in(path, op.Key, op.Value)
+		case "$exists":
+			condition = "doc @? " + f.arg(jsonPath(path))
+			if !truthy(op.Value) {
+				condition = "NOT (" + condition + ")"
+			}
+		case "$regex":
+			var pattern, options string
+			pattern, options, err = regexOperands(op.Value, ops)
+			if err == nil {
+				condition, err = f.regex(path, pattern, options)
+			}
+		case "$options":
+			// consumed by $regex
+			continue
+		default:
+			err = fmt.Errorf("unsupported operator %s on %s", op.Key, path)
+		}
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+	if len(conditions) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+func (f *postgresFilter) equals(path string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		// Mongo matches null or missing fields
+		return "NOT (doc @? " + f.arg(jsonPath(path)+" ? (@ != null)") + ")", nil
+	case primitive.Regex:
+		return f.regex(path, v.Pattern, v.Options)
+	case primitive.ObjectID:
+		// ObjectIDs are stored as extended JSON {"$oid": "<hex>"}
+		path += ".$oid"
+		value = v.Hex()
+	}
+
+	literal, err := jsonPathLiteral(value)
+	if err != nil {
+		return "", fmt.Errorf("unsupported value for %s: %w", path, err)
+	}
+	return "doc @? " + f.arg(jsonPath(path)+" ? (@ == "+literal+")"), nil
+}
+
+func (f *postgresFilter) compare(path string, operator string, value interface{}) (string, error) {
+	literal, err := jsonPathLiteral(value)
+	if err != nil || value == nil {
+		return "", fmt.Errorf("unsupported value for %s %s", operator, path)
+	}
+	comparators := map[string]string{"$gt": ">", "$gte": ">=", "$lt": "<", "$lte": "<="}
+	return "doc @? " + f.arg(jsonPath(path)+" ? (@ "+comparators[operator]+" "+literal+")"), nil
+}
+
+func (f *postgresFilter) in(path string, operator string, value interface{}) (string, error) {
+	list, ok := value.(bson.A)
+	if !ok {
+		return "", fmt.Errorf("%s expects an array", operator)
+	}
+
+	var conditions []string
+	for _, candidate := range list {
+		condition, err := f.equals(path, candidate)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	condition := "FALSE"
+	if len(conditions) > 0 {
+		condition = "(" + strings.Join(conditions, " OR ") + ")"
+	}
+	if operator == "$nin" {
+		return "NOT " + condition, nil
+	}
+	return condition, nil
+}
+
+func (f *postgresFilter) regex(path string, pattern string, options string) (string, error) {
+	literal, err := jsonPathLiteral(pattern)
+	if err != nil {
+		return "", err
+	}
+	var flags string
+	for _, o := range options {
+		switch o {
+		case 'i', 'm', 's':
+			flags += string(o)
+		}
+	}
+	predicate := "@ like_regex " + literal
+	if flags != "" {
+		predicate += ` flag "` + flags + `"`
+	}
+	return "doc @? " + f.arg(jsonPath(path)+" ? ("+predicate+")"), nil
+}
+
+// orderBy returns the ORDER BY terms sorting like Mongo on fields: by type
+// (null, numbers, strings, documents, arrays, booleans), then numerically, then
+// by the text value in byte order. Insertion order breaks the ties.
+func (f *postgresFilter) orderBy(fields []SortField) string {
+	var terms []string
+	for _, field := range fields {
+		path := f.arg(strings.Split(field.Path, "."))
+		direction := " ASC NULLS FIRST"
+		if field.Descending {
+			direction = " DESC NULLS LAST"
+		}
+		terms = append(terms,
+			`CASE jsonb_typeof(doc #> `+path+`::text[]) WHEN 'number' THEN 1 WHEN 'string' THEN 2 WHEN 'object' THEN 3 WHEN 'array' THEN 4 WHEN 'boolean' THEN 6 ELSE 0 END`+direction,
+			`CASE WHEN jsonb_typeof(doc #> `+path+`::text[]) = 'number' THEN (doc #>> `+path+`::text[])::numeric END`+direction,
+			`(doc #>> `+path+`::text[]) COLLATE "C"`+direction,
+		)
+	}
+	return strings.Join(append(terms, "seq"), ", ")
+}
+
+// regexOperands returns the pattern and options of a $regex operator
+func regexOperands(pattern interface{}, ops bson.D) (string, string, error) {
+	var options string
+	for _, op := range ops {
+		if op.Key == "$options" {
+			options, _ = op.Value.(string)
+		}
+	}
+	switch p := pattern.(type) {
+	case string:
+		return p, options, nil
+	case primitive.Regex:
+		if options == "" {
+			options = p.Options
+		}
+		return p.Pattern, options, nil
+	}
+	return "", "", fmt.Errorf("$regex expects a string")
+}
+
+// jsonPath converts a dotted Mongo path into a quoted jsonpath, e.g. $."metadata"."id"
+func jsonPath(path string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, key := range strings.Split(path, ".") {
+		literal, _ := jsonPathLiteral(key)
+		b.WriteString(".")
+		b.WriteString(literal)
+	}
+	return b.String()
+}
+
+// jsonPathLiteral renders a scalar BSON value as a jsonpath literal
+func jsonPathLiteral(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "null", nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return "", fmt.Errorf("%v has no jsonpath literal", v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(v); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	}
+	return "", fmt.Errorf("%T has no jsonpath literal", value)
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/bananaops/tracker/internal/utils"
+)
+
+func TestPostgresFilter(t *testing.T) {
+
+	oid, _ := primitive.ObjectIDFromHex("65f1c2d3e4f5a6b7c8d9e0f1")
+
+	testCases := []struct {
+		name         string
+		filter       interface{}
+		exceptedSQL  string
+		exceptedArgs []interface{}
+		exceptedErr  bool
+	}{
+		{
+			name:        "OK - Test empty filter",
+			filter:      map[string]interface{}{},
+			exceptedSQL: "TRUE",
+		},
+		{
+			name:         "OK - Test equality on nested string",
+			filter:       map[string]interface{}{"metadata.id": "id-1"},
+			exceptedSQL:  "doc @? $1",
+			exceptedArgs: []interface{}{`$."metadata"."id" ? (@ == "id-1")`},
+		},
+		{
+			name:         "OK - Test string literal is escaped",
+			filter:       bson.D{{Key: "title", Value: `say "hi" <b>\`}},
+			exceptedSQL:  "doc @? $1",
+			exceptedArgs: []interface{}{`$."title" ? (@ == "say \"hi\" <b>\\")`},
+		},
+		{
+			name:         "OK - Test equality with an enum",
+			filter:       bson.D{{Key: "attributes.environment", Value: v1alpha1.Environment_production}},
+			exceptedSQL:  "doc @? $1",
+			exceptedArgs: []interface{}{`$."attributes"."environment" ? (@ == 7)`},
+		},
+		{
+			name:         "OK - Test equality with null",
+			filter:       bson.D{{Key: "metadata.slackid", Value: nil}},
+			exceptedSQL:  "NOT (doc @? $1)",
+			exceptedArgs: []interface{}{`$."metadata"."slackid" ? (@ != null)`},
+		},
+		{
+			name:         "OK - Test equality with an ObjectID",
+			filter:       bson.D{{Key: "_id", Value: oid}},
+			exceptedSQL:  "doc @? $1",
+			exceptedArgs: []interface{}{`$."_id"."$oid" ? (@ == "65f1c2d3e4f5a6b7c8d9e0f1")`},
+		},
+		{
+			name:         "OK - Test range",
+			filter:       bson.D{{Key: "metadata.createdat.seconds", Value: bson.D{{Key: "$gte", Value: int64(1700000000)}, {Key: "$lte", Value: int64(1700086399)}}}},
+			exceptedSQL:  "doc @? $1 AND doc @? $2",
+			exceptedArgs: []interface{}{`$."metadata"."createdat"."seconds" ? (@ >= 1700000000)`, `$."metadata"."createdat"."seconds" ? (@ <= 1700086399)`},
+		},
+		{
+			name:         "OK - Test $in and $nin",
+			filter:       bson.D{{Key: "attributes.type", Value: bson.D{{Key: "$in", Value: bson.A{int32(1), int32(2)}}, {Key: "$nin", Value: bson.A{}}}}},
+			exceptedSQL:  "(doc @? $1 OR doc @? $2) AND NOT FALSE",
+			exceptedArgs: []interface{}{`$."attributes"."type" ? (@ == 1)`, `$."attributes"."type" ? (@ == 2)`},
+		},
+		{
+			name:         "OK - Test $ne and $exists",
+			filter:       bson.D{{Key: "attributes.status", Value: bson.D{{Key: "$ne", Value: int32(3)}, {Key: "$exists", Value: true}}}},
+			exceptedSQL:  "NOT (doc @? $1) AND doc @? $2",
+			exceptedArgs: []interface{}{`$."attributes"."status" ? (@ == 3)`, `$."attributes"."status"`},
+		},
+		{
+			name:         "OK - Test $regex with options",
+			filter:       bson.D{{Key: "title", Value: bson.D{{Key: "$regex", Value: "^dep"}, {Key: "$options", Value: "i"}}}},
+			exceptedSQL:  "doc @? $1",
+			exceptedArgs: []interface{}{`$."title" ? (@ like_regex "^dep" flag "i")`},
+		},
+		{
+			name: "OK - Test $or and $nor",
+			filter: bson.D{
+				{Key: "$or", Value: bson.A{bson.D{{Key: "a", Value: "x"}}, bson.D{{Key: "b", Value: true}}}},
+				{Key: "$nor", Value: bson.A{bson.D{{Key: "c", Value: 1.5}}}},
+			},
+			exceptedSQL:  "((doc @? $1) OR (doc @? $2)) AND NOT ((doc @? $3))",
+			exceptedArgs: []interface{}{`$."a" ? (@ == "x")`, `$."b" ? (@ == true)`, `$."c" ? (@ == 1.5)`},
+		},
+		{
+			name:        "KO - Test unsupported operator",
+			filter:      bson.D{{Key: "a", Value: bson.D{{Key: "$elemMatch", Value: bson.D{}}}}},
+			exceptedErr: true,
+		},
+		{
+			name:        "KO - Test document equality",
+			filter:      bson.D{{Key: "a", Value: bson.D{{Key: "b", Value: 1}}}},
+			exceptedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		f := &postgresFilter{}
+		sql, err := f.where(testCase.filter)
+		if testCase.exceptedErr {
+			assert.Error(t, err, testCase.name)
+			continue
+		}
+		assert.NoError(t, err, testCase.name)
+		assert.Equal(t, testCase.exceptedSQL, sql, testCase.name)
+		assert.Equal(t, testCase.exceptedArgs, f.args, testCase.name)
+	}
+}
+
+func TestPostgresFilterUtilsFilters(t *testing.T) {
+
+	filter, err := utils.CreateStatsFilter(&utils.StatsFilter{
+		StartDate:    "2024-03-01",
+		EndDate:      "2024-03-31",
+		Environments: []int32{int32(v1alpha1.Environment_production)},
+		Query:        `service in (payments,billing) and status!=success and not owner~"team-*"`,
+	})
+	assert.NoError(t, err)
+
+	f := &postgresFilter{}
+	_, err = f.where(filter)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, f.args)
+}
+
+func TestPostgresOrderBy(t *testing.T) {
+	f := &postgresFilter{args: []interface{}{"events"}}
+
+	assert.Equal(t, "seq", f.orderBy(nil))
+
+	orderBy := f.orderBy([]SortField{{Path: "attributes.service", Descending: true}, {Path: "metadata.id"}})
+	assert.Equal(t, []interface{}{"events", []string{"attributes", "service"}, []string{"metadata", "id"}}, f.args)
+	assert.Equal(t, "CASE jsonb_typeof(doc #> $2::text[]) WHEN 'number' THEN 1 WHEN 'string' THEN 2 WHEN 'object' THEN 3 WHEN 'array' THEN 4 WHEN 'boolean' THEN 6 ELSE 0 END DESC NULLS LAST, "+
+		"CASE WHEN jsonb_typeof(doc #> $2::text[]) = 'number' THEN (doc #>> $2::text[])::numeric END DESC NULLS LAST, "+
+		"(doc #>> $2::text[]) COLLATE \"C\" DESC NULLS LAST, "+
+		"CASE jsonb_typeof(doc #> $3::text[]) WHEN 'number' THEN 1 WHEN 'string' THEN 2 WHEN 'object' THEN 3 WHEN 'array' THEN 4 WHEN 'boolean' THEN 6 ELSE 0 END ASC NULLS FIRST, "+
+		"CASE WHEN jsonb_typeof(doc #> $3::text[]) = 'number' THEN (doc #>> $3::text[])::numeric END ASC NULLS FIRST, "+
+		"(doc #>> $3::text[]) COLLATE \"C\" ASC NULLS FIRST, seq", orderBy)
+}
@@ -0,0 +1,198 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/bananaops/tracker/internal/config"
+	"github.com/bananaops/tracker/internal/utils"
+)
+
+func testEvent(t *testing.T) *eventv1alpha1.Event {
+	return &eventv1alpha1.Event{
+		Title: "deploy payments <v2>",
+		Attributes: &eventv1alpha1.EventAttributes{
+			Message:      "rollout",
+			Service:      "payments",
+			Type:         eventv1alpha1.Type_deployment,
+			Priority:     eventv1alpha1.Priority_P1,
+			Environment:  eventv1alpha1.Environment_production,
+			Status:       eventv1alpha1.Status_success,
+			Impact:       true,
+			StartDate:    timestamppb.New(mustParse(t, "2024-03-10T10:00:00Z")),
+			StakeHolders: []string{"alice", "bob"},
+		},
+		Links: &eventv1alpha1.EventLinks{PullRequestLink: "https://github.com/org/repo/pull/1"},
+		Metadata: &eventv1alpha1.EventMetadata{
+			Id:        "id-1",
+			CreatedAt: timestamppb.New(mustParse(t, "2024-03-10T10:00:00.123456789Z")),
+			Duration:  durationpb.New(90 * time.Second),
+		},
+		Changelog: []*eventv1alpha1.ChangelogEntry{
+			{Timestamp: timestamppb.New(mustParse(t, "2024-03-10T10:00:00Z")), User: "alice", ChangeType: eventv1alpha1.ChangeType_created, Comment: "Event created"},
+			{Timestamp: timestamppb.New(mustParse(t, "2024-03-10T10:05:00Z")), User: "bob", ChangeType: eventv1alpha1.ChangeType_status_changed, Field: "status", OldValue: "start", NewValue: "success"},
+		},
+	}
+}
+
+// TestPostgresDocumentRoundTrip checks that the extended JSON kept in the doc
+// column decodes to the same event, even once jsonb has reordered its keys
+func TestPostgresDocumentRoundTrip(t *testing.T) {
+	event := testEvent(t)
+
+	doc, err := marshalDocument(event)
+	assert.NoError(t, err)
+
+	// jsonb does not keep the order of the keys
+	decoder := json.NewDecoder(bytes.NewBufferString(doc))
+	decoder.UseNumber()
+	var generic interface{}
+	assert.NoError(t, decoder.Decode(&generic))
+	reordered, err := json.Marshal(generic)
+	assert.NoError(t, err)
+
+	raw, err := unmarshalDocument(string(reordered))
+	assert.NoError(t, err)
+	decoded := &eventv1alpha1.Event{}
+	assert.NoError(t, bson.Unmarshal(raw, &decoded))
+	assert.True(t, proto.Equal(event, decoded), "event should round-trip, got %v", decoded)
+}
+
+// TestPostgresTextSearchQueries checks the tsqueries built for the Mongo $text syntax
+func TestPostgresTextSearchQueries(t *testing.T) {
+	query, err := utils.ParseTextQuery(`kafka lag "consumer rebalance" -staging -test`)
+	assert.NoError(t, err)
+	f := &postgresFilter{}
+	match, rank := textSearchQueries(f, query)
+	assert.Equal(t, `(phraseto_tsquery('simple', $2)) && !!to_tsquery('simple', $3)`, match)
+	assert.Equal(t, `to_tsquery('simple', $1) || phraseto_tsquery('simple', $2)`, rank)
+	assert.Equal(t, []interface{}{"kafka | lag", "consumer rebalance", "staging | test"}, f.args)
+
+	query, err = utils.ParseTextQuery("kafka lag")
+	assert.NoError(t, err)
+	f = &postgresFilter{}
+	match, rank = textSearchQueries(f, query)
+	assert.Equal(t, `to_tsquery('simple', $1)`, match)
+	assert.Equal(t, match, rank)
+}
+
+// TestPostgresStores runs the stores against a real PostgreSQL (DB_POSTGRES_DSN)
+func TestPostgresStores(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db, err := openPostgres(ctx, config.ConfigDatabase.PostgresDSN)
+	if err != nil {
+		t.Skipf("PostgreSQL not available: %v", err)
+	}
+	defer db.Close()
+
+	collection := "test_" + time.Now().Format("20060102150405.000000000")
+	defer func() {
+		if _, err := db.ExecContext(context.Background(), `DELETE FROM documents WHERE collection LIKE $1`, collection+"%"); err != nil {
+			t.Logf("Warning: failed to clean test documents: %v", err)
+		}
+	}()
+
+	events := &DocumentEventStore{collection: newPostgresCollection(db, collection+"_events")}
+	event := testEvent(t)
+	assert.NoError(t, events.collection.insertOne(ctx, event))
+
+	got, err := events.Get(ctx, map[string]interface{}{"metadata.id": "id-1"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(event, got), "event should round-trip, got %v", got)
+
+	found, err := events.Search(ctx, map[string]interface{}{"attributes.stakeholders": "bob"})
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	filter, err := utils.CreateFilter(&eventv1alpha1.SearchEventsRequest{
+		Service:     "payments",
+		Environment: eventv1alpha1.Environment_production,
+		StartDate:   "2024-03-01",
+		EndDate:     "2024-03-31",
+	})
+	assert.NoError(t, err)
+	found, err = events.Search(ctx, filter)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	statsFilter, err := utils.CreateStatsFilter(&utils.StatsFilter{StartDate: "2024-03-01", EndDate: "2024-03-31"})
+	assert.NoError(t, err)
+	count, err := events.CountWithFilter(ctx, statsFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	matches, total, err := events.TextSearch(ctx, bson.D{}, `payments -staging "event created"`, FindOptions{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "id-1", matches[0].Event.Metadata.Id)
+		assert.Greater(t, matches[0].Score, 0.0)
+	}
+	_, total, err = events.TextSearch(ctx, bson.D{}, "payments -rollout", FindOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	results, err := events.AggregateByMonth(ctx, statsFilter, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []MonthlyStatsResult{{Year: 2024, Month: 3, Service: "payments", Count: 1}}, results)
+
+	update := proto.Clone(event).(*eventv1alpha1.Event)
+	update.Attributes.Status = eventv1alpha1.Status_failure
+	before, err := events.Update(ctx, map[string]interface{}{"metadata.id": "id-1"}, update)
+	assert.NoError(t, err)
+	assert.Equal(t, eventv1alpha1.Status_success, before.Attributes.Status)
+
+	assert.NoError(t, events.Delete(ctx, map[string]interface{}{"metadata.id": "id-1"}))
+	_, err = events.Get(ctx, map[string]interface{}{"metadata.id": "id-1"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	catalogs := &DocumentCatalogStore{collection: newPostgresCollection(db, collection+"_catalog")}
+	catalog := &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-a", Version: "1.2.3"}
+	created, err := catalogs.Update(ctx, map[string]interface{}{"name": "payments"}, catalog)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(catalog, created), "catalog should round-trip, got %v", created)
+
+	for _, name := range []string{"billing", "auth"} {
+		_, err = catalogs.Update(ctx, map[string]interface{}{"name": name}, &catalogv1alpha1.Catalog{Name: name, Owner: "team-b"})
+		assert.NoError(t, err)
+	}
+	sorted, err := catalogs.Find(ctx, bson.D{}, FindOptions{Sort: []SortField{{Path: "owner", Descending: true}, {Path: "name"}}, Skip: 1, Limit: 2})
+	assert.NoError(t, err)
+	if assert.Len(t, sorted, 2) {
+		assert.Equal(t, []string{"billing", "payments"}, []string{sorted[0].Name, sorted[1].Name})
+	}
+
+	testAcquireConcurrently(t, &DocumentLockStore{collection: newPostgresCollection(db, collection+"_locks")})
+	testAcquireScopes(t, &DocumentLockStore{collection: newPostgresCollection(db, collection+"_scoped_locks")})
+	testAcquireModes(t, &DocumentLockStore{collection: newPostgresCollection(db, collection+"_shared_locks")})
+	testReservationOverlaps(t, &DocumentReservationStore{collection: newPostgresCollection(db, collection+"_reservations")})
+	testFreezesAt(t, &DocumentFreezeStore{collection: newPostgresCollection(db, collection+"_freezes")})
+	testChanges(t, &DocumentChangeStore{collection: newPostgresCollection(db, collection+"_changes")})
+	testIdempotency(t, &DocumentIdempotencyStore{collection: newPostgresCollection(db, collection+"_idempotency")})
+	testAppendChangelogConcurrently(t, &DocumentEventStore{collection: newPostgresCollection(db, collection+"_appended_events")})
+
+	links := &DocumentLinksStore{collection: newPostgresCollection(db, collection+"_links")}
+	link, err := links.Create(ctx, &LinkItem{Group: "Tools", Name: "grafana", URL: "https://grafana"})
+	assert.NoError(t, err)
+	updated, err := links.Update(ctx, link.ID.Hex(), &LinkItem{Group: "Tools", Name: "grafana", URL: "https://grafana.example"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://grafana.example", updated.URL)
+	assert.NoError(t, links.Delete(ctx, link.ID.Hex()))
+}
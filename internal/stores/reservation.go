@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/google/uuid"
+)
+
+type ReservationStoreClient struct {
+	collection *mongo.Collection
+}
+
+func NewStoreReservation(collection string) (c *ReservationStoreClient) {
+	return &ReservationStoreClient{
+		collection: NewClient(collection),
+	}
+}
+
+// Create stores the Reservation unless it overlaps another one. Overlaps cannot
+// be enforced by an index: as for scoped locks, the reservation is checked
+// before and after its insertion, and withdrawn when another one overlaps it
+// meanwhile.
+func (c *ReservationStoreClient) Create(ctx context.Context, reservation *v1alpha1.Reservation) (*v1alpha1.Reservation, error) {
+	newReservation(reservation)
+
+	existing := &v1alpha1.Reservation{}
+	err := c.collection.FindOne(ctx, ReservationOverlaps(reservation)).Decode(existing)
+	if err == nil {
+		return nil, &ReservationOverlapError{Existing: existing}
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	if _, err := c.collection.InsertOne(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	others := bson.D{{Key: "$and", Value: bson.A{ReservationOverlaps(reservation), bson.D{{Key: "id", Value: bson.D{{Key: "$ne", Value: reservation.Id}}}}}}}
+	err = c.collection.FindOne(ctx, others).Decode(existing)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return reservation, nil
+	}
+	if _, errDelete := c.collection.DeleteOne(ctx, bson.D{{Key: "id", Value: reservation.Id}}); errDelete != nil {
+		return nil, errDelete
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, &ReservationOverlapError{Existing: existing}
+}
+
+// Get returns the first Reservation matching filter
+func (c *ReservationStoreClient) Get(ctx context.Context, filter map[string]interface{}) (result *v1alpha1.Reservation, err error) {
+	result = &v1alpha1.Reservation{}
+	err = c.collection.FindOne(ctx, filter).Decode(&result)
+	return
+}
+
+// Find returns the Reservations matching filter, sorted and paged by opts
+func (c *ReservationStoreClient) Find(ctx context.Context, filter bson.D, opts FindOptions) (results []*v1alpha1.Reservation, err error) {
+	cursor, err := c.collection.Find(ctx, filter, opts.mongo())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &results)
+	return
+}
+
+// Count counts the Reservations matching filter
+func (c *ReservationStoreClient) Count(ctx context.Context, filter bson.D) (int64, error) {
+	return c.collection.CountDocuments(ctx, filter)
+}
+
+// Update replaces the fields of the first matching Reservation and returns the Reservation after the update
+func (c *ReservationStoreClient) Update(ctx context.Context, filter map[string]interface{}, reservation *v1alpha1.Reservation) (result *v1alpha1.Reservation, err error) {
+	result = &v1alpha1.Reservation{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = c.collection.FindOneAndUpdate(ctx, filter, bson.D{{Key: "$set", Value: reservation}}, opts).Decode(&result)
+	return
+}
+
+// Delete deletes the first Reservation matching filter and returns the number of deleted reservations
+func (c *ReservationStoreClient) Delete(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	result, err := c.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func newReservation(reservation *v1alpha1.Reservation) {
+	reservation.Id = uuid.New().String()
+	reservation.CreatedAt = timestamppb.Now()
+}
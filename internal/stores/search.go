@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/bananaops/tracker/internal/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TextMatch is an event matching a full-text query, with its relevance
+type TextMatch struct {
+	Event *eventv1alpha1.Event
+	Score float64
+}
+
+// textSearcher is implemented by the collections able to match and rank a
+// full-text query themselves instead of decoding every matching document
+type textSearcher interface {
+	textSearch(ctx context.Context, filter bson.D, query *utils.TextQuery, opts FindOptions) ([]TextMatch, int64, error)
+}
+
+// textScore is the relevance Mongo computes for a $text query
+var textScore = bson.D{{Key: "$meta", Value: "textScore"}}
+
+// TextSearch returns the events matching filter and the full-text query q,
+// the most relevant first, along with the number of matches before paging.
+// opts.Sort is ignored, the matches being ordered by relevance.
+func (c *EventStoreClient) TextSearch(ctx context.Context, filter bson.D, q string, opts FindOptions) ([]TextMatch, int64, error) {
+	textFilter := bson.D{{Key: "$and", Value: bson.A{filter, bson.D{{Key: "$text", Value: bson.D{{Key: "$search", Value: q}}}}}}}
+	total, err := c.collection.CountDocuments(ctx, textFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findOptions := options.Find().
+		SetProjection(bson.D{{Key: "score", Value: textScore}}).
+		SetSort(bson.D{{Key: "score", Value: textScore}, {Key: "metadata.createdat.seconds", Value: -1}})
+	if opts.Skip > 0 {
+		findOptions.SetSkip(opts.Skip)
+	}
+	if opts.Limit > 0 {
+		findOptions.SetLimit(opts.Limit)
+	}
+	cursor, err := c.collection.Find(ctx, textFilter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var matches []TextMatch
+	for cursor.Next(ctx) {
+		event := &eventv1alpha1.Event{}
+		if err := cursor.Decode(event); err != nil {
+			return nil, 0, err
+		}
+		score, _ := cursor.Current.Lookup("score").DoubleOK()
+		matches = append(matches, TextMatch{Event: event, Score: score})
+	}
+	return matches, total, cursor.Err()
+}
+
+// TextSearch returns the events matching filter and the full-text query q,
+// the most relevant first, along with the number of matches before paging.
+// PostgreSQL ranks them with its event_text index. The other collections
+// decode and score, as utils.TextQuery does, every event matching filter:
+// they do not suit large datasets.
+func (c *DocumentEventStore) TextSearch(ctx context.Context, filter bson.D, q string, opts FindOptions) ([]TextMatch, int64, error) {
+	query, err := utils.ParseTextQuery(q)
+	if err != nil {
+		return nil, 0, err
+	}
+	if searcher, ok := c.collection.(textSearcher); ok {
+		return searcher.textSearch(ctx, filter, query, opts)
+	}
+
+	var matches []TextMatch
+	err = c.collection.find(ctx, filter, FindOptions{}, func(raw bson.Raw) error {
+		event := &eventv1alpha1.Event{}
+		if err := bson.Unmarshal(raw, event); err != nil {
+			return err
+		}
+		if score := query.Score(event); score > 0 {
+			matches = append(matches, TextMatch{Event: event, Score: score})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Event.GetMetadata().GetCreatedAt().GetSeconds() > matches[j].Event.GetMetadata().GetCreatedAt().GetSeconds()
+	})
+	total := int64(len(matches))
+	matches = matches[min(opts.Skip, total):]
+	if opts.Limit > 0 && int64(len(matches)) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+	return matches, total, nil
+}
@@ -0,0 +1,485 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	freezev1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"github.com/bananaops/tracker/internal/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrNotFound is returned by every backend when no document matches a filter.
+// It is the Mongo error so callers written against the Mongo stores keep working.
+var ErrNotFound = mongo.ErrNoDocuments
+
+// ErrRevisionConflict is returned by the updates of events and catalog entries
+// when the stored document is no longer at the revision the update was built
+// from: someone else changed it in between.
+var ErrRevisionConflict = errors.New("the document was modified concurrently")
+
+// Filters passed to the stores use the Mongo query syntax (see utils.CreateFilter
+// and utils.CreateStatsFilter), whatever the backend behind the interface.
+
+// atRevision restricts filter to the documents whose revision, at path, is
+// revision. The documents stored before revisions existed have none, which
+// counts as 0.
+func atRevision(filter map[string]interface{}, path string, revision int64) bson.D {
+	condition := bson.D{{Key: path, Value: revision}}
+	if revision == 0 {
+		condition = bson.D{{Key: path, Value: bson.D{{Key: "$in", Value: bson.A{int64(0), nil}}}}}
+	}
+	return bson.D{{Key: "$and", Value: bson.A{filter, condition}}}
+}
+
+// SortField orders results on a document path, e.g. metadata.createdat.seconds
+type SortField struct {
+	Path       string
+	Descending bool
+}
+
+// FindOptions sorts and pages the results of a Find. The zero value returns
+// every match in insertion order.
+type FindOptions struct {
+	Sort  []SortField
+	Skip  int64
+	Limit int64
+}
+
+// mongo converts the options for the Mongo driver
+func (o FindOptions) mongo() *options.FindOptions {
+	opts := options.Find()
+	if len(o.Sort) > 0 {
+		sort := bson.D{}
+		for _, field := range o.Sort {
+			direction := 1
+			if field.Descending {
+				direction = -1
+			}
+			sort = append(sort, bson.E{Key: field.Path, Value: direction})
+		}
+		opts.SetSort(sort)
+	}
+	if o.Skip > 0 {
+		opts.SetSkip(o.Skip)
+	}
+	if o.Limit > 0 {
+		opts.SetLimit(o.Limit)
+	}
+	return opts
+}
+
+// LockedError is returned by LockStore.Acquire when another lock already holds
+// the service, environment and resource, or a scope covering them
+type LockedError struct {
+	Holder *lockv1alpha1.Lock
+	// Requested is the lock that was refused, when known
+	Requested *lockv1alpha1.Lock
+}
+
+func (e *LockedError) Error() string {
+	if e.Requested != nil && (e.Requested.Service != e.Holder.Service || e.Requested.Resource != e.Holder.Resource) {
+		return fmt.Sprintf("service %s is covered for %s in %s by lock %s on service %s, resource %s, held by %s (event_id: %s)",
+			e.Requested.Service, e.Requested.Resource, e.Requested.Environment, e.Holder.Id, e.Holder.Service, e.Holder.Resource, e.Holder.Who, e.Holder.EventId)
+	}
+	return fmt.Sprintf("service %s is already locked for %s in %s by %s (lock_id: %s, event_id: %s)",
+		e.Holder.Service, e.Holder.Resource, e.Holder.Environment, e.Holder.Who, e.Holder.Id, e.Holder.EventId)
+}
+
+// lockKey is the filter matching the lock with the exact service, environment and resource of lock
+func lockKey(lock *lockv1alpha1.Lock) bson.D {
+	return bson.D{
+		{Key: "service", Value: lock.Service},
+		{Key: "environment", Value: lock.Environment},
+		{Key: "resource", Value: lock.Resource},
+	}
+}
+
+// LockConflicts is the filter matching the locks conflicting with lock. The
+// service and the resource of a lock may be a scope: "*" covers every value and
+// a trailing "*" every value with that prefix, e.g. "payments-*". A shared lock
+// only conflicts with the exclusive ones.
+func LockConflicts(lock *lockv1alpha1.Lock) bson.D {
+	conditions := bson.A{bson.D{{Key: "environment", Value: lock.Environment}}}
+	if lock.Mode == lockv1alpha1.LockMode_shared {
+		conditions = append(conditions, bson.D{{Key: "mode", Value: bson.D{{Key: "$ne", Value: int32(lockv1alpha1.LockMode_shared)}}}})
+	}
+	for _, field := range []struct{ key, value string }{
+		{"service", lock.Service},
+		{"resource", lock.Resource},
+	} {
+		if condition := scopeConflicts(field.key, field.value); condition != nil {
+			conditions = append(conditions, condition)
+		}
+	}
+	return bson.D{{Key: "$and", Value: conditions}}
+}
+
+// scopeConflicts matches the values of key overlapping the scope value, nil when every value does
+func scopeConflicts(key string, value string) bson.D {
+	if value == "*" {
+		return nil
+	}
+	prefix, isScope := strings.CutSuffix(value, "*")
+
+	// the scopes covering value: "*", "p*", "pa*", ...
+	covering := bson.A{"*"}
+	longest := len(prefix)
+	if isScope {
+		longest--
+	}
+	for i := 1; i <= longest; i++ {
+		covering = append(covering, prefix[:i]+"*")
+	}
+	if !isScope {
+		return bson.D{{Key: key, Value: bson.D{{Key: "$in", Value: append(covering, value)}}}}
+	}
+	// a scope also overlaps every value and scope it covers, itself included
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: key, Value: bson.D{{Key: "$in", Value: covering}}}},
+		bson.D{{Key: key, Value: bson.D{{Key: "$regex", Value: "^" + regexp.QuoteMeta(prefix)}}}},
+	}}}
+}
+
+// ReservationOverlapError is returned by ReservationStore.Create when another
+// reservation already books the environment, or the service, over the period
+type ReservationOverlapError struct {
+	Existing *lockv1alpha1.Reservation
+}
+
+func (e *ReservationOverlapError) Error() string {
+	reserved := "every service"
+	if e.Existing.Service != "" {
+		reserved = "service " + e.Existing.Service
+	}
+	return fmt.Sprintf("%s of %s is already reserved by %s from %s to %s (reservation_id: %s)",
+		reserved, e.Existing.Environment, e.Existing.Owner,
+		e.Existing.Start.AsTime().Format(time.RFC3339), e.Existing.End.AsTime().Format(time.RFC3339), e.Existing.Id)
+}
+
+// ReservationOverlaps is the filter matching the reservations overlapping
+// reservation: same environment, same service or a whole environment on one
+// side, and periods intersecting. Periods are compared to the second.
+func ReservationOverlaps(reservation *lockv1alpha1.Reservation) bson.D {
+	conditions := bson.A{
+		bson.D{{Key: "environment", Value: reservation.Environment}},
+		bson.D{{Key: "start.seconds", Value: bson.D{{Key: "$lt", Value: reservation.End.GetSeconds()}}}},
+		bson.D{{Key: "end.seconds", Value: bson.D{{Key: "$gt", Value: reservation.Start.GetSeconds()}}}},
+	}
+	if reservation.Service != "" {
+		conditions = append(conditions, bson.D{{Key: "service", Value: bson.D{{Key: "$in", Value: bson.A{"", reservation.Service}}}}})
+	}
+	return bson.D{{Key: "$and", Value: conditions}}
+}
+
+// FreezesAt is the filter matching the freezes of environment, of every
+// environment when empty, that may be in force at: the period of a freeze, when
+// it has one, includes at. The windows of a recurring freeze are left to the caller.
+func FreezesAt(environment string, at time.Time) bson.D {
+	conditions := bson.A{
+		bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "start", Value: nil}},
+			bson.D{{Key: "start.seconds", Value: bson.D{{Key: "$lte", Value: at.Unix()}}}},
+		}}},
+		bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "end", Value: nil}},
+			bson.D{{Key: "end.seconds", Value: bson.D{{Key: "$gt", Value: at.Unix()}}}},
+		}}},
+	}
+	if environment != "" {
+		conditions = append(conditions, bson.D{{Key: "environment", Value: environment}})
+	}
+	return bson.D{{Key: "$and", Value: conditions}}
+}
+
+// EventStore persists events
+type EventStore interface {
+	List(ctx context.Context) ([]*eventv1alpha1.Event, error)
+	Create(ctx context.Context, eventInsert *eventv1alpha1.Event) (*eventv1alpha1.Event, error)
+	Get(ctx context.Context, filter map[string]interface{}) (*eventv1alpha1.Event, error)
+	Search(ctx context.Context, filter map[string]interface{}) ([]*eventv1alpha1.Event, error)
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*eventv1alpha1.Event, error)
+	// TextSearch ranks the events matching filter by relevance to the full-text query q
+	TextSearch(ctx context.Context, filter bson.D, q string, opts FindOptions) ([]TextMatch, int64, error)
+	// Update replaces the fields of the first matching event, provided it is
+	// still at the revision of eventUpdate, and moves eventUpdate to the next
+	// revision. It returns the event as it was before the update, or
+	// ErrRevisionConflict.
+	Update(ctx context.Context, filter map[string]interface{}, eventUpdate *eventv1alpha1.Event) (*eventv1alpha1.Event, error)
+	// AppendChangelog appends entries to the changelog of the first matching
+	// event in a single atomic write, whatever its revision, and returns the
+	// event after the update
+	AppendChangelog(ctx context.Context, filter map[string]interface{}, entries ...*eventv1alpha1.ChangelogEntry) (*eventv1alpha1.Event, error)
+	Delete(ctx context.Context, filter map[string]interface{}) error
+	CountWithFilter(ctx context.Context, filter bson.D) (int64, error)
+	AggregateByMonth(ctx context.Context, matchFilter bson.D, groupByService bool) ([]MonthlyStatsResult, error)
+	Aggregate(ctx context.Context, matchFilter bson.D, opts AggregateOptions) ([]AggregateResult, error)
+}
+
+// LockStore persists locks
+type LockStore interface {
+	List(ctx context.Context) ([]*lockv1alpha1.Lock, error)
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.Lock, error)
+	Count(ctx context.Context, filter bson.D) (int64, error)
+	Create(ctx context.Context, lockInsert *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error)
+	// Acquire creates the lock atomically, or fails with a *LockedError when
+	// the service is already locked for the resource in the environment
+	Acquire(ctx context.Context, lockInsert *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error)
+	Get(ctx context.Context, filter map[string]interface{}) (*lockv1alpha1.Lock, error)
+	Unlock(ctx context.Context, filter map[string]interface{}) (int64, error)
+	Update(ctx context.Context, filter map[string]interface{}, lockUpdate *lockv1alpha1.Lock) (*lockv1alpha1.Lock, error)
+}
+
+// LockHistoryStore keeps the audit trail of the locks. Entries are only ever appended.
+type LockHistoryStore interface {
+	Append(ctx context.Context, entry *lockv1alpha1.LockHistoryEntry) error
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.LockHistoryEntry, error)
+	Count(ctx context.Context, filter bson.D) (int64, error)
+}
+
+// ReservationStore persists the reservations of environments
+type ReservationStore interface {
+	// Create stores the reservation unless it overlaps another one, in which
+	// case it returns a *ReservationOverlapError
+	Create(ctx context.Context, reservation *lockv1alpha1.Reservation) (*lockv1alpha1.Reservation, error)
+	Get(ctx context.Context, filter map[string]interface{}) (*lockv1alpha1.Reservation, error)
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*lockv1alpha1.Reservation, error)
+	Count(ctx context.Context, filter bson.D) (int64, error)
+	Update(ctx context.Context, filter map[string]interface{}, reservation *lockv1alpha1.Reservation) (*lockv1alpha1.Reservation, error)
+	Delete(ctx context.Context, filter map[string]interface{}) (int64, error)
+}
+
+// FreezeStore persists the freezes of environments
+type FreezeStore interface {
+	Create(ctx context.Context, freeze *freezev1alpha1.Freeze) (*freezev1alpha1.Freeze, error)
+	Get(ctx context.Context, filter map[string]interface{}) (*freezev1alpha1.Freeze, error)
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*freezev1alpha1.Freeze, error)
+	Count(ctx context.Context, filter bson.D) (int64, error)
+	// Update replaces the definition of the first matching freeze, its
+	// exceptions are left as they are
+	Update(ctx context.Context, filter map[string]interface{}, freeze *freezev1alpha1.Freeze) (*freezev1alpha1.Freeze, error)
+	// AddException appends exception to the freeze id in a single atomic
+	// write, or returns ErrNotFound
+	AddException(ctx context.Context, id string, exception *freezev1alpha1.FreezeException) (*freezev1alpha1.Freeze, error)
+	// ApproveException approves the exception exceptionId of the freeze id in
+	// a single atomic write, or returns ErrNotFound when the freeze has no
+	// such pending exception
+	ApproveException(ctx context.Context, id string, exceptionId string, approvedBy string, approvedAt *timestamppb.Timestamp) (*freezev1alpha1.Freeze, error)
+	Delete(ctx context.Context, filter map[string]interface{}) (int64, error)
+}
+
+// CatalogStore persists catalog entries
+type CatalogStore interface {
+	List(ctx context.Context) ([]*catalogv1alpha1.Catalog, error)
+	Find(ctx context.Context, filter bson.D, opts FindOptions) ([]*catalogv1alpha1.Catalog, error)
+	Count(ctx context.Context, filter bson.D) (int64, error)
+	Get(ctx context.Context, filter map[string]interface{}) (*catalogv1alpha1.Catalog, error)
+	// Update creates or updates the first matching entry, provided it is still
+	// at the revision of catalogUpdate (0 for an entry to create), and moves
+	// catalogUpdate to the next revision. It returns the entry after the
+	// update, or ErrRevisionConflict.
+	Update(ctx context.Context, filter map[string]interface{}, catalogUpdate *catalogv1alpha1.Catalog) (*catalogv1alpha1.Catalog, error)
+	Delete(ctx context.Context, filter map[string]interface{}) error
+}
+
+// ChangeStore persists the changes published on the watch feeds, so that
+// every instance of the tracker sees them and resume tokens outlive a restart
+type ChangeStore interface {
+	// Append numbers data after the last change of feed and stores it,
+	// keeping the last capacity changes of feed. Two appends may commit out of
+	// sequence order.
+	Append(ctx context.Context, feed string, data []byte, capacity int64) (int64, error)
+	// Since returns at most limit changes of feed after the sequence after, in
+	// sequence order
+	Since(ctx context.Context, feed string, after int64, limit int64) ([]*Change, error)
+	// Last returns the sequence of the last change appended to feed, 0 when
+	// there is none
+	Last(ctx context.Context, feed string) (int64, error)
+}
+
+// IdempotencyStore records the idempotency keys of the event creations, so
+// that a key creates one event whatever the instance of the tracker
+type IdempotencyStore interface {
+	// Reserve inserts record unless an unexpired record holds its key. It
+	// returns the record holding the key, and whether it is record.
+	Reserve(ctx context.Context, record *Idempotency) (*Idempotency, bool, error)
+	// Complete records the event created for the reservation record, kept
+	// until record.ExpiresAt. It returns ErrNotFound when the reservation
+	// expired and was taken over.
+	Complete(ctx context.Context, record *Idempotency) error
+	// Release deletes the reservation record when it created no event
+	Release(ctx context.Context, record *Idempotency) error
+}
+
+// LinksStore persists the custom links shown in the UI
+type LinksStore interface {
+	List(ctx context.Context) ([]*LinkItem, error)
+	Create(ctx context.Context, item *LinkItem) (*LinkItem, error)
+	Update(ctx context.Context, id string, item *LinkItem) (*LinkItem, error)
+	Delete(ctx context.Context, id string) error
+}
+
+var (
+	_ EventStore       = (*EventStoreClient)(nil)
+	_ LockStore        = (*LockStoreClient)(nil)
+	_ LockHistoryStore = (*LockHistoryStoreClient)(nil)
+	_ ReservationStore = (*ReservationStoreClient)(nil)
+	_ FreezeStore      = (*FreezeStoreClient)(nil)
+	_ CatalogStore     = (*CatalogStoreClient)(nil)
+	_ ChangeStore      = (*ChangeStoreClient)(nil)
+	_ IdempotencyStore = (*IdempotencyStoreClient)(nil)
+	_ LinksStore       = (*LinksStoreClient)(nil)
+
+	_ EventStore       = (*DocumentEventStore)(nil)
+	_ LockStore        = (*DocumentLockStore)(nil)
+	_ LockHistoryStore = (*DocumentLockHistoryStore)(nil)
+	_ ReservationStore = (*DocumentReservationStore)(nil)
+	_ FreezeStore      = (*DocumentFreezeStore)(nil)
+	_ CatalogStore     = (*DocumentCatalogStore)(nil)
+	_ ChangeStore      = (*DocumentChangeStore)(nil)
+	_ IdempotencyStore = (*DocumentIdempotencyStore)(nil)
+	_ LinksStore       = (*DocumentLinksStore)(nil)
+
+	_ documentCollection = (*memoryCollection)(nil)
+	_ documentCollection = (*postgresCollection)(nil)
+	_ documentCollection = (*embeddedCollection)(nil)
+	_ monthAggregator    = (*postgresCollection)(nil)
+	_ textSearcher       = (*postgresCollection)(nil)
+)
+
+// NewEventStore returns the event store of the configured storage backend
+func NewEventStore(collection string) EventStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreEvent(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreEvent(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreEvent(collection)
+	default:
+		return NewStoreEvent(collection)
+	}
+}
+
+// NewLockStore returns the lock store of the configured storage backend
+func NewLockStore(collection string) LockStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreLock(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreLock(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreLock(collection)
+	default:
+		return NewStoreLock(collection)
+	}
+}
+
+// NewLockHistoryStore returns the lock history store of the configured storage backend
+func NewLockHistoryStore(collection string) LockHistoryStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreLockHistory(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreLockHistory(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreLockHistory(collection)
+	default:
+		return NewStoreLockHistory(collection)
+	}
+}
+
+// NewReservationStore returns the reservation store of the configured storage backend
+func NewReservationStore(collection string) ReservationStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreReservation(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreReservation(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreReservation(collection)
+	default:
+		return NewStoreReservation(collection)
+	}
+}
+
+// NewFreezeStore returns the freeze store of the configured storage backend
+func NewFreezeStore(collection string) FreezeStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreFreeze(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreFreeze(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreFreeze(collection)
+	default:
+		return NewStoreFreeze(collection)
+	}
+}
+
+// NewCatalogStore returns the catalog store of the configured storage backend
+func NewCatalogStore(collection string) CatalogStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreCatalog(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreCatalog(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreCatalog(collection)
+	default:
+		return NewStoreCatalog(collection)
+	}
+}
+
+// NewLinksStore returns the links store of the configured storage backend
+func NewLinksStore(collection string) LinksStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreLinks(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreLinks(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreLinks(collection)
+	default:
+		return NewStoreLinks(collection)
+	}
+}
+
+// NewChangeStore returns the watch feed change store of the configured storage backend
+func NewChangeStore(collection string) ChangeStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreChange(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreChange(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreChange(collection)
+	default:
+		return NewStoreChange(collection)
+	}
+}
+
+// NewIdempotencyStore returns the idempotency store of the configured storage backend
+func NewIdempotencyStore(collection string) IdempotencyStore {
+	switch config.ConfigDatabase.Storage {
+	case config.StorageMemory:
+		return NewMemoryStoreIdempotency(collection)
+	case config.StoragePostgres:
+		return NewPostgresStoreIdempotency(collection)
+	case config.StorageEmbedded:
+		return NewEmbeddedStoreIdempotency(collection)
+	default:
+		return NewStoreIdempotency(collection)
+	}
+}
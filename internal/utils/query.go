@@ -0,0 +1,424 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// QueryError is a query that does not parse, Position is the column (from 1,
+// in characters) where the query goes wrong
+type QueryError struct {
+	Position int
+	Message  string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Position, e.Message)
+}
+
+// queryFieldKind tells which values and operators a query field takes
+type queryFieldKind int
+
+const (
+	queryString queryFieldKind = iota
+	queryEnum
+	queryBool
+	queryDate
+)
+
+type queryField struct {
+	path string
+	kind queryFieldKind
+	// values of an enum field, by name
+	values map[string]int32
+}
+
+// QueryFields are the fields of the events a query filters on
+var QueryFields = map[string]queryField{
+	"id":           {path: "metadata.id"},
+	"title":        {path: "title"},
+	"message":      {path: "attributes.message"},
+	"source":       {path: "attributes.source"},
+	"service":      {path: "attributes.service"},
+	"owner":        {path: "attributes.owner"},
+	"related_id":   {path: "attributes.relatedid"},
+	"release_id":   {path: "attributes.releaseid"},
+	"slack_id":     {path: "metadata.slackid"},
+	"ticket":       {path: "links.ticket"},
+	"pull_request": {path: "links.pullrequestlink"},
+	"type":         {path: "attributes.type", kind: queryEnum, values: v1alpha1.Type_value},
+	"priority":     {path: "attributes.priority", kind: queryEnum, values: v1alpha1.Priority_value},
+	"status":       {path: "attributes.status", kind: queryEnum, values: v1alpha1.Status_value},
+	"environment":  {path: "attributes.environment", kind: queryEnum, values: v1alpha1.Environment_value},
+	"env":          {path: "attributes.environment", kind: queryEnum, values: v1alpha1.Environment_value},
+	"impact":       {path: "attributes.impact", kind: queryBool},
+	"start_date":   {path: "attributes.startdate.seconds", kind: queryDate},
+	"end_date":     {path: "attributes.enddate.seconds", kind: queryDate},
+	"created_at":   {path: "metadata.createdat.seconds", kind: queryDate},
+}
+
+// queryOperators maps the comparison operators to the Mongo ones, "=" being a plain equality
+var queryOperators = map[string]string{
+	"=":  "",
+	"!=": "$ne",
+	">":  "$gt",
+	">=": "$gte",
+	"<":  "$lt",
+	"<=": "$lte",
+	"~":  "$regex",
+	"!~": "$regex",
+}
+
+type queryTokenKind int
+
+const (
+	tokenEnd queryTokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+type queryToken struct {
+	kind  queryTokenKind
+	text  string
+	start int
+}
+
+// isKeyword tells whether the token is the unquoted keyword, whatever its case
+func (t queryToken) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t queryToken) String() string {
+	if t.kind == tokenEnd {
+		return "end of query"
+	}
+	return strconv.Quote(t.text)
+}
+
+// queryParser compiles a query by recursive descent:
+//
+//	or         = and { "or" and }
+//	and        = not { "and" not }
+//	not        = "not" not | "(" or ")" | comparison
+//	comparison = field operator value | field [ "not" ] "in" "(" value { "," value } ")"
+type queryParser struct {
+	query  string
+	tokens []queryToken
+	at     int
+}
+
+// ParseQuery compiles a query such as
+//
+//	service in (a,b) and env=production and status!=success and owner~"team-*"
+//
+// to a store filter. Values are words or double quoted strings, ~ matches a
+// pattern where * is any text and ? any character, case insensitively.
+func ParseQuery(query string) (bson.D, error) {
+	p := &queryParser{query: query}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if p.peek().kind == tokenEnd {
+		return nil, p.errorAt(0, "the query is empty")
+	}
+	filter, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEnd {
+		return nil, p.errorAt(next.start, "unexpected %s, expected and, or or the end of query", next)
+	}
+	return filter, nil
+}
+
+func (p *queryParser) errorAt(offset int, format string, args ...any) *QueryError {
+	return &QueryError{
+		Position: utf8.RuneCountInString(p.query[:offset]) + 1,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *queryParser) tokenize() error {
+	q := p.query
+	for i := 0; i < len(q); {
+		r, size := utf8.DecodeRuneInString(q[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			p.tokens = append(p.tokens, queryToken{kind: tokenOpen, text: "(", start: i})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, queryToken{kind: tokenClose, text: ")", start: i})
+			i++
+		case r == ',':
+			p.tokens = append(p.tokens, queryToken{kind: tokenComma, text: ",", start: i})
+			i++
+		case strings.ContainsRune("=!<>~", r):
+			operator := q[i : i+1]
+			if i+1 < len(q) {
+				if _, ok := queryOperators[q[i:i+2]]; ok {
+					operator = q[i : i+2]
+				}
+			}
+			if _, ok := queryOperators[operator]; !ok {
+				return p.errorAt(i, "unknown operator %q", operator)
+			}
+			p.tokens = append(p.tokens, queryToken{kind: tokenOperator, text: operator, start: i})
+			i += len(operator)
+		case r == '"':
+			var text strings.Builder
+			end := i + 1
+			for ; end < len(q) && q[end] != '"'; end++ {
+				if q[end] == '\\' && end+1 < len(q) {
+					end++
+				}
+				text.WriteByte(q[end])
+			}
+			if end == len(q) {
+				return p.errorAt(i, "unterminated string")
+			}
+			p.tokens = append(p.tokens, queryToken{kind: tokenString, text: text.String(), start: i})
+			i = end + 1
+		default:
+			end := i
+			for end < len(q) {
+				r, size := utf8.DecodeRuneInString(q[end:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`(),=!<>~"`, r) {
+					break
+				}
+				end += size
+			}
+			p.tokens = append(p.tokens, queryToken{kind: tokenWord, text: q[i:end], start: i})
+			i = end
+		}
+	}
+	p.tokens = append(p.tokens, queryToken{kind: tokenEnd, start: len(q)})
+	return nil
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.at]
+}
+
+func (p *queryParser) next() queryToken {
+	token := p.tokens[p.at]
+	if token.kind != tokenEnd {
+		p.at++
+	}
+	return token
+}
+
+func (p *queryParser) or() (bson.D, error) {
+	return p.logical("or", "$or", p.and)
+}
+
+func (p *queryParser) and() (bson.D, error) {
+	return p.logical("and", "$and", p.not)
+}
+
+// logical parses operands separated by keyword, combined with operator when
+// there are several
+func (p *queryParser) logical(keyword string, operator string, operand func() (bson.D, error)) (bson.D, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := bson.A{first}
+	for p.peek().isKeyword(keyword) {
+		p.next()
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return bson.D{{Key: operator, Value: operands}}, nil
+}
+
+func (p *queryParser) not() (bson.D, error) {
+	token := p.peek()
+	switch {
+	case token.isKeyword("not"):
+		p.next()
+		negated, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{Key: "$nor", Value: bson.A{negated}}}, nil
+	case token.kind == tokenOpen:
+		p.next()
+		filter, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenClose {
+			return nil, p.errorAt(closing.start, "unexpected %s, expected )", closing)
+		}
+		return filter, nil
+	}
+	return p.comparison()
+}
+
+func (p *queryParser) comparison() (bson.D, error) {
+	name := p.next()
+	if name.kind != tokenWord {
+		return nil, p.errorAt(name.start, "unexpected %s, expected a field", name)
+	}
+	field, ok := QueryFields[strings.ToLower(name.text)]
+	if !ok {
+		return nil, p.errorAt(name.start, "unknown field %q, expected one of %s", name.text, strings.Join(queryFieldNames(), ", "))
+	}
+
+	operator := p.next()
+	negated := operator.isKeyword("not")
+	if negated {
+		operator = p.next()
+		if !operator.isKeyword("in") {
+			return nil, p.errorAt(operator.start, "unexpected %s, expected in", operator)
+		}
+	}
+	if operator.isKeyword("in") {
+		if field.kind == queryDate {
+			return nil, p.errorAt(operator.start, "in cannot be used on %s", name.text)
+		}
+		values, err := p.list(field)
+		if err != nil {
+			return nil, err
+		}
+		mongoOperator := "$in"
+		if negated {
+			mongoOperator = "$nin"
+		}
+		return bson.D{{Key: field.path, Value: bson.D{{Key: mongoOperator, Value: values}}}}, nil
+	}
+	if operator.kind != tokenOperator {
+		return nil, p.errorAt(operator.start, "unexpected %s, expected an operator (=, !=, <, <=, >, >=, ~, !~, in)", operator)
+	}
+
+	switch {
+	case field.kind == queryDate && (operator.text == "=" || operator.text == "!="),
+		field.kind != queryDate && strings.ContainsAny(operator.text, "<>"),
+		field.kind != queryString && strings.HasSuffix(operator.text, "~"):
+		return nil, p.errorAt(operator.start, "%s cannot be used on %s", operator.text, name.text)
+	}
+	value, err := p.value(field)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operator.text {
+	case "=":
+		return bson.D{{Key: field.path, Value: value}}, nil
+	case "~", "!~":
+		match := bson.D{{Key: field.path, Value: bson.D{
+			{Key: "$regex", Value: globPattern(value.(string))},
+			{Key: "$options", Value: "i"},
+		}}}
+		if operator.text == "!~" {
+			return bson.D{{Key: "$nor", Value: bson.A{match}}}, nil
+		}
+		return match, nil
+	}
+	return bson.D{{Key: field.path, Value: bson.D{{Key: queryOperators[operator.text], Value: value}}}}, nil
+}
+
+// list parses the parenthesized values of an in
+func (p *queryParser) list(field queryField) (bson.A, error) {
+	if open := p.next(); open.kind != tokenOpen {
+		return nil, p.errorAt(open.start, "unexpected %s, expected (", open)
+	}
+	var values bson.A
+	for {
+		value, err := p.value(field)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		switch separator := p.next(); separator.kind {
+		case tokenComma:
+		case tokenClose:
+			return values, nil
+		default:
+			return nil, p.errorAt(separator.start, "unexpected %s, expected , or )", separator)
+		}
+	}
+}
+
+// value parses a value of field, as it is stored
+func (p *queryParser) value(field queryField) (interface{}, error) {
+	token := p.next()
+	if token.kind != tokenWord && token.kind != tokenString {
+		return nil, p.errorAt(token.start, "unexpected %s, expected a value", token)
+	}
+
+	switch field.kind {
+	case queryEnum:
+		if number, err := strconv.ParseInt(token.text, 10, 32); err == nil {
+			return int32(number), nil
+		}
+		for name, number := range field.values {
+			if strings.EqualFold(name, token.text) && number != 0 {
+				return number, nil
+			}
+		}
+		return nil, p.errorAt(token.start, "unknown value %s, expected one of %s", token, strings.Join(enumNames(field.values), ", "))
+	case queryBool:
+		value, err := strconv.ParseBool(token.text)
+		if err != nil {
+			return nil, p.errorAt(token.start, "unexpected %s, expected true or false", token)
+		}
+		return value, nil
+	case queryDate:
+		date, err := parseDate(token.text)
+		if err != nil {
+			return nil, p.errorAt(token.start, "invalid date %s", token)
+		}
+		return date.Unix(), nil
+	}
+	return token.text, nil
+}
+
+// globPattern converts a pattern where * is any text and ? any character to an anchored regex
+func globPattern(glob string) string {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+	return "^" + pattern + "$"
+}
+
+func queryFieldNames() []string {
+	names := make([]string, 0, len(QueryFields))
+	for name := range QueryFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// enumNames returns the names of the values of an enum, but its unspecified value
+func enumNames(values map[string]int32) []string {
+	var names []string
+	for name, number := range values {
+		if number != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return values[names[i]] < values[names[j]] })
+	return names
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestParseQuery(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected bson.D
+	}{
+		{
+			name:     "OK - Test equality",
+			query:    "service=payments",
+			expected: bson.D{{Key: "attributes.service", Value: "payments"}},
+		},
+		{
+			name:  "OK - Test enum names and numbers",
+			query: "env = PRODUCTION and status in (success, 2)",
+			expected: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "attributes.environment", Value: int32(7)}},
+				bson.D{{Key: "attributes.status", Value: bson.D{{Key: "$in", Value: bson.A{int32(3), int32(2)}}}}},
+			}}},
+		},
+		{
+			name:  "OK - Test and binds tighter than or",
+			query: `service in (a,b) and status!=success or not owner~"team-*"`,
+			expected: bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "$and", Value: bson.A{
+					bson.D{{Key: "attributes.service", Value: bson.D{{Key: "$in", Value: bson.A{"a", "b"}}}}},
+					bson.D{{Key: "attributes.status", Value: bson.D{{Key: "$ne", Value: int32(3)}}}},
+				}}},
+				bson.D{{Key: "$nor", Value: bson.A{
+					bson.D{{Key: "attributes.owner", Value: bson.D{{Key: "$regex", Value: "^team-.*$"}, {Key: "$options", Value: "i"}}}},
+				}}},
+			}}},
+		},
+		{
+			name:  "OK - Test parentheses and not in",
+			query: `(type=deployment OR type=operation) AND title not in ("say \"hi\"")`,
+			expected: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "$or", Value: bson.A{
+					bson.D{{Key: "attributes.type", Value: int32(1)}},
+					bson.D{{Key: "attributes.type", Value: int32(2)}},
+				}}},
+				bson.D{{Key: "title", Value: bson.D{{Key: "$nin", Value: bson.A{`say "hi"`}}}}},
+			}}},
+		},
+		{
+			name:  "OK - Test dates and booleans",
+			query: "created_at >= 2024-03-01 and impact=true",
+			expected: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "metadata.createdat.seconds", Value: bson.D{{Key: "$gte", Value: int64(1709251200)}}}},
+				bson.D{{Key: "attributes.impact", Value: true}},
+			}}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		filter, err := ParseQuery(testCase.query)
+		assert.NoError(t, err, testCase.name)
+		assert.Equal(t, testCase.expected, filter, testCase.name)
+	}
+}
+
+func TestParseQueryError(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		query    string
+		position int
+		message  string
+	}{
+		{name: "KO - Test empty query", query: " ", position: 1, message: "the query is empty"},
+		{name: "KO - Test unknown field", query: "service=a and team=b", position: 15, message: `unknown field "team"`},
+		{name: "KO - Test unknown enum value", query: "env=prod", position: 5, message: `unknown value "prod"`},
+		{name: "KO - Test missing value", query: "service=", position: 9, message: "unexpected end of query, expected a value"},
+		{name: "KO - Test unclosed list", query: "service in (a,b", position: 16, message: "expected , or )"},
+		{name: "KO - Test unterminated string", query: `owner~"team-*`, position: 7, message: "unterminated string"},
+		{name: "KO - Test operator on enum", query: "status~succ*", position: 7, message: "~ cannot be used on status"},
+		{name: "KO - Test missing and", query: "service=a status=b", position: 11, message: `unexpected "status", expected and, or or the end of query`},
+		{name: "KO - Test position in characters", query: "title=été or )", position: 14, message: `unexpected ")", expected a field`},
+	}
+
+	for _, testCase := range testCases {
+		_, err := ParseQuery(testCase.query)
+		queryErr, ok := err.(*QueryError)
+		if assert.True(t, ok, testCase.name) {
+			assert.Equal(t, testCase.position, queryErr.Position, testCase.name)
+			assert.Contains(t, queryErr.Message, testCase.message, testCase.name)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+)
+
+// TextFieldWeights are the fields of an event searched by a text query, with
+// the weight of a match in each of them. The Mongo text index uses the same.
+var TextFieldWeights = map[string]int32{
+	"title":                 10,
+	"attributes.message":    5,
+	"changelog.comment":     2,
+	"links.ticket":          1,
+	"links.pullrequestlink": 1,
+}
+
+// snippetRadius is how many characters a highlight keeps around its first match
+const snippetRadius = 60
+
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// TextQuery is a parsed full-text query, with the syntax of Mongo $text:
+// words, "quoted phrases" and -excluded words, all case insensitive. An event
+// matches when it has every phrase, no excluded word and, without phrase, one
+// of the words at least.
+type TextQuery struct {
+	Words    []string
+	Phrases  []string
+	Excluded []string
+	// phrasePatterns finds each phrase whatever its case
+	phrasePatterns []*regexp.Regexp
+}
+
+// ParseTextQuery parses q, an unterminated phrase runs to the end of q
+func ParseTextQuery(q string) (*TextQuery, error) {
+	query := &TextQuery{}
+	for i, part := range strings.Split(q, `"`) {
+		if i%2 == 1 {
+			if phrase := strings.ToLower(strings.TrimSpace(part)); phrase != "" {
+				query.Phrases = append(query.Phrases, phrase)
+				query.phrasePatterns = append(query.phrasePatterns, regexp.MustCompile(`(?i)`+regexp.QuoteMeta(phrase)))
+			}
+			continue
+		}
+		for _, field := range strings.Fields(part) {
+			excluded := strings.HasPrefix(field, "-")
+			for _, word := range wordPattern.FindAllString(strings.ToLower(field), -1) {
+				if excluded {
+					query.Excluded = append(query.Excluded, word)
+				} else {
+					query.Words = append(query.Words, word)
+				}
+			}
+		}
+	}
+	if len(query.Words) == 0 && len(query.Phrases) == 0 {
+		return nil, errors.New("q has no word to search")
+	}
+	return query, nil
+}
+
+// textField is a searched text of an event
+type textField struct {
+	name   string
+	text   string
+	weight int32
+}
+
+func textFields(event *v1alpha1.Event) []textField {
+	fields := []textField{
+		{"title", event.GetTitle(), TextFieldWeights["title"]},
+		{"attributes.message", event.GetAttributes().GetMessage(), TextFieldWeights["attributes.message"]},
+	}
+	for _, entry := range event.GetChangelog() {
+		fields = append(fields, textField{"changelog.comment", entry.GetComment(), TextFieldWeights["changelog.comment"]})
+	}
+	return append(fields,
+		textField{"links.ticket", event.GetLinks().GetTicket(), TextFieldWeights["links.ticket"]},
+		textField{"links.pull_request_link", event.GetLinks().GetPullRequestLink(), TextFieldWeights["links.pullrequestlink"]},
+	)
+}
+
+// matches returns the byte ranges of the words and phrases of q found in text
+func (q *TextQuery) matches(text string) (spans [][2]int, excluded bool) {
+	for _, found := range wordPattern.FindAllStringIndex(text, -1) {
+		word := strings.ToLower(text[found[0]:found[1]])
+		for _, wanted := range q.Excluded {
+			if word == wanted {
+				excluded = true
+			}
+		}
+		for _, wanted := range q.Words {
+			if word == wanted {
+				spans = append(spans, [2]int{found[0], found[1]})
+				break
+			}
+		}
+	}
+	for _, pattern := range q.phrasePatterns {
+		for _, found := range pattern.FindAllStringIndex(text, -1) {
+			spans = append(spans, [2]int{found[0], found[1]})
+		}
+	}
+	return spans, excluded
+}
+
+// Score returns the relevance of event for q, 0 when it does not match: the
+// weight of each field times its number of matches
+func (q *TextQuery) Score(event *v1alpha1.Event) float64 {
+	var score float64
+	var words int
+	phrases := map[string]bool{}
+	for _, field := range textFields(event) {
+		spans, excluded := q.matches(field.text)
+		if excluded {
+			return 0
+		}
+		score += float64(field.weight) * float64(len(spans))
+		lower := strings.ToLower(field.text)
+		for _, phrase := range q.Phrases {
+			if strings.Contains(lower, phrase) {
+				phrases[phrase] = true
+			}
+		}
+		words += len(spans)
+	}
+	if len(phrases) < len(q.Phrases) || words == 0 {
+		return 0
+	}
+	return score
+}
+
+// Highlights returns a snippet of each field of event matching q, its matches
+// wrapped in <em> tags and the rest HTML escaped
+func (q *TextQuery) Highlights(event *v1alpha1.Event) []*v1alpha1.Highlight {
+	var highlights []*v1alpha1.Highlight
+	for _, field := range textFields(event) {
+		spans, _ := q.matches(field.text)
+		if len(spans) == 0 {
+			continue
+		}
+		highlights = append(highlights, &v1alpha1.Highlight{Field: field.name, Snippet: snippet(field.text, spans)})
+	}
+	return highlights
+}
+
+// snippet cuts text around its first span, marking the spans it keeps
+func snippet(text string, spans [][2]int) string {
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	start := max(0, spans[0][0]-snippetRadius)
+	end := min(len(text), spans[0][1]+snippetRadius)
+	// do not cut a character nor a word in two
+	for start > 0 && !wordBoundary(text, start) {
+		start--
+	}
+	for end < len(text) && !wordBoundary(text, end) {
+		end++
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	at := start
+	for _, span := range spans {
+		if span[0] < at || span[1] > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[at:span[0]]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[span[0]:span[1]]))
+		b.WriteString("</em>")
+		at = span[1]
+	}
+	b.WriteString(html.EscapeString(text[at:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+func wordBoundary(text string, at int) bool {
+	return strings.ContainsRune(" \t\n", rune(text[at-1])) || strings.ContainsRune(" \t\n", rune(text[at]))
+}
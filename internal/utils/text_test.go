@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+)
+
+func TestParseTextQuery(t *testing.T) {
+	query, err := ParseTextQuery(`Kafka "consumer Rebalance" -staging lag`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kafka", "lag"}, query.Words)
+	assert.Equal(t, []string{"consumer rebalance"}, query.Phrases)
+	assert.Equal(t, []string{"staging"}, query.Excluded)
+
+	_, err = ParseTextQuery(` "" -kafka`)
+	assert.Error(t, err, "nothing to match")
+}
+
+func TestTextQueryScore(t *testing.T) {
+	event := &v1alpha1.Event{
+		Title:      "Kafka upgrade",
+		Attributes: &v1alpha1.EventAttributes{Message: "the consumer rebalance of kafka took an hour"},
+		Changelog:  []*v1alpha1.ChangelogEntry{{Comment: "Rebalance done"}},
+		Links:      &v1alpha1.EventLinks{},
+	}
+
+	testCases := []struct {
+		q     string
+		score float64
+	}{
+		{q: "kafka", score: 10 + 5},
+		{q: "rebalance", score: 5 + 2},
+		{q: `"consumer rebalance"`, score: 5},
+		{q: `"rebalance consumer"`, score: 0},
+		{q: "kafka -hour", score: 0},
+		{q: "zookeeper", score: 0},
+	}
+
+	for _, testCase := range testCases {
+		query, err := ParseTextQuery(testCase.q)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.score, query.Score(event), testCase.q)
+	}
+}
+
+func TestTextQueryHighlights(t *testing.T) {
+	query, err := ParseTextQuery("kafka")
+	assert.NoError(t, err)
+
+	event := &v1alpha1.Event{
+		Title: "<b>Kafka</b> upgrade",
+		Attributes: &v1alpha1.EventAttributes{
+			Message: "a long introduction written before getting to the point, which is that the kafka brokers were restarted one by one during the night while nobody was watching the dashboards",
+		},
+	}
+	highlights := query.Highlights(event)
+	if assert.Len(t, highlights, 2) {
+		assert.Equal(t, "title", highlights[0].Field)
+		assert.Equal(t, "&lt;b&gt;<em>Kafka</em>&lt;/b&gt; upgrade", highlights[0].Snippet)
+		assert.Equal(t, "attributes.message", highlights[1].Field)
+		assert.Equal(t, "…introduction written before getting to the point, which is that the <em>kafka</em> brokers were restarted one by one during the night while nobody…", highlights[1].Snippet)
+	}
+}
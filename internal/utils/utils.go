@@ -8,6 +8,7 @@ import (
 	"time"
 
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -63,10 +64,14 @@ func CreateFilter(e *v1alpha1.SearchEventsRequest) (map[string]interface{}, erro
 		}
 		filter["attributes.startdate.seconds"] = bson.D{{Key: "$lte", Value: date.Unix()}}
 	}
-	if len(filter) == 0 {
-		err := errors.New("no filter for search events")
-		return nil, err
+	if e.Query != "" {
+		query, err := ParseQuery(e.Query)
+		if err != nil {
+			return nil, err
+		}
+		filter["$and"] = bson.A{query}
 	}
+	// sans critère, la recherche renvoie tous les événements, paginés
 	return filter, nil
 }
 
@@ -144,27 +149,36 @@ type StatsFilter struct {
 	Statuses     []int32
 	Source       string
 	Service      string
+	// Query is a filter in the language of ParseQuery
+	Query string
 }
 
-// CreateStatsFilter builds a bson.D filter for event statistics queries
-func CreateStatsFilter(f *StatsFilter) (bson.D, error) {
-	filter := bson.D{}
-
-	// Parse and validate dates (required)
+// ParseStatsPeriod parses and validates the required dates of a StatsFilter
+func ParseStatsPeriod(f *StatsFilter) (start time.Time, end time.Time, err error) {
 	if f.StartDate == "" || f.EndDate == "" {
-		return nil, errors.New("start_date and end_date are required")
+		return start, end, errors.New("start_date and end_date are required")
 	}
 
-	start, err := parseDate(f.StartDate)
+	start, err = parseDate(f.StartDate)
 	if err != nil {
-		return nil, fmt.Errorf("invalid start_date: %w", err)
+		return start, end, fmt.Errorf("invalid start_date: %w", err)
 	}
-	end, err := parseDate(f.EndDate)
+	end, err = parseDate(f.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("invalid end_date: %w", err)
+		return start, end, fmt.Errorf("invalid end_date: %w", err)
 	}
 
-	if err = checkDateInverted(start, end); err != nil {
+	err = checkDateInverted(start, end)
+	return start, end, err
+}
+
+// CreateStatsFilter builds a bson.D filter for event statistics queries
+func CreateStatsFilter(f *StatsFilter) (bson.D, error) {
+	filter := bson.D{}
+
+	// Parse and validate dates (required)
+	start, end, err := ParseStatsPeriod(f)
+	if err != nil {
 		return nil, err
 	}
 
@@ -206,5 +220,121 @@ func CreateStatsFilter(f *StatsFilter) (bson.D, error) {
 		filter = append(filter, bson.E{Key: "attributes.service", Value: f.Service})
 	}
 
+	if f.Query != "" {
+		query, err := ParseQuery(f.Query)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, bson.E{Key: "$and", Value: bson.A{query}})
+	}
+
+	return filter, nil
+}
+
+// CreateLockHistoryFilter builds a bson.D filter for lock history queries
+func CreateLockHistoryFilter(r *lockv1alpha1.ListLockHistoryRequest) (bson.D, error) {
+	filter := bson.D{}
+
+	if r.Service != "" {
+		filter = append(filter, bson.E{Key: "service", Value: r.Service})
+	}
+	if r.Environment != "" {
+		filter = append(filter, bson.E{Key: "environment", Value: r.Environment})
+	}
+	if r.LockId != "" {
+		filter = append(filter, bson.E{Key: "lockid", Value: r.LockId})
+	}
+	// the holder of the lock or the author of the change, e.g. who force-released it
+	if r.Who != "" {
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "who", Value: r.Who}},
+			bson.D{{Key: "actor", Value: r.Who}},
+		}})
+	}
+
+	timestamp := bson.D{}
+	var start, end time.Time
+	var err error
+	if r.StartDate != "" {
+		if start, err = parseDate(r.StartDate); err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+		timestamp = append(timestamp, bson.E{Key: "$gte", Value: start.Unix()})
+	}
+	if r.EndDate != "" {
+		if end, err = parseDate(r.EndDate); err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+		timestamp = append(timestamp, bson.E{Key: "$lte", Value: end.Unix()})
+	}
+	if r.StartDate != "" && r.EndDate != "" {
+		if err = checkDateInverted(start, end); err != nil {
+			return nil, err
+		}
+	}
+	if len(timestamp) > 0 {
+		filter = append(filter, bson.E{Key: "timestamp.seconds", Value: timestamp})
+	}
+
+	return filter, nil
+}
+
+// CreateOverlapsFilter builds a bson.D filter for the events of a period of
+// overlaps: their period, from start_date, or the creation date when unset, to
+// end_date, or their start when unset, intersects the requested one
+func CreateOverlapsFilter(r *v1alpha1.GetOverlapsRequest) (bson.D, error) {
+	if r.StartDate == "" || r.EndDate == "" {
+		return nil, errors.New("start_date and end_date are required")
+	}
+	start, err := parseDate(r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_date: %w", err)
+	}
+	end, err := parseDate(r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_date: %w", err)
+	}
+	if err = checkDateInverted(start, end); err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{Key: "$and", Value: bson.A{
+			// starts before the end of the period
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "attributes.startdate.seconds", Value: bson.D{{Key: "$lte", Value: end.Unix()}}}},
+				bson.D{
+					{Key: "attributes.startdate", Value: nil},
+					{Key: "metadata.createdat.seconds", Value: bson.D{{Key: "$lte", Value: end.Unix()}}},
+				},
+			}}},
+			// ends after the start of the period
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "attributes.enddate.seconds", Value: bson.D{{Key: "$gte", Value: start.Unix()}}}},
+				bson.D{
+					{Key: "attributes.enddate", Value: nil},
+					{Key: "attributes.startdate.seconds", Value: bson.D{{Key: "$gte", Value: start.Unix()}}},
+				},
+				bson.D{
+					{Key: "attributes.enddate", Value: nil},
+					{Key: "attributes.startdate", Value: nil},
+					{Key: "metadata.createdat.seconds", Value: bson.D{{Key: "$gte", Value: start.Unix()}}},
+				},
+			}}},
+		}},
+	}
+
+	if len(r.Environments) > 0 {
+		environments := make([]int32, len(r.Environments))
+		for idx, env := range r.Environments {
+			environments[idx] = int32(env)
+		}
+		filter = append(filter, bson.E{Key: "attributes.environment", Value: bson.D{{Key: "$in", Value: environments}}})
+	}
+
+	if r.Service != "" {
+		filter = append(filter, bson.E{Key: "attributes.service", Value: r.Service})
+	}
+
 	return filter, nil
 }
@@ -7,34 +7,16 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lockv1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
 )
 
 var loc = time.Now().Local().Location()
 
-func TestCreateFilterError(t *testing.T) {
+func TestCreateFilterEmpty(t *testing.T) {
 
-	testCases := []struct {
-		name  string
-		event *v1alpha1.SearchEventsRequest
-	}{
-		{
-			name: "OK - Test error when all values is null",
-			event: &v1alpha1.SearchEventsRequest{
-				Source:    "",
-				Type:      0,
-				Priority:  0,
-				Status:    0,
-				Service:   "",
-				StartDate: "",
-				EndDate:   "",
-			},
-		},
-	}
-
-	for _, testCase := range testCases {
-		_, err := CreateFilter(testCase.event)
-		assert.Error(t, err, testCase.name)
-	}
+	filter, err := CreateFilter(&v1alpha1.SearchEventsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, filter, "an empty search matches every event")
 }
 
 func TestParseDate(t *testing.T) {
@@ -316,6 +298,50 @@ func TestCreateStatsFilterMultipleEnvironments(t *testing.T) {
 	assert.Len(t, result, 2)
 }
 
+func TestCreateLockHistoryFilter(t *testing.T) {
+	result, err := CreateLockHistoryFilter(&lockv1alpha1.ListLockHistoryRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, result, "no filter returns the whole history")
+
+	result, err = CreateLockHistoryFilter(&lockv1alpha1.ListLockHistoryRequest{
+		Service:     "payments",
+		Environment: "production",
+		Who:         "alice",
+		StartDate:   "2025-01-01",
+		EndDate:     "2025-01-31",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result, 4)
+	assert.Equal(t, "timestamp.seconds", result[3].Key)
+
+	_, err = CreateLockHistoryFilter(&lockv1alpha1.ListLockHistoryRequest{StartDate: "2025-02-01", EndDate: "2025-01-01"})
+	assert.Error(t, err)
+
+	_, err = CreateLockHistoryFilter(&lockv1alpha1.ListLockHistoryRequest{StartDate: "yesterday"})
+	assert.Error(t, err)
+}
+
+func TestCreateOverlapsFilter(t *testing.T) {
+	result, err := CreateOverlapsFilter(&v1alpha1.GetOverlapsRequest{StartDate: "2025-01-01", EndDate: "2025-01-31"})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1, "the period only")
+
+	result, err = CreateOverlapsFilter(&v1alpha1.GetOverlapsRequest{
+		StartDate:    "2025-01-01",
+		EndDate:      "2025-01-31",
+		Environments: []v1alpha1.Environment{v1alpha1.Environment_production},
+		Service:      "payments",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, "attributes.environment", result[1].Key)
+
+	_, err = CreateOverlapsFilter(&v1alpha1.GetOverlapsRequest{StartDate: "2025-01-01"})
+	assert.Error(t, err)
+	_, err = CreateOverlapsFilter(&v1alpha1.GetOverlapsRequest{StartDate: "2025-02-01", EndDate: "2025-01-01"})
+	assert.Error(t, err)
+}
+
 // Helper function to create bool pointer
 func boolPtr(b bool) *bool {
 	return &b
@@ -59,7 +59,9 @@ class TrackerMCPServer:
                            end_date: Optional[str] = None,
                            environment: Optional[str] = None,
                            impact: Optional[bool] = None,
-                           slack_id: Optional[str] = None) -> dict[str, Any]:
+                           slack_id: Optional[str] = None,
+                           query: Optional[str] = None,
+                           filter: Optional[str] = None) -> dict[str, Any]:
         """Search events with multiple filters"""
         params = {}
         if source:
@@ -82,6 +84,10 @@ class TrackerMCPServer:
             params["impact"] = str(impact).lower()
         if slack_id:
             params["slackId"] = slack_id
+        if query:
+            params["q"] = query
+        if filter:
+            params["query"] = filter
             
         response = await self.client.get(f"{self.api_base}/events/search", params=params)
         response.raise_for_status()
@@ -93,6 +99,23 @@ class TrackerMCPServer:
         response.raise_for_status()
         return response.json()
     
+    async def get_overlaps(self, start_date: str, end_date: str,
+                           environment: Optional[str] = None,
+                           service: Optional[str] = None,
+                           environment_only: bool = False) -> dict[str, Any]:
+        """Get the pairs of events whose periods intersect"""
+        params = {"startDate": start_date, "endDate": end_date}
+        if environment:
+            params["environments"] = environment
+        if service:
+            params["service"] = service
+        if environment_only:
+            params["environmentOnly"] = "true"
+
+        response = await self.client.get(f"{self.api_base}/events/overlaps", params=params)
+        response.raise_for_status()
+        return response.json()
+    
     async def list_catalog(self, per_page: int = 10, page: int = 1) -> dict[str, Any]:
         """List catalog services"""
         params = {"perPage": per_page, "page": page}
@@ -231,6 +254,14 @@ async def main():
                         "slack_id": {
                             "type": "string",
                             "description": "Filter by Slack message ID"
+                        },
+                        "query": {
+                            "type": "string",
+                            "description": "Full-text search in titles, messages, changelog comments, tickets and pull request links: words, \"quoted phrases\" and -excluded words. Results are ranked by relevance, with highlighted snippets in hits"
+                        },
+                        "filter": {
+                            "type": "string",
+                            "description": "Filter in the tracker query language, e.g. service in (payments,billing) and env=production and status!=success and owner~\"team-*\""
                         }
                     }
                 }
@@ -249,6 +280,38 @@ async def main():
                     "required": ["event_id"]
                 }
             ),
+            Tool(
+                name="get_overlaps",
+                description="Get the conflicts of a period: pairs of events of the same environment and service whose periods intersect, with the overlap duration and the owners of the services from the catalog.",
+                inputSchema={
+                    "type": "object",
+                    "properties": {
+                        "start_date": {
+                            "type": "string",
+                            "description": "Start of the period (ISO 8601 format: YYYY-MM-DD or YYYY-MM-DDTHH:MM:SSZ)"
+                        },
+                        "end_date": {
+                            "type": "string",
+                            "description": "End of the period (ISO 8601 format: YYYY-MM-DD or YYYY-MM-DDTHH:MM:SSZ)"
+                        },
+                        "environment": {
+                            "type": "string",
+                            "description": "Filter by environment",
+                            "enum": ["development", "integration", "TNR", "UAT", "recette", "preproduction", "production", "mco"]
+                        },
+                        "service": {
+                            "type": "string",
+                            "description": "Filter by service name"
+                        },
+                        "environment_only": {
+                            "type": "boolean",
+                            "description": "Pair the events of an environment whatever their service",
+                            "default": False
+                        }
+                    },
+                    "required": ["start_date", "end_date"]
+                }
+            ),
             Tool(
                 name="list_catalog",
                 description="List services from the catalog. Returns service information including name, description, team, and links.",
@@ -344,10 +407,20 @@ async def main():
                     end_date=arguments.get("end_date"),
                     environment=arguments.get("environment"),
                     impact=arguments.get("impact"),
-                    slack_id=arguments.get("slack_id")
+                    slack_id=arguments.get("slack_id"),
+                    query=arguments.get("query"),
+                    filter=arguments.get("filter")
                 )
             elif name == "get_event":
                 result = await tracker.get_event(arguments["event_id"])
+            elif name == "get_overlaps":
+                result = await tracker.get_overlaps(
+                    start_date=arguments["start_date"],
+                    end_date=arguments["end_date"],
+                    environment=arguments.get("environment"),
+                    service=arguments.get("service"),
+                    environment_only=arguments.get("environment_only", False)
+                )
             elif name == "list_catalog":
                 result = await tracker.list_catalog(
                     per_page=arguments.get("per_page", 10),
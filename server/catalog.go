@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -9,19 +10,20 @@ import (
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
 	"github.com/bananaops/tracker/internal/config"
 	store "github.com/bananaops/tracker/internal/stores"
+	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Catalog struct {
 	v1alpha1.UnimplementedCatalogServiceServer
-	store  *store.CatalogStoreClient
+	store  store.CatalogStore
 	logger *slog.Logger
 }
 
 func NewCatalog() *Catalog {
 	return &Catalog{
 		UnimplementedCatalogServiceServer: v1alpha1.UnimplementedCatalogServiceServer{},
-		store:                             store.NewStoreCatalog(config.ConfigDatabase.CatalogCollection),
+		store:                             store.NewCatalogStore(config.ConfigDatabase.CatalogCollection),
 		logger:                            slog.New(slog.NewJSONHandler(os.Stdout, nil)),
 	}
 }
@@ -41,9 +43,56 @@ func (e *Catalog) CreateUpdateCatalog(
 	if i.Version == "" {
 		return nil, fmt.Errorf("version is required")
 	}
+	expected, err := expectedRevision(ctx, i.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recommencer si l'entrée change entre sa lecture et son écriture
+	var catalogResult *v1alpha1.CreateUpdateCatalogResponse
+	var logMessage string
+	err = retryOnConflict(expected, func() (err error) {
+		catalogResult, logMessage, err = e.saveCatalog(ctx, i, expected)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// log catalog to json format
+	e.logger.Info(logMessage,
+		"name", catalogResult.Catalog.Name,
+		"type", catalogResult.Catalog.Type.String(),
+		"languages", catalogResult.Catalog.Languages.String(),
+		"owner", catalogResult.Catalog.Owner,
+		"version", catalogResult.Catalog.Version,
+		"link", catalogResult.Catalog.Link,
+		"description", catalogResult.Catalog.Description,
+		"repository", catalogResult.Catalog.Repository,
+		"dependencies_in", catalogResult.Catalog.DependenciesIn,
+		"dependencies_out", catalogResult.Catalog.DependenciesOut,
+		"sla", catalogResult.Catalog.Sla,
+		"platform", catalogResult.Catalog.Platform.String(),
+		"created_at", catalogResult.Catalog.CreatedAt.AsTime(),
+		"updated_at", catalogResult.Catalog.UpdatedAt.AsTime(),
+	)
+
+	return catalogResult, nil
+}
+
+// saveCatalog creates or updates the entry of i, provided it is at the expected
+// revision, and returns it with the message to log
+func (e *Catalog) saveCatalog(
+	ctx context.Context,
+	i *v1alpha1.CreateUpdateCatalogRequest,
+	expected int64,
+) (*v1alpha1.CreateUpdateCatalogResponse, string, error) {
 
 	// Get existing catalog to preserve version fields if they exist
 	existingCatalog, _ := e.store.Get(ctx, map[string]interface{}{"name": i.Name})
+	if err := checkRevision("catalog", i.Name, existingCatalog.GetRevision(), expected); err != nil {
+		return nil, "", err
+	}
 
 	var catalog = &v1alpha1.Catalog{
 		Name:                    i.Name,
@@ -63,6 +112,7 @@ func (e *Catalog) CreateUpdateCatalog(
 		DashboardLinks:          i.DashboardLinks,
 		VulnerabilitySummary:    i.VulnerabilitySummary,
 		InfrastructureResources: i.InfrastructureResources,
+		Revision:                existingCatalog.GetRevision(),
 	}
 
 	// Preserve existing version fields if updating
@@ -87,30 +137,15 @@ func (e *Catalog) CreateUpdateCatalog(
 	}
 
 	catalogResult.Catalog, err = e.store.Update(ctx, map[string]interface{}{"name": i.Name}, catalog)
+	if errors.Is(err, store.ErrRevisionConflict) {
+		return nil, "", revisionError(err, "catalog", i.Name)
+	}
 	if err != nil {
 		e.logger.Error("failed to update catalog", "error", err, "name", i.Name)
-		return nil, fmt.Errorf("failed to update catalog %s: %w", i.Name, err)
+		return nil, "", fmt.Errorf("failed to update catalog %s: %w", i.Name, err)
 	}
 
-	// log catalog to json format
-	e.logger.Info(logMessage,
-		"name", catalogResult.Catalog.Name,
-		"type", catalogResult.Catalog.Type.String(),
-		"languages", catalogResult.Catalog.Languages.String(),
-		"owner", catalogResult.Catalog.Owner,
-		"version", catalogResult.Catalog.Version,
-		"link", catalogResult.Catalog.Link,
-		"description", catalogResult.Catalog.Description,
-		"repository", catalogResult.Catalog.Repository,
-		"dependencies_in", catalogResult.Catalog.DependenciesIn,
-		"dependencies_out", catalogResult.Catalog.DependenciesOut,
-		"sla", catalogResult.Catalog.Sla,
-		"platform", catalogResult.Catalog.Platform.String(),
-		"created_at", catalogResult.Catalog.CreatedAt.AsTime(),
-		"updated_at", catalogResult.Catalog.UpdatedAt.AsTime(),
-	)
-
-	return catalogResult, nil
+	return catalogResult, logMessage, nil
 }
 
 func (e *Catalog) GetCatalog(
@@ -133,14 +168,26 @@ func (e *Catalog) ListCatalogs(
 	i *v1alpha1.ListCatalogsRequest,
 ) (*v1alpha1.ListCatalogsResponse, error) {
 
+	p, err := newPagination(i.PerPage, i.Page, i.Sort, i.PageToken, catalogSortFields)
+	if err != nil {
+		return nil, err
+	}
+
 	var catalogsResult = &v1alpha1.ListCatalogsResponse{}
-	var err error
+	catalogs, err := e.store.Find(ctx, p.filter(bson.D{}), p.options())
+	if err != nil {
+		return nil, err
+	}
+	catalogsResult.Catalogs, catalogsResult.NextPageToken, err = paginate(p, catalogs)
+	if err != nil {
+		return nil, err
+	}
 
-	catalogsResult.Catalogs, err = e.store.List(context.Background())
+	count, err := e.store.Count(ctx, bson.D{})
 	if err != nil {
 		return nil, err
 	}
-	catalogsResult.TotalCount = uint32(len(catalogsResult.Catalogs))
+	catalogsResult.TotalCount = uint32(count)
 
 	return catalogsResult, nil
 }
@@ -312,24 +359,42 @@ func (e *Catalog) UpdateVersions(
 		"reference_version", i.ReferenceVersion,
 	)
 
-	// Get existing catalog
-	existingCatalog, err := e.store.Get(ctx, map[string]interface{}{"name": i.Name})
+	expected, err := expectedRevision(ctx, i.Revision)
 	if err != nil {
-		e.logger.Error("failed to get catalog for version update", "error", err, "name", i.Name)
-		return nil, fmt.Errorf("catalog %s not found: %w", i.Name, err)
+		return nil, err
 	}
 
-	// Update only version fields
-	existingCatalog.AvailableVersions = i.AvailableVersions
-	existingCatalog.LatestVersion = i.LatestVersion
-	existingCatalog.ReferenceVersion = i.ReferenceVersion
-	existingCatalog.UpdatedAt = timestamppb.Now()
+	var updatedCatalog *v1alpha1.Catalog
+	err = retryOnConflict(expected, func() error {
+		// Get existing catalog
+		existingCatalog, err := e.store.Get(ctx, map[string]interface{}{"name": i.Name})
+		if err != nil {
+			e.logger.Error("failed to get catalog for version update", "error", err, "name", i.Name)
+			return fmt.Errorf("catalog %s not found: %w", i.Name, err)
+		}
+		if err := checkRevision("catalog", i.Name, existingCatalog.Revision, expected); err != nil {
+			return err
+		}
 
-	// Save updated catalog
-	updatedCatalog, err := e.store.Update(ctx, map[string]interface{}{"name": i.Name}, existingCatalog)
+		// Update only version fields
+		existingCatalog.AvailableVersions = i.AvailableVersions
+		existingCatalog.LatestVersion = i.LatestVersion
+		existingCatalog.ReferenceVersion = i.ReferenceVersion
+		existingCatalog.UpdatedAt = timestamppb.Now()
+
+		// Save updated catalog
+		updatedCatalog, err = e.store.Update(ctx, map[string]interface{}{"name": i.Name}, existingCatalog)
+		if errors.Is(err, store.ErrRevisionConflict) {
+			return revisionError(err, "catalog", i.Name)
+		}
+		if err != nil {
+			e.logger.Error("failed to update catalog versions", "error", err, "name", i.Name)
+			return fmt.Errorf("failed to update versions for catalog %s: %w", i.Name, err)
+		}
+		return nil
+	})
 	if err != nil {
-		e.logger.Error("failed to update catalog versions", "error", err, "name", i.Name)
-		return nil, fmt.Errorf("failed to update versions for catalog %s: %w", i.Name, err)
+		return nil, err
 	}
 
 	e.logger.Info("✅ Successfully updated versions",
@@ -354,11 +419,9 @@ func (e *Catalog) UpdateDependencies(
 		return nil, fmt.Errorf("name is required")
 	}
 
-	// Get existing catalog
-	existingCatalog, err := e.store.Get(ctx, map[string]interface{}{"name": i.Name})
+	expected, err := expectedRevision(ctx, i.Revision)
 	if err != nil {
-		e.logger.Error("catalog not found", "error", err, "name", i.Name)
-		return nil, fmt.Errorf("catalog %s not found: %w", i.Name, err)
+		return nil, err
 	}
 
 	e.logger.Info("📦 Updating dependencies",
@@ -367,16 +430,36 @@ func (e *Catalog) UpdateDependencies(
 		"dependencies_out_count", len(i.DependenciesOut),
 	)
 
-	// Update only dependency fields
-	existingCatalog.DependenciesIn = i.DependenciesIn
-	existingCatalog.DependenciesOut = i.DependenciesOut
-	existingCatalog.UpdatedAt = timestamppb.Now()
+	var updatedCatalog *v1alpha1.Catalog
+	err = retryOnConflict(expected, func() error {
+		// Get existing catalog
+		existingCatalog, err := e.store.Get(ctx, map[string]interface{}{"name": i.Name})
+		if err != nil {
+			e.logger.Error("catalog not found", "error", err, "name", i.Name)
+			return fmt.Errorf("catalog %s not found: %w", i.Name, err)
+		}
+		if err := checkRevision("catalog", i.Name, existingCatalog.Revision, expected); err != nil {
+			return err
+		}
 
-	// Save updated catalog
-	updatedCatalog, err := e.store.Update(ctx, map[string]interface{}{"name": i.Name}, existingCatalog)
+		// Update only dependency fields
+		existingCatalog.DependenciesIn = i.DependenciesIn
+		existingCatalog.DependenciesOut = i.DependenciesOut
+		existingCatalog.UpdatedAt = timestamppb.Now()
+
+		// Save updated catalog
+		updatedCatalog, err = e.store.Update(ctx, map[string]interface{}{"name": i.Name}, existingCatalog)
+		if errors.Is(err, store.ErrRevisionConflict) {
+			return revisionError(err, "catalog", i.Name)
+		}
+		if err != nil {
+			e.logger.Error("failed to update catalog dependencies", "error", err, "name", i.Name)
+			return fmt.Errorf("failed to update dependencies for catalog %s: %w", i.Name, err)
+		}
+		return nil
+	})
 	if err != nil {
-		e.logger.Error("failed to update catalog dependencies", "error", err, "name", i.Name)
-		return nil, fmt.Errorf("failed to update dependencies for catalog %s: %w", i.Name, err)
+		return nil, err
 	}
 
 	e.logger.Info("✅ Successfully updated dependencies",
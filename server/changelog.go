@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// linkComments are the comments of the changelog entries of a new link
+var linkComments = map[string]string{
+	"ticket":            "Jira ticket linked",
+	"pull_request_link": "Pull request linked",
+}
+
+// fieldChange is a field modified by an update, with its values as written
+// in the changelog
+type fieldChange struct {
+	field    protoreflect.FieldDescriptor
+	path     string
+	oldValue string
+	newValue string
+}
+
+// diffEvent returns the fields of the title, attributes and links of event
+// that differ from previous
+func diffEvent(previous *v1alpha1.Event, event *v1alpha1.Event) []fieldChange {
+	scratch := proto.Clone(previous).(*v1alpha1.Event)
+	var changes []fieldChange
+	for _, field := range maskableFields {
+		changes = patchField(scratch.ProtoReflect(), event.ProtoReflect(), []string{field}, "", changes)
+	}
+	return changes
+}
+
+// patchField sets the field at path of dst to its value in src, returning the
+// fields it modifies. A message field is patched field by field, down to its
+// scalar, repeated and timestamp fields.
+func patchField(dst protoreflect.Message, src protoreflect.Message, path []string, prefix string, changes []fieldChange) []fieldChange {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	name := prefix + path[0]
+	if len(path) > 1 {
+		return patchField(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:], name+".", changes)
+	}
+	if patchedByField(fd) {
+		sub, from := dst.Mutable(fd).Message(), src.Get(fd).Message()
+		fields := fd.Message().Fields()
+		for j := range fields.Len() {
+			changes = patchField(sub, from, []string{string(fields.Get(j).Name())}, name+".", changes)
+		}
+		return changes
+	}
+
+	old, updated := dst.Get(fd), src.Get(fd)
+	if old.Equal(updated) {
+		return changes
+	}
+	changes = append(changes, fieldChange{field: fd, path: name, oldValue: valueText(fd, old), newValue: valueText(fd, updated)})
+	if src.Has(fd) {
+		dst.Set(fd, updated)
+	} else {
+		dst.Clear(fd)
+	}
+	return changes
+}
+
+// patchedByField tells whether a field holds a message of the event, rather than a well-known type
+func patchedByField(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() &&
+		!strings.HasPrefix(string(fd.Message().FullName()), "google.protobuf.")
+}
+
+// addFieldChanges adds a changelog entry for each change: approved when the
+// owner alone changed, status_changed for the status, linked for a new link
+// and updated for the other fields
+func addFieldChanges(event *v1alpha1.Event, user string, changes []fieldChange) {
+	for _, change := range changes {
+		name := string(change.field.Name())
+		label := strings.ReplaceAll(name, "_", " ")
+		changeType, comment := v1alpha1.ChangeType_updated, strings.ToUpper(label[:1])+label[1:]+" updated"
+		switch {
+		case change.path == "attributes.owner" && len(changes) == 1:
+			changeType, comment = v1alpha1.ChangeType_approved, fmt.Sprintf("Event approved by %s", user)
+		case change.path == "attributes.status":
+			changeType = v1alpha1.ChangeType_status_changed
+		case linkComments[name] != "" && change.newValue != "":
+			changeType, comment = v1alpha1.ChangeType_linked, linkComments[name]
+		}
+		addChangelogEntry(event, changeType, user, name, change.oldValue, change.newValue, comment)
+	}
+}
+
+// valueText formats the value of a field for the changelog, so that
+// setValueText can restore it: enums by name, timestamps in RFC 3339 and
+// repeated fields as a JSON array
+func valueText(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if !fd.IsList() {
+		return scalarText(fd, value)
+	}
+	list := value.List()
+	if list.Len() == 0 {
+		return ""
+	}
+	values := make([]string, list.Len())
+	for j := range list.Len() {
+		values[j] = scalarText(fd, list.Get(j))
+	}
+	text, _ := json.Marshal(values)
+	return string(text)
+}
+
+func scalarText(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if enum := fd.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+		return fmt.Sprint(value.Enum())
+	case protoreflect.MessageKind:
+		message := value.Message()
+		if !message.IsValid() {
+			return ""
+		}
+		if timestamp, ok := message.Interface().(*timestamppb.Timestamp); ok {
+			return timestamp.AsTime().Format(time.RFC3339Nano)
+		}
+		text, _ := protojson.Marshal(message.Interface())
+		return string(text)
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// setValueText sets the field of m to a value formatted by valueText
+func setValueText(m protoreflect.Message, fd protoreflect.FieldDescriptor, text string) error {
+	if text == "" && (fd.IsList() || fd.Kind() == protoreflect.MessageKind) {
+		m.Clear(fd)
+		return nil
+	}
+	if !fd.IsList() {
+		value, err := parseScalarText(m, fd, text)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, value)
+		return nil
+	}
+
+	var texts []string
+	if err := json.Unmarshal([]byte(text), &texts); err != nil {
+		return err
+	}
+	list := m.NewField(fd).List()
+	for _, element := range texts {
+		value, err := parseScalarText(m, fd, element)
+		if err != nil {
+			return err
+		}
+		list.Append(value)
+	}
+	m.Set(fd, protoreflect.ValueOfList(list))
+	return nil
+}
+
+func parseScalarText(m protoreflect.Message, fd protoreflect.FieldDescriptor, text string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(text), nil
+	case protoreflect.BoolKind:
+		value, err := strconv.ParseBool(text)
+		return protoreflect.ValueOfBool(value), err
+	case protoreflect.EnumKind:
+		if enum := fd.Enum().Values().ByName(protoreflect.Name(text)); enum != nil {
+			return protoreflect.ValueOfEnum(enum.Number()), nil
+		}
+		number, err := strconv.ParseInt(text, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(number)), err
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == "google.protobuf.Timestamp" {
+			date, err := time.Parse(time.RFC3339Nano, text)
+			return protoreflect.ValueOfMessage(timestamppb.New(date).ProtoReflect()), err
+		}
+		message := m.NewField(fd).Message()
+		err := protojson.Unmarshal([]byte(text), message.Interface())
+		return protoreflect.ValueOfMessage(message), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("%s cannot be restored from the changelog", fd.Name())
+}
+
+// changelogField finds the field named in a changelog entry: the title, a
+// field of the attributes or of the links, or the Slack ID
+func changelogField(event *v1alpha1.Event, name string) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	m := event.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	if name == "title" {
+		return m, fields.ByName("title")
+	}
+	for _, parent := range []protoreflect.Name{"attributes", "links"} {
+		sub := m.Mutable(fields.ByName(parent)).Message()
+		if fd := sub.Descriptor().Fields().ByName(protoreflect.Name(name)); fd != nil {
+			return sub, fd
+		}
+	}
+	if name == "slack_id" {
+		sub := m.Mutable(fields.ByName("metadata")).Message()
+		return sub, sub.Descriptor().Fields().ByName("slack_id")
+	}
+	return nil, nil
+}
+
+// eventAt undoes the changes of event made after at, from the last one, and
+// drops their changelog entries. It also returns how many of these entries
+// could not be undone.
+func eventAt(event *v1alpha1.Event, at time.Time) (*v1alpha1.Event, int) {
+	past := proto.Clone(event).(*v1alpha1.Event)
+	skipped := 0
+	for j := len(past.Changelog) - 1; j >= 0; j-- {
+		entry := past.Changelog[j]
+		if !entry.GetTimestamp().AsTime().After(at) {
+			continue
+		}
+		if entry.Field == "" {
+			continue
+		}
+		m, fd := changelogField(past, entry.Field)
+		if fd == nil || setValueText(m, fd, entry.OldValue) != nil {
+			skipped++
+		}
+	}
+
+	var changelog []*v1alpha1.ChangelogEntry
+	for _, entry := range past.Changelog {
+		if !entry.GetTimestamp().AsTime().After(at) {
+			changelog = append(changelog, entry)
+		}
+	}
+	past.Changelog = changelog
+	return past, skipped
+}
+
+func (e *Event) GetEventAt(
+	ctx context.Context,
+	i *v1alpha1.GetEventAtRequest,
+) (*v1alpha1.GetEventAtResponse, error) {
+
+	if i.At == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "at is required")
+	}
+	event, err := e.store.Get(ctx, map[string]interface{}{"metadata.id": i.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no event found in tracker for id %s", i.Id)
+	}
+	// The creation entry is written just before created_at is set
+	created := event.GetMetadata().GetCreatedAt()
+	if len(event.Changelog) > 0 && (created == nil || event.Changelog[0].GetTimestamp().AsTime().Before(created.AsTime())) {
+		created = event.Changelog[0].GetTimestamp()
+	}
+	if created != nil && i.At.AsTime().Before(created.AsTime()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "event %s was created at %s, after %s", i.Id,
+			created.AsTime().Format(time.RFC3339Nano), i.At.AsTime().Format(time.RFC3339Nano))
+	}
+
+	past, skipped := eventAt(event, i.At.AsTime())
+	return &v1alpha1.GetEventAtResponse{Event: past, SkippedEntries: uint32(skipped)}, nil
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/bananaops/tracker/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// doraGroup is the key of the metrics of a group, its fields are only set
+// when grouped by
+type doraGroup struct {
+	service     string
+	team        string
+	environment v1alpha1.Environment
+}
+
+// doraSample gathers the events of a group the metrics are computed from
+type doraSample struct {
+	deployments    uint64
+	failed         uint64
+	leadTimes      []time.Duration
+	restored       uint64
+	timesToRestore []time.Duration
+}
+
+// GetDoraMetrics computes the DORA metrics from the deployments and incidents
+// created in the period. A deployment is counted when it ends: an event of
+// type deployment with the status success or failure, whose duration is its
+// lead time. It failed with the status failure, or when an incident is related
+// to it or to its start. An incident is restored by an event of type incident
+// with the status close, done or success, whose duration is its time to restore.
+func (e *Event) GetDoraMetrics(
+	ctx context.Context,
+	i *v1alpha1.GetDoraMetricsRequest,
+) (*v1alpha1.GetDoraMetricsResponse, error) {
+
+	statsFilter, start, end, err := newStatsFilter(i)
+	if err != nil {
+		return nil, err
+	}
+	statsFilter.Types = []int32{int32(v1alpha1.Type_deployment), int32(v1alpha1.Type_incident)}
+	filter, err := utils.CreateStatsFilter(statsFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	events, err := e.store.Find(ctx, filter, store.FindOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find events: %w", err)
+	}
+
+	// ids of the deployments, and of their start, incidents are related to
+	incidentRelated := map[string]bool{}
+	for _, event := range events {
+		if event.Attributes.Type == v1alpha1.Type_incident && event.Attributes.RelatedId != "" {
+			incidentRelated[event.Attributes.RelatedId] = true
+		}
+	}
+
+	owner := e.ownerLookup(ctx)
+	groupOf := func(event *v1alpha1.Event) doraGroup {
+		var group doraGroup
+		if i.GroupByService {
+			group.service = event.Attributes.Service
+		}
+		if i.GroupByTeam {
+			group.team = owner(event.Attributes.Service)
+		}
+		if i.GroupByEnvironment {
+			group.environment = event.Attributes.Environment
+		}
+		return group
+	}
+
+	samples := map[doraGroup]*doraSample{}
+	sampleOf := func(event *v1alpha1.Event) *doraSample {
+		group := groupOf(event)
+		if samples[group] == nil {
+			samples[group] = &doraSample{}
+		}
+		return samples[group]
+	}
+
+	for _, event := range events {
+		switch {
+		case event.Attributes.Type == v1alpha1.Type_deployment && isDeploymentEnd(event.Attributes.Status):
+			sample := sampleOf(event)
+			sample.deployments++
+			if event.Attributes.Status == v1alpha1.Status_failure ||
+				incidentRelated[event.Metadata.Id] ||
+				(event.Attributes.RelatedId != "" && incidentRelated[event.Attributes.RelatedId]) {
+				sample.failed++
+			} else if event.Metadata.Duration != nil {
+				sample.leadTimes = append(sample.leadTimes, event.Metadata.Duration.AsDuration())
+			}
+		case event.Attributes.Type == v1alpha1.Type_incident && isIncidentResolution(event.Attributes.Status):
+			sample := sampleOf(event)
+			sample.restored++
+			if event.Metadata.Duration != nil {
+				sample.timesToRestore = append(sample.timesToRestore, event.Metadata.Duration.AsDuration())
+			}
+		}
+	}
+
+	// a day at least, the end date of a period of a single day being its start
+	days := end.Sub(start).Hours() / 24
+	if days < 1 {
+		days = 1
+	}
+
+	metrics := make([]*v1alpha1.DoraMetrics, 0, len(samples))
+	for group, sample := range samples {
+		entry := &v1alpha1.DoraMetrics{
+			Service:             group.service,
+			Team:                group.team,
+			Environment:         group.environment,
+			Deployments:         sample.deployments,
+			DeploymentFrequency: float64(sample.deployments) / days,
+			FailedDeployments:   sample.failed,
+			Incidents:           sample.restored,
+		}
+		if sample.deployments > 0 {
+			entry.ChangeFailureRate = float64(sample.failed) / float64(sample.deployments)
+		}
+		if len(sample.leadTimes) > 0 {
+			entry.LeadTime = durationpb.New(median(sample.leadTimes))
+		}
+		if len(sample.timesToRestore) > 0 {
+			entry.TimeToRestore = durationpb.New(median(sample.timesToRestore))
+		}
+		metrics = append(metrics, entry)
+	}
+	sort.Slice(metrics, func(a, b int) bool {
+		if metrics[a].Service != metrics[b].Service {
+			return metrics[a].Service < metrics[b].Service
+		}
+		if metrics[a].Team != metrics[b].Team {
+			return metrics[a].Team < metrics[b].Team
+		}
+		return metrics[a].Environment < metrics[b].Environment
+	})
+
+	e.logger.Info("dora metrics retrieved",
+		"start_date", i.StartDate,
+		"end_date", i.EndDate,
+		"events", len(events),
+		"groups", len(metrics),
+	)
+
+	return &v1alpha1.GetDoraMetricsResponse{
+		Metrics:   metrics,
+		StartDate: i.StartDate,
+		EndDate:   i.EndDate,
+	}, nil
+}
+
+func isDeploymentEnd(s v1alpha1.Status) bool {
+	return s == v1alpha1.Status_success || s == v1alpha1.Status_failure
+}
+
+func isIncidentResolution(s v1alpha1.Status) bool {
+	return s == v1alpha1.Status_close || s == v1alpha1.Status_done || s == v1alpha1.Status_success
+}
+
+// median returns the middle of durations, the mean of both middles when even
+func median(durations []time.Duration) time.Duration {
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
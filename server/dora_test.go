@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+)
+
+func TestGetDoraMetrics(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	now := time.Now().UTC()
+	from, to := now.AddDate(0, 0, -1).Format("2006-01-02"), now.AddDate(0, 0, 1).Format("2006-01-02")
+
+	record := func(eventType v1alpha1.Type, s v1alpha1.Status, service string, environment v1alpha1.Environment, relatedId string, duration time.Duration) *v1alpha1.Event {
+		metadata := &v1alpha1.EventMetadata{}
+		if duration > 0 {
+			metadata.Duration = durationpb.New(duration)
+		}
+		event, err := e.store.Create(ctx, &v1alpha1.Event{
+			Title:      service,
+			Attributes: &v1alpha1.EventAttributes{Type: eventType, Status: s, Service: service, Environment: environment, RelatedId: relatedId},
+			Metadata:   metadata,
+		})
+		assert.NoError(t, err)
+		return event
+	}
+	started := record(v1alpha1.Type_deployment, v1alpha1.Status_start, "payments", v1alpha1.Environment_production, "", 0)
+	record(v1alpha1.Type_deployment, v1alpha1.Status_success, "payments", v1alpha1.Environment_production, started.Metadata.Id, 10*time.Minute)
+	record(v1alpha1.Type_deployment, v1alpha1.Status_success, "payments", v1alpha1.Environment_production, "", 20*time.Minute)
+	record(v1alpha1.Type_deployment, v1alpha1.Status_success, "payments", v1alpha1.Environment_UAT, "", 30*time.Minute)
+	record(v1alpha1.Type_deployment, v1alpha1.Status_failure, "billing", v1alpha1.Environment_production, "", 5*time.Minute)
+	opened := record(v1alpha1.Type_incident, v1alpha1.Status_open, "payments", v1alpha1.Environment_production, started.Metadata.Id, 0)
+	record(v1alpha1.Type_incident, v1alpha1.Status_close, "payments", v1alpha1.Environment_production, opened.Metadata.Id, time.Hour)
+
+	_, err := e.catalogStore.Update(ctx, map[string]interface{}{"name": "payments"}, &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-payments"})
+	assert.NoError(t, err)
+
+	dora, err := e.GetDoraMetrics(ctx, &v1alpha1.GetDoraMetricsRequest{StartDate: from, EndDate: to})
+	assert.NoError(t, err)
+	if assert.Len(t, dora.Metrics, 1) {
+		overall := dora.Metrics[0]
+		assert.Equal(t, uint64(4), overall.Deployments)
+		assert.Equal(t, 2.0, overall.DeploymentFrequency, "4 deployments in 2 days")
+		assert.Equal(t, uint64(2), overall.FailedDeployments, "a failure and a deployment related to an incident")
+		assert.Equal(t, 0.5, overall.ChangeFailureRate)
+		assert.Equal(t, 25*time.Minute, overall.LeadTime.AsDuration(), "median of the successful deployments")
+		assert.Equal(t, uint64(1), overall.Incidents)
+		assert.Equal(t, time.Hour, overall.TimeToRestore.AsDuration())
+	}
+
+	dora, err = e.GetDoraMetrics(ctx, &v1alpha1.GetDoraMetricsRequest{StartDate: from, EndDate: to, GroupByTeam: true, GroupByEnvironment: true,
+		Environments: []v1alpha1.Environment{v1alpha1.Environment_production}})
+	assert.NoError(t, err)
+	if assert.Len(t, dora.Metrics, 2) {
+		assert.Equal(t, "", dora.Metrics[0].Team, "billing is not in the catalog")
+		assert.Equal(t, 1.0, dora.Metrics[0].ChangeFailureRate)
+		assert.Nil(t, dora.Metrics[0].LeadTime)
+		assert.Equal(t, "team-payments", dora.Metrics[1].Team)
+		assert.Equal(t, v1alpha1.Environment_production, dora.Metrics[1].Environment)
+		assert.Equal(t, uint64(2), dora.Metrics[1].Deployments)
+		assert.Equal(t, 20*time.Minute, dora.Metrics[1].LeadTime.AsDuration())
+	}
+
+	_, err = e.GetDoraMetrics(ctx, &v1alpha1.GetDoraMetricsRequest{StartDate: to, EndDate: from})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
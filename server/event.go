@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,8 +16,12 @@ import (
 	store "github.com/bananaops/tracker/internal/stores"
 	"github.com/bananaops/tracker/internal/utils"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -39,17 +45,28 @@ var (
 
 type Event struct {
 	v1alpha1.UnimplementedEventServiceServer
-	store       *store.EventStoreClient
-	lockService *Lock
-	logger      *slog.Logger
+	store         store.EventStore
+	catalogStore  store.CatalogStore
+	lockService   *Lock
+	freezeService *Freeze
+	logger        *slog.Logger
+	changes       *changeFeed[*v1alpha1.WatchEventsResponse]
+	idempotency   store.IdempotencyStore
+	// idempotencyKeys serializes the creations of a key on this instance, so
+	// that a retry waits for the request it repeats
+	idempotencyKeys keyMutex[string]
 }
 
 func NewEvent() *Event {
 	return &Event{
 		UnimplementedEventServiceServer: v1alpha1.UnimplementedEventServiceServer{},
-		store:                           store.NewStoreEvent(config.ConfigDatabase.EventCollection),
+		store:                           store.NewEventStore(config.ConfigDatabase.EventCollection),
+		catalogStore:                    store.NewCatalogStore(config.ConfigDatabase.CatalogCollection),
 		lockService:                     NewLock(),
+		freezeService:                   NewFreeze(),
 		logger:                          slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+		changes:                         eventChanges,
+		idempotency:                     store.NewIdempotencyStore(config.ConfigDatabase.IdempotencyCollection),
 	}
 }
 
@@ -86,15 +103,17 @@ func shouldReleaseLock(eventType v1alpha1.Type, status v1alpha1.Status) bool {
 		(status == v1alpha1.Status_success || status == v1alpha1.Status_failure || status == v1alpha1.Status_done)
 }
 
-// getResourceType retourne le type de ressource pour le lock
-func getResourceType(eventType v1alpha1.Type) string {
+// getResourceType retourne le type de ressource et le mode du lock.
+// Les opérations partagent la ressource deployment : elles tournent en parallèle
+// entre elles mais bloquent un déploiement, qui lui est exclusif.
+func getResourceType(eventType v1alpha1.Type) (string, lock.LockMode) {
 	switch eventType {
 	case v1alpha1.Type_deployment:
-		return "deployment"
+		return "deployment", lock.LockMode_exclusive
 	case v1alpha1.Type_operation:
-		return "operation"
+		return "deployment", lock.LockMode_shared
 	default:
-		return "unknown"
+		return "unknown", lock.LockMode_exclusive
 	}
 }
 
@@ -121,6 +140,7 @@ func (e *Event) CreateEvent(
 			StakeHolders:  i.Attributes.StakeHolders,
 			Notification:  i.Attributes.Notification,
 			Notifications: i.Attributes.Notifications,
+			ReleaseId:     i.Attributes.ReleaseId,
 		},
 		Links: &v1alpha1.EventLinks{
 			PullRequestLink: i.Links.PullRequestLink,
@@ -131,6 +151,29 @@ func (e *Event) CreateEvent(
 		},
 	}
 
+	// Une requête rejouée avec la même clé renvoie l'événement déjà créé
+	key, err := idempotencyKey(ctx, i.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	var idempotencyRecord *store.Idempotency
+	if key != "" {
+		defer e.idempotencyKeys.lock(key)()
+		hash, err := requestHash(i)
+		if err != nil {
+			return nil, err
+		}
+		var created *v1alpha1.CreateEventResponse
+		idempotencyRecord, created, err = e.reserveIdempotencyKey(ctx, key, hash)
+		if err != nil || created != nil {
+			return created, err
+		}
+		// une création qui échoue libère la clé pour la prochaine tentative
+		defer e.releaseIdempotencyKey(idempotencyRecord)
+		event.Metadata.IdempotencyKey = key
+		event.Metadata.RequestHash = hash
+	}
+
 	if event.Attributes.RelatedId != "" {
 		// check attributes.relatedId is present
 		relatedEvent, err := e.store.Get(context.Background(), map[string]interface{}{"metadata.id": &i.Attributes.RelatedId})
@@ -154,29 +197,102 @@ func (e *Event) CreateEvent(
 	}
 	addChangelogEntry(event, v1alpha1.ChangeType_created, user, "", "", "", "Event created")
 
+	// Pendant un gel, les déploiements qui démarrent sont refusés sauf exception
+	// approuvée. La fin d'un déploiement commencé avant le gel passe.
+	if i.Attributes.Type == v1alpha1.Type_deployment && shouldCreateLock(i.Attributes.Type, i.Attributes.Status) {
+		frozen, exception, err := e.freezeService.blockingFreeze(ctx, i.Attributes.Environment.String(), i.Attributes.Service, i.FreezeExceptionId)
+		if err != nil {
+			return nil, err
+		}
+		if frozen != nil {
+			e.logger.Warn("event refused during freeze",
+				"service", i.Attributes.Service,
+				"environment", i.Attributes.Environment.String(),
+				"freeze_id", frozen.Freeze.Id,
+			)
+			return nil, frozenStatus(frozen, i.Attributes.Service)
+		}
+		if exception != nil {
+			addChangelogEntry(event, v1alpha1.ChangeType_commented, user, "", "", "",
+				fmt.Sprintf("Deployed during a freeze by exception %s approved by %s", exception.Id, exception.ApprovedBy))
+		}
+	}
+
 	// Vérifier et créer un lock si nécessaire AVANT de créer l'événement
+	var createdLock *lock.CreateLockResponse
 	if shouldCreateLock(i.Attributes.Type, i.Attributes.Status) {
+		resource, mode := getResourceType(i.Attributes.Type)
 		lockReq := &lock.CreateLockRequest{
 			Service:     i.Attributes.Service,
 			Who:         user,
 			Environment: i.Attributes.Environment.String(),
-			Resource:    getResourceType(i.Attributes.Type),
+			Resource:    resource,
 			EventId:     "", // Sera mis à jour après la création de l'événement
+			Ttl:         i.LockTtl,
+			Mode:        mode,
 		}
 
-		_, err := e.lockService.CreateLock(ctx, lockReq)
+		// Pendant la réservation d'une autre équipe, l'environnement lui est réservé
+		envReservation, err := e.lockService.activeReservation(ctx, lockReq.Environment, lockReq.Service)
+		if err != nil {
+			return nil, err
+		}
+		if envReservation != nil && envReservation.Owner != i.Attributes.Owner {
+			e.logger.Warn("event refused during reservation",
+				"service", i.Attributes.Service,
+				"environment", lockReq.Environment,
+				"owner", i.Attributes.Owner,
+				"reservation_id", envReservation.Id,
+				"reserved_by", envReservation.Owner,
+			)
+			return nil, reservedStatus(envReservation, lockReq.Service)
+		}
+
+		// Les événements d'une release partagent les locks de son bundle,
+		// ceux de l'équipe qui a réservé l'environnement le lock de sa réservation
+		bundleLock, err := e.lockService.releaseLock(ctx, i.Attributes.ReleaseId, &lock.Lock{
+			Service:     lockReq.Service,
+			Environment: lockReq.Environment,
+			Resource:    lockReq.Resource,
+			Mode:        lockReq.Mode,
+		})
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case bundleLock != nil:
+			addChangelogEntry(event, v1alpha1.ChangeType_locked, user, "", "", "",
+				fmt.Sprintf("Service locked in %s by bundle %s of release %s", lockReq.Environment, bundleLock.BundleId, i.Attributes.ReleaseId))
+		case envReservation != nil && e.lockService.reservationLock(ctx, envReservation) != nil:
+			addChangelogEntry(event, v1alpha1.ChangeType_locked, user, "", "", "",
+				fmt.Sprintf("Service locked in %s by reservation %s of %s", lockReq.Environment, envReservation.Id, envReservation.Owner))
+		default:
+			createdLock, err = e.lockService.CreateLock(ctx, lockReq)
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
 		if err != nil {
 			e.logger.Error("failed to create lock",
 				"service", i.Attributes.Service,
 				"environment", i.Attributes.Environment.String(),
-				"resource", getResourceType(i.Attributes.Type),
+				"resource", resource,
 				"error", err,
 			)
 
 			// Améliorer le message d'erreur pour être plus explicite
-			if strings.Contains(err.Error(), "already locked") {
-				return nil, fmt.Errorf("cannot create event: service %s is already locked in %s. Please unlock it first",
+			if st, ok := status.FromError(err); ok && st.Code() == codes.AlreadyExists {
+				// keep the holder sent as detail by CreateLock
+				locked := st.Proto()
+				locked.Message = fmt.Sprintf("cannot create event: service %s is already locked in %s. Please unlock it first",
 					i.Attributes.Service, i.Attributes.Environment.String())
+				for _, detail := range st.Details() {
+					if holder, ok := detail.(*lock.Lock); ok && isLockScope(holder) {
+						locked.Message = fmt.Sprintf("cannot create event: service %s in %s is covered by lock %s on service %s, resource %s, held by %s. Please unlock it first",
+							i.Attributes.Service, i.Attributes.Environment.String(), holder.Id, holder.Service, holder.Resource, holder.Who)
+					}
+				}
+				return nil, status.FromProto(locked).Err()
 			}
 
 			return nil, fmt.Errorf("cannot create event: failed to create lock - %v", err)
@@ -184,49 +300,49 @@ func (e *Event) CreateEvent(
 	}
 
 	var eventResult = &v1alpha1.CreateEventResponse{}
-	var err error
 	eventResult.Event, err = e.store.Create(context.Background(), event)
 	if err != nil {
+		// sans événement, le lock pris pour lui bloquerait la prochaine tentative
+		if createdLock != nil {
+			if _, errUnlock := e.lockService.unlock(context.WithoutCancel(ctx), createdLock.Lock, lock.LockHistoryAction_released, user, "event not created", ""); errUnlock != nil {
+				e.logger.Warn("failed to release the lock of an event not created",
+					"lock_id", createdLock.Lock.Id,
+					"service", i.Attributes.Service,
+					"error", errUnlock,
+				)
+			}
+		}
 		return nil, err
 	}
+	if idempotencyRecord != nil {
+		e.completeIdempotencyKey(ctx, idempotencyRecord, eventResult.Event.Metadata.Id)
+	}
+	publishEvent(e.changes, v1alpha1.EventChangeKind_event_created, eventResult.Event, nil)
 
 	// Mettre à jour le lock avec l'event_id
-	if shouldCreateLock(i.Attributes.Type, i.Attributes.Status) {
-		// Récupérer le lock correspondant et mettre à jour son event_id
-		filter := map[string]interface{}{
-			"service":     i.Attributes.Service,
-			"environment": i.Attributes.Environment.String(),
-			"resource":    getResourceType(i.Attributes.Type),
-		}
-
-		existingLock, err2 := e.lockService.store.Get(ctx, filter)
-		if err2 == nil && existingLock != nil && existingLock.Id != "" {
-			_, errUpd := e.lockService.UpdateLock(ctx, &lock.UpdateLockRequest{
-				Id:      existingLock.Id,
-				EventId: eventResult.Event.Metadata.Id,
-			})
-			if errUpd != nil {
-				e.logger.Warn("failed to update lock with event_id",
-					"lock_id", existingLock.Id,
-					"event_id", eventResult.Event.Metadata.Id,
-					"service", i.Attributes.Service,
-					"environment", i.Attributes.Environment.String(),
-					"error", errUpd,
-				)
-			} else {
-				e.logger.Info("lock updated with event_id",
-					"lock_id", existingLock.Id,
-					"event_id", eventResult.Event.Metadata.Id,
-					"service", i.Attributes.Service,
-					"environment", i.Attributes.Environment.String(),
-				)
-			}
+	if createdLock != nil {
+		existingLock := createdLock.Lock
+		eventResult.Lock = existingLock
+		_, errUpd := e.lockService.UpdateLock(ctx, &lock.UpdateLockRequest{
+			Id:      existingLock.Id,
+			Who:     existingLock.Who,
+			EventId: eventResult.Event.Metadata.Id,
+		})
+		if errUpd != nil {
+			e.logger.Warn("failed to update lock with event_id",
+				"lock_id", existingLock.Id,
+				"event_id", eventResult.Event.Metadata.Id,
+				"service", i.Attributes.Service,
+				"environment", i.Attributes.Environment.String(),
+				"error", errUpd,
+			)
 		} else {
-			e.logger.Warn("lock not found for event to update",
+			existingLock.EventId = eventResult.Event.Metadata.Id
+			e.logger.Info("lock updated with event_id",
+				"lock_id", existingLock.Id,
+				"event_id", eventResult.Event.Metadata.Id,
 				"service", i.Attributes.Service,
 				"environment", i.Attributes.Environment.String(),
-				"resource", getResourceType(i.Attributes.Type),
-				"error", err2,
 			)
 		}
 	}
@@ -279,16 +395,71 @@ func (e *Event) SearchEvents(
 ) (*v1alpha1.SearchEventsResponse, error) {
 
 	filter, err := utils.CreateFilter(i)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if i.Q != "" {
+		return e.searchText(ctx, i, filter)
+	}
+
+	p, err := newPagination(i.PerPage, i.Page, i.Sort, i.PageToken, eventSortFields)
 	if err != nil {
 		return nil, err
 	}
 
 	var eventsResult = &v1alpha1.SearchEventsResponse{}
-	eventsResult.Events, err = e.store.Search(context.Background(), filter)
+	events, err := e.store.Find(ctx, p.filter(filterDocument(filter)), p.options())
 	if err != nil {
 		return nil, err
 	}
-	eventsResult.TotalCount = uint32(len(eventsResult.Events))
+	eventsResult.Events, eventsResult.NextPageToken, err = paginate(p, events)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := e.store.CountWithFilter(ctx, filterDocument(filter))
+	if err != nil {
+		return nil, err
+	}
+	eventsResult.TotalCount = uint32(count)
+
+	return eventsResult, nil
+}
+
+// searchText ranks the events matching filter by relevance to i.Q. The
+// matches are paged with page and per_page only, their order not being
+// one a page_token can resume from.
+func (e *Event) searchText(
+	ctx context.Context,
+	i *v1alpha1.SearchEventsRequest,
+	filter map[string]interface{},
+) (*v1alpha1.SearchEventsResponse, error) {
+
+	if i.Sort != "" || i.PageToken != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "sort and page_token cannot be used with q, the events are ranked by relevance")
+	}
+	query, err := utils.ParseTextQuery(i.Q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid q: %v", err)
+	}
+	p, err := newPagination(i.PerPage, i.Page, "", "", eventSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, count, err := e.store.TextSearch(ctx, filterDocument(filter), i.Q, store.FindOptions{Skip: p.skip, Limit: p.perPage})
+	if err != nil {
+		return nil, err
+	}
+	var eventsResult = &v1alpha1.SearchEventsResponse{TotalCount: uint32(count)}
+	for _, match := range matches {
+		eventsResult.Events = append(eventsResult.Events, match.Event)
+		eventsResult.Hits = append(eventsResult.Hits, &v1alpha1.SearchHit{
+			EventId:    match.Event.GetMetadata().GetId(),
+			Score:      match.Score,
+			Highlights: query.Highlights(match.Event),
+		})
+	}
 
 	return eventsResult, nil
 }
@@ -298,14 +469,26 @@ func (e *Event) ListEvents(
 	i *v1alpha1.ListEventsRequest,
 ) (*v1alpha1.ListEventsResponse, error) {
 
+	p, err := newPagination(i.PerPage, i.Page, i.Sort, i.PageToken, eventSortFields)
+	if err != nil {
+		return nil, err
+	}
+
 	var eventsResult = &v1alpha1.ListEventsResponse{}
-	var err error
+	events, err := e.store.Find(ctx, p.filter(bson.D{}), p.options())
+	if err != nil {
+		return nil, err
+	}
+	eventsResult.Events, eventsResult.NextPageToken, err = paginate(p, events)
+	if err != nil {
+		return nil, err
+	}
 
-	eventsResult.Events, err = e.store.List(context.Background())
+	count, err := e.store.CountWithFilter(ctx, bson.D{})
 	if err != nil {
 		return nil, err
 	}
-	eventsResult.TotalCount = uint32(len(eventsResult.Events))
+	eventsResult.TotalCount = uint32(count)
 
 	return eventsResult, nil
 }
@@ -342,6 +525,31 @@ func (e *Event) UpdateEvent(
 	i *v1alpha1.UpdateEventRequest,
 ) (*v1alpha1.UpdateEventResponse, error) {
 
+	expected, err := expectedRevision(ctx, i.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventResult *v1alpha1.UpdateEventResponse
+	err = retryOnConflict(expected, func() (err error) {
+		if len(i.GetUpdateMask().GetPaths()) > 0 {
+			eventResult, err = e.patchEvent(ctx, i, expected)
+		} else {
+			eventResult, err = e.replaceEvent(ctx, i, expected)
+		}
+		return err
+	})
+	return eventResult, err
+}
+
+// replaceEvent replaces the whole event by the fields of i, adding a changelog
+// entry for each field it modifies. It returns the event as updated.
+func (e *Event) replaceEvent(
+	ctx context.Context,
+	i *v1alpha1.UpdateEventRequest,
+	expected int64,
+) (*v1alpha1.UpdateEventResponse, error) {
+
 	var eventResult = &v1alpha1.UpdateEventResponse{}
 	var eventDatabase = &v1alpha1.GetEventResponse{}
 	var err error
@@ -362,6 +570,9 @@ func (e *Event) UpdateEvent(
 			return nil, fmt.Errorf("no event found in tracker for slack id %s", i.SlackId)
 		}
 	}
+	if err := checkRevision("event", eventDatabase.Event.Metadata.Id, eventDatabase.Event.Metadata.Revision, expected); err != nil {
+		return nil, err
+	}
 
 	var event = &v1alpha1.Event{
 		Title: i.Title,
@@ -381,6 +592,7 @@ func (e *Event) UpdateEvent(
 			StakeHolders:  i.Attributes.StakeHolders,
 			Notification:  i.Attributes.Notification,
 			Notifications: i.Attributes.Notifications,
+			ReleaseId:     i.Attributes.ReleaseId,
 		},
 		Links: &v1alpha1.EventLinks{
 			PullRequestLink: i.Links.PullRequestLink,
@@ -391,16 +603,14 @@ func (e *Event) UpdateEvent(
 			CreatedAt: eventDatabase.Event.Metadata.CreatedAt,
 			Duration:  eventDatabase.Event.Metadata.Duration,
 			Id:        eventDatabase.Event.Metadata.Id,
+			Revision:  eventDatabase.Event.Metadata.Revision,
+			// a retry of the creation still finds the event by its key
+			IdempotencyKey: eventDatabase.Event.Metadata.IdempotencyKey,
+			RequestHash:    eventDatabase.Event.Metadata.RequestHash,
 		},
 	}
 
-	if event.Attributes.Status == 2 || event.Attributes.Status == 3 {
-		duration := time.Since(eventDatabase.Event.Metadata.CreatedAt.AsTime())
-		event.Metadata.Duration = durationpb.New(duration)
-		if eventDatabase.Event.Attributes.Status != event.Attributes.Status {
-			recordEvent(event.Attributes.Status.String(), event.Attributes.Service, event.Attributes.Environment.String(), duration)
-		}
-	}
+	updateDuration(eventDatabase.Event, event)
 
 	// Preserve existing changelog
 	event.Changelog = eventDatabase.Event.Changelog
@@ -411,87 +621,11 @@ func (e *Event) UpdateEvent(
 		user = i.Attributes.Owner
 	}
 
-	// Detect if this is an approval (only owner changed, nothing else)
-	isApproval := eventDatabase.Event.Attributes.Owner != event.Attributes.Owner &&
-		eventDatabase.Event.Attributes.Status == event.Attributes.Status &&
-		eventDatabase.Event.Attributes.Priority == event.Attributes.Priority &&
-		eventDatabase.Event.Title == event.Title
-
-	// Check for status change
-	if eventDatabase.Event.Attributes.Status != event.Attributes.Status {
-		addChangelogEntry(
-			event,
-			v1alpha1.ChangeType_status_changed,
-			user,
-			"status",
-			eventDatabase.Event.Attributes.Status.String(),
-			event.Attributes.Status.String(),
-			"Status updated",
-		)
-	}
-
-	// Check for ticket link change
-	if eventDatabase.Event.Links.Ticket != event.Links.Ticket && event.Links.Ticket != "" {
-		addChangelogEntry(
-			event,
-			v1alpha1.ChangeType_linked,
-			user,
-			"ticket",
-			eventDatabase.Event.Links.Ticket,
-			event.Links.Ticket,
-			"Jira ticket linked",
-		)
-	}
-
-	// Check for priority change
-	if eventDatabase.Event.Attributes.Priority != event.Attributes.Priority {
-		addChangelogEntry(
-			event,
-			v1alpha1.ChangeType_updated,
-			user,
-			"priority",
-			eventDatabase.Event.Attributes.Priority.String(),
-			event.Attributes.Priority.String(),
-			"Priority updated",
-		)
-	}
-
-	// Check for title change
-	if eventDatabase.Event.Title != event.Title {
-		addChangelogEntry(
-			event,
-			v1alpha1.ChangeType_updated,
-			user,
-			"title",
-			eventDatabase.Event.Title,
-			event.Title,
-			"Title updated",
-		)
-	}
-
-	// Add general update entry if no specific changes were tracked
-	if len(event.Changelog) == len(eventDatabase.Event.Changelog) {
-		if isApproval {
-			addChangelogEntry(
-				event,
-				v1alpha1.ChangeType_approved,
-				user,
-				"",
-				"",
-				"",
-				fmt.Sprintf("Event approved by %s", user),
-			)
-		} else {
-			addChangelogEntry(
-				event,
-				v1alpha1.ChangeType_updated,
-				user,
-				"",
-				"",
-				"",
-				"Event updated",
-			)
-		}
+	// One entry per modified field, or a general one when nothing changed
+	changes := diffEvent(eventDatabase.Event, event)
+	addFieldChanges(event, user, changes)
+	if len(changes) == 0 {
+		addChangelogEntry(event, v1alpha1.ChangeType_updated, user, "", "", "", "Event updated")
 	}
 
 	// Use the appropriate filter based on whether SlackId or Id is provided
@@ -502,31 +636,58 @@ func (e *Event) UpdateEvent(
 		filter = map[string]interface{}{"metadata.id": i.Id}
 	}
 
-	eventResult.Event, err = e.store.Update(context.Background(), filter, event)
-	if err != nil {
+	if _, err := e.saveUpdate(ctx, filter, eventDatabase.Event, event); err != nil {
 		return nil, err
 	}
+	eventResult.Event = event
+
+	return eventResult, nil
+}
+
+// updateDuration sets the duration of an event ended by its update, from its creation
+func updateDuration(previous *v1alpha1.Event, event *v1alpha1.Event) {
+	if event.Attributes.Status == 2 || event.Attributes.Status == 3 {
+		duration := time.Since(previous.Metadata.CreatedAt.AsTime())
+		event.Metadata.Duration = durationpb.New(duration)
+		if previous.Attributes.Status != event.Attributes.Status {
+			recordEvent(event.Attributes.Status.String(), event.Attributes.Service, event.Attributes.Environment.String(), duration)
+		}
+	}
+}
+
+// saveUpdate replaces the event matching filter, read as stored, by event,
+// provided it is still at the revision of event, tells the watchers and
+// releases the lock of an ending event. The idempotency key of the stored
+// event is kept. It returns the event as it was before the update.
+func (e *Event) saveUpdate(ctx context.Context, filter map[string]interface{}, stored *v1alpha1.Event, event *v1alpha1.Event) (*v1alpha1.Event, error) {
+	event.Metadata.IdempotencyKey = stored.GetMetadata().GetIdempotencyKey()
+	event.Metadata.RequestHash = stored.GetMetadata().GetRequestHash()
+	previous, err := e.store.Update(context.Background(), filter, event)
+	if err != nil {
+		return nil, revisionError(err, "event", event.GetMetadata().GetId())
+	}
+	publishEventUpdate(e.changes, previous, event)
 
 	// Libérer le lock si l'événement se termine
 	if shouldReleaseLock(event.Attributes.Type, event.Attributes.Status) {
-		err = e.lockService.UnlockByEventId(ctx, eventResult.Event.Metadata.Id)
+		err = e.lockService.UnlockByEventId(ctx, previous.Metadata.Id)
 		if err != nil {
 			e.logger.Warn("failed to release lock",
-				"event_id", eventResult.Event.Metadata.Id,
+				"event_id", previous.Metadata.Id,
 				"service", event.Attributes.Service,
 				"error", err,
 			)
 			// Ne pas retourner d'erreur, l'événement est déjà mis à jour
 		} else {
 			e.logger.Info("lock released for event",
-				"event_id", eventResult.Event.Metadata.Id,
+				"event_id", previous.Metadata.Id,
 				"service", event.Attributes.Service,
 				"status", event.Attributes.Status.String(),
 			)
 		}
 	}
 
-	return eventResult, nil
+	return previous, nil
 }
 
 func (e *Event) DeleteEvent(
@@ -536,10 +697,16 @@ func (e *Event) DeleteEvent(
 
 	var eventResult = &v1alpha1.DeleteEventResponse{}
 
+	// keep the event to tell the watchers what was deleted
+	deleted, errGet := e.store.Get(ctx, map[string]interface{}{"metadata.id": i.Id})
+
 	err := e.store.Delete(context.Background(), map[string]interface{}{"metadata.id": i.Id})
 	if err != nil {
 		return nil, err
 	}
+	if errGet == nil {
+		publishEvent(e.changes, v1alpha1.EventChangeKind_event_deleted, deleted, nil)
+	}
 
 	return eventResult, nil
 }
@@ -549,15 +716,6 @@ func (e *Event) AddChangelogEntry(
 	i *v1alpha1.AddChangelogEntryRequest,
 ) (*v1alpha1.AddChangelogEntryResponse, error) {
 
-	// Retrieve the existing event
-	eventDatabase, err := e.store.Get(ctx, map[string]interface{}{"metadata.id": i.Id})
-	if err != nil {
-		if err.Error() == "mongo: no documents in result" {
-			return nil, fmt.Errorf("event not found with id %s", i.Id)
-		}
-		return nil, err
-	}
-
 	// Validate the changelog entry
 	if i.Entry == nil {
 		return nil, fmt.Errorf("changelog entry cannot be nil")
@@ -568,17 +726,15 @@ func (e *Event) AddChangelogEntry(
 		i.Entry.Timestamp = timestamppb.Now()
 	}
 
-	// Append the new changelog entry
-	if eventDatabase.Changelog == nil {
-		eventDatabase.Changelog = []*v1alpha1.ChangelogEntry{}
-	}
-	eventDatabase.Changelog = append(eventDatabase.Changelog, i.Entry)
-
-	// Update the event in the database
-	updatedEvent, err := e.store.Update(ctx, map[string]interface{}{"metadata.id": i.Id}, eventDatabase)
+	// Append the new changelog entry, whatever the concurrent updates
+	updatedEvent, err := e.store.AppendChangelog(ctx, map[string]interface{}{"metadata.id": i.Id}, i.Entry)
 	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, fmt.Errorf("event not found with id %s", i.Id)
+		}
 		return nil, fmt.Errorf("failed to update event changelog: %w", err)
 	}
+	publishEvent(e.changes, v1alpha1.EventChangeKind_changelog_added, updatedEvent, i.Entry)
 
 	e.logger.Info("changelog entry added",
 		"event_id", i.Id,
@@ -656,48 +812,60 @@ func (e *Event) AddSlackId(
 	i *v1alpha1.AddSlackIdRequest,
 ) (*v1alpha1.AddSlackIdResponse, error) {
 
-	// Retrieve the existing event
-	eventDatabase, err := e.store.Get(ctx, map[string]interface{}{"metadata.id": i.Id})
-	if err != nil {
-		if err.Error() == "mongo: no documents in result" {
-			return nil, fmt.Errorf("event not found with id %s", i.Id)
-		}
-		return nil, err
-	}
-
 	// Validate the Slack ID
 	if i.SlackId == "" {
 		return nil, fmt.Errorf("slack_id cannot be empty")
 	}
 
-	// Check if Slack ID already exists
-	if eventDatabase.Metadata.SlackId != "" {
-		return nil, fmt.Errorf("event already has a slack_id: %s", eventDatabase.Metadata.SlackId)
-	}
+	// Read and update the event again when it changed in between
+	var eventDatabase *v1alpha1.Event
+	err := retryOnConflict(0, func() (err error) {
+		// Retrieve the existing event
+		eventDatabase, err = e.store.Get(ctx, map[string]interface{}{"metadata.id": i.Id})
+		if err != nil {
+			if err.Error() == "mongo: no documents in result" {
+				return fmt.Errorf("event not found with id %s", i.Id)
+			}
+			return err
+		}
 
-	// Update the Slack ID
-	eventDatabase.Metadata.SlackId = i.SlackId
+		// Check if Slack ID already exists
+		if eventDatabase.Metadata.SlackId != "" {
+			return fmt.Errorf("event already has a slack_id: %s", eventDatabase.Metadata.SlackId)
+		}
 
-	// Add changelog entry
-	user := "system"
-	if eventDatabase.Attributes.Owner != "" {
-		user = eventDatabase.Attributes.Owner
-	}
-	addChangelogEntry(
-		eventDatabase,
-		v1alpha1.ChangeType_linked,
-		user,
-		"slack_id",
-		"",
-		i.SlackId,
-		"Slack message linked",
-	)
+		// Update the Slack ID
+		eventDatabase.Metadata.SlackId = i.SlackId
+
+		// Add changelog entry
+		user := "system"
+		if eventDatabase.Attributes.Owner != "" {
+			user = eventDatabase.Attributes.Owner
+		}
+		addChangelogEntry(
+			eventDatabase,
+			v1alpha1.ChangeType_linked,
+			user,
+			"slack_id",
+			"",
+			i.SlackId,
+			"Slack message linked",
+		)
 
-	// Update the event in the database
-	updatedEvent, err := e.store.Update(ctx, map[string]interface{}{"metadata.id": i.Id}, eventDatabase)
+		// Update the event in the database
+		_, err = e.store.Update(ctx, map[string]interface{}{"metadata.id": i.Id}, eventDatabase)
+		if errors.Is(err, store.ErrRevisionConflict) {
+			return revisionError(err, "event", i.Id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update event with slack_id: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update event with slack_id: %w", err)
+		return nil, err
 	}
+	publishEvent(e.changes, v1alpha1.EventChangeKind_event_updated, eventDatabase, nil)
 
 	e.logger.Info("slack_id added to event",
 		"event_id", i.Id,
@@ -705,7 +873,7 @@ func (e *Event) AddSlackId(
 	)
 
 	return &v1alpha1.AddSlackIdResponse{
-		Event: updatedEvent,
+		Event: eventDatabase,
 	}, nil
 }
 
@@ -717,60 +885,84 @@ func recordEvent(status string, service string, environment string, duration tim
 	eventDuration.With(prometheus.Labels{"status": status, "service": service, "environment": environment}).Observe(duration.Seconds())
 }
 
-func (e *Event) GetEventStats(
-	ctx context.Context,
-	i *v1alpha1.GetEventStatsRequest,
-) (*v1alpha1.GetEventStatsResponse, error) {
+// statsRequest is the filter shared by the statistics requests
+type statsRequest interface {
+	GetStartDate() string
+	GetEndDate() string
+	GetEnvironments() []v1alpha1.Environment
+	GetSource() string
+	GetService() string
+	GetQuery() string
+}
 
-	// Build filter from request
-	statsFilter := &utils.StatsFilter{
-		StartDate: i.StartDate,
-		EndDate:   i.EndDate,
-		Source:    i.Source,
-		Service:   i.Service,
-	}
+// statsCriteria is the filter on the attributes of the events of the
+// statistics requests having one
+type statsCriteria interface {
+	GetImpact() *wrapperspb.BoolValue
+	GetPriorities() []v1alpha1.Priority
+	GetTypes() []v1alpha1.Type
+	GetStatuses() []v1alpha1.Status
+}
+
+var (
+	_ statsCriteria = (*v1alpha1.GetEventStatsRequest)(nil)
+	_ statsCriteria = (*v1alpha1.GetEventStatsByMonthRequest)(nil)
+	_ statsCriteria = (*v1alpha1.AggregateEventsRequest)(nil)
+)
 
-	// Convert environments
-	if len(i.Environments) > 0 {
-		statsFilter.Environments = make([]int32, len(i.Environments))
-		for idx, env := range i.Environments {
-			statsFilter.Environments[idx] = int32(env)
+// newStatsFilter converts the filter of a statistics request, and parses its
+// required period
+func newStatsFilter(i statsRequest) (*utils.StatsFilter, time.Time, time.Time, error) {
+	statsFilter := &utils.StatsFilter{
+		StartDate:    i.GetStartDate(),
+		EndDate:      i.GetEndDate(),
+		Environments: enumValues(i.GetEnvironments()),
+		Source:       i.GetSource(),
+		Service:      i.GetService(),
+		Query:        i.GetQuery(),
+	}
+	if criteria, ok := i.(statsCriteria); ok {
+		if criteria.GetImpact() != nil {
+			impact := criteria.GetImpact().GetValue()
+			statsFilter.Impact = &impact
 		}
+		statsFilter.Priorities = enumValues(criteria.GetPriorities())
+		statsFilter.Types = enumValues(criteria.GetTypes())
+		statsFilter.Statuses = enumValues(criteria.GetStatuses())
 	}
 
-	// Convert impact
-	if i.Impact != nil {
-		impact := i.Impact.Value
-		statsFilter.Impact = &impact
+	start, end, err := utils.ParseStatsPeriod(statsFilter)
+	if err != nil {
+		return nil, start, end, status.Errorf(codes.InvalidArgument, "failed to create stats filter: %v", err)
 	}
+	return statsFilter, start, end, nil
+}
 
-	// Convert priorities
-	if len(i.Priorities) > 0 {
-		statsFilter.Priorities = make([]int32, len(i.Priorities))
-		for idx, p := range i.Priorities {
-			statsFilter.Priorities[idx] = int32(p)
-		}
+// enumValues returns the numbers of values, nil when there is none
+func enumValues[E ~int32](values []E) []int32 {
+	if len(values) == 0 {
+		return nil
 	}
-
-	// Convert types
-	if len(i.Types) > 0 {
-		statsFilter.Types = make([]int32, len(i.Types))
-		for idx, t := range i.Types {
-			statsFilter.Types[idx] = int32(t)
-		}
+	numbers := make([]int32, len(values))
+	for idx, value := range values {
+		numbers[idx] = int32(value)
 	}
+	return numbers
+}
 
-	// Convert statuses
-	if len(i.Statuses) > 0 {
-		statsFilter.Statuses = make([]int32, len(i.Statuses))
-		for idx, s := range i.Statuses {
-			statsFilter.Statuses[idx] = int32(s)
-		}
+func (e *Event) GetEventStats(
+	ctx context.Context,
+	i *v1alpha1.GetEventStatsRequest,
+) (*v1alpha1.GetEventStatsResponse, error) {
+
+	statsFilter, _, _, err := newStatsFilter(i)
+	if err != nil {
+		return nil, err
 	}
 
 	filter, err := utils.CreateStatsFilter(statsFilter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create stats filter: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create stats filter: %v", err)
 	}
 
 	count, err := e.store.CountWithFilter(ctx, filter)
@@ -802,55 +994,14 @@ func (e *Event) GetEventStatsByMonth(
 	i *v1alpha1.GetEventStatsByMonthRequest,
 ) (*v1alpha1.GetEventStatsByMonthResponse, error) {
 
-	// Build filter from request
-	statsFilter := &utils.StatsFilter{
-		StartDate: i.StartDate,
-		EndDate:   i.EndDate,
-		Source:    i.Source,
-		Service:   i.Service,
-	}
-
-	// Convert environments
-	if len(i.Environments) > 0 {
-		statsFilter.Environments = make([]int32, len(i.Environments))
-		for idx, env := range i.Environments {
-			statsFilter.Environments[idx] = int32(env)
-		}
-	}
-
-	// Convert impact
-	if i.Impact != nil {
-		impact := i.Impact.Value
-		statsFilter.Impact = &impact
-	}
-
-	// Convert priorities
-	if len(i.Priorities) > 0 {
-		statsFilter.Priorities = make([]int32, len(i.Priorities))
-		for idx, p := range i.Priorities {
-			statsFilter.Priorities[idx] = int32(p)
-		}
-	}
-
-	// Convert types
-	if len(i.Types) > 0 {
-		statsFilter.Types = make([]int32, len(i.Types))
-		for idx, t := range i.Types {
-			statsFilter.Types[idx] = int32(t)
-		}
-	}
-
-	// Convert statuses
-	if len(i.Statuses) > 0 {
-		statsFilter.Statuses = make([]int32, len(i.Statuses))
-		for idx, s := range i.Statuses {
-			statsFilter.Statuses[idx] = int32(s)
-		}
+	statsFilter, _, _, err := newStatsFilter(i)
+	if err != nil {
+		return nil, err
 	}
 
 	filter, err := utils.CreateStatsFilter(statsFilter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create stats filter: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create stats filter: %v", err)
 	}
 
 	results, err := e.store.AggregateByMonth(ctx, filter, i.GroupByService)
@@ -892,6 +1043,79 @@ func (e *Event) GetEventStatsByMonth(
 	}, nil
 }
 
+func (e *Event) AggregateEvents(
+	ctx context.Context,
+	i *v1alpha1.AggregateEventsRequest,
+) (*v1alpha1.AggregateEventsResponse, error) {
+
+	statsFilter, _, _, err := newStatsFilter(i)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range i.GroupBy {
+		if !slices.Contains(store.AggregateFields, field) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot group by %q, expected one of %s", field, strings.Join(store.AggregateFields, ", "))
+		}
+	}
+
+	filter, err := utils.CreateStatsFilter(statsFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create stats filter: %v", err)
+	}
+
+	results, err := e.store.Aggregate(ctx, filter, store.AggregateOptions{Bucket: i.Bucket, GroupBy: i.GroupBy})
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate events: %w", err)
+	}
+
+	// Convert results to proto
+	aggregations := make([]*v1alpha1.Aggregation, len(results))
+	var totalCount uint64
+	for idx, r := range results {
+		aggregation := &v1alpha1.Aggregation{
+			Count:     uint64(r.Count),     // #nosec G115
+			Successes: uint64(r.Successes), // #nosec G115
+			Failures:  uint64(r.Failures),  // #nosec G115
+		}
+		if !r.Bucket.IsZero() {
+			aggregation.Bucket = timestamppb.New(r.Bucket)
+		}
+		if len(i.GroupBy) > 0 {
+			aggregation.Group = make(map[string]string, len(i.GroupBy))
+			for field, value := range r.Group {
+				aggregation.Group[i.GroupBy[field]] = value
+			}
+		}
+		if ended := r.Successes + r.Failures; ended > 0 {
+			aggregation.SuccessRatio = float64(r.Successes) / float64(ended)
+		}
+		if r.Measured > 0 {
+			aggregation.DurationP50 = durationpb.New(r.P50)
+			aggregation.DurationP90 = durationpb.New(r.P90)
+			aggregation.DurationP99 = durationpb.New(r.P99)
+		}
+		aggregations[idx] = aggregation
+		totalCount += aggregation.Count
+	}
+
+	e.logger.Info("event aggregations retrieved",
+		"start_date", i.StartDate,
+		"end_date", i.EndDate,
+		"bucket", i.Bucket.String(),
+		"group_by", i.GroupBy,
+		"aggregations_count", len(aggregations),
+		"total_count", totalCount,
+	)
+
+	return &v1alpha1.AggregateEventsResponse{
+		Aggregations: aggregations,
+		TotalCount:   totalCount,
+		StartDate:    i.StartDate,
+		EndDate:      i.EndDate,
+	}, nil
+}
+
 func init() {
 	// Enregistrer les métriques
 	prometheus.MustRegister(eventCounter)
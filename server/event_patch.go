@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskableFields are the fields of an event an update mask may list, with their subfields
+var maskableFields = []string{"title", "attributes", "links"}
+
+// patchEvent updates the fields of i.UpdateMask only, adding a changelog
+// entry for each field it modifies. It returns the event as updated.
+func (e *Event) patchEvent(
+	ctx context.Context,
+	i *v1alpha1.UpdateEventRequest,
+	expected int64,
+) (*v1alpha1.UpdateEventResponse, error) {
+
+	mask := proto.Clone(i.UpdateMask).(*fieldmaskpb.FieldMask)
+	mask.Normalize()
+	if !mask.IsValid(&v1alpha1.Event{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %q, expected fields of the event", strings.Join(i.UpdateMask.Paths, ","))
+	}
+	for _, path := range mask.Paths {
+		if root, _, _ := strings.Cut(path, "."); !slices.Contains(maskableFields, root) {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask cannot change %s, only %s", path, strings.Join(maskableFields, ", "))
+		}
+	}
+
+	filter, key := map[string]interface{}{"metadata.id": i.Id}, "id "+i.Id
+	if i.SlackId != "" {
+		filter, key = map[string]interface{}{"metadata.slackid": i.SlackId}, "slack id "+i.SlackId
+	}
+	previous, err := e.store.Get(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no event found in tracker for %s", key)
+	}
+	if err := checkRevision("event", previous.GetMetadata().GetId(), previous.GetMetadata().GetRevision(), expected); err != nil {
+		return nil, err
+	}
+
+	event := proto.Clone(previous).(*v1alpha1.Event)
+	if event.Attributes == nil {
+		event.Attributes = &v1alpha1.EventAttributes{}
+	}
+	if event.Metadata == nil {
+		event.Metadata = &v1alpha1.EventMetadata{}
+	}
+	patch := &v1alpha1.Event{Title: i.Title, Attributes: i.Attributes, Links: i.Links}
+	var changes []fieldChange
+	for _, path := range mask.Paths {
+		changes = patchField(event.ProtoReflect(), patch.ProtoReflect(), strings.Split(path, "."), "", changes)
+	}
+	if len(changes) == 0 {
+		return &v1alpha1.UpdateEventResponse{Event: previous}, nil
+	}
+
+	user := "system"
+	if i.GetAttributes().GetOwner() != "" {
+		user = i.Attributes.Owner
+	}
+	addFieldChanges(event, user, changes)
+	updateDuration(previous, event)
+
+	if _, err := e.saveUpdate(ctx, filter, previous, event); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.UpdateEventResponse{Event: event}, nil
+}
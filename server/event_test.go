@@ -0,0 +1,580 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+)
+
+// newTestEvent builds an event service sharing its event store with its lock service
+func newTestEvent(t *testing.T) *Event {
+	lockService := newTestLock(t)
+	freezeService := newTestFreeze(t)
+	return &Event{
+		store:         lockService.eventStore,
+		catalogStore:  freezeService.catalogStore,
+		lockService:   lockService,
+		freezeService: freezeService,
+		logger:        slog.New(slog.NewJSONHandler(io.Discard, nil)),
+		changes:       lockService.eventChanges,
+		idempotency:   store.NewMemoryStoreIdempotency(t.Name()),
+	}
+}
+
+func deploymentRequest(service string, status v1alpha1.Status) *v1alpha1.CreateEventRequest {
+	return &v1alpha1.CreateEventRequest{
+		Title: "deploy " + service,
+		Attributes: &v1alpha1.EventAttributes{
+			Message:     "deploy",
+			Source:      "github",
+			Type:        v1alpha1.Type_deployment,
+			Priority:    v1alpha1.Priority_P1,
+			Environment: v1alpha1.Environment_production,
+			Service:     service,
+			Status:      status,
+			Owner:       "alice",
+		},
+		Links: &v1alpha1.EventLinks{},
+	}
+}
+
+func TestCreateEventLocks(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Event.Metadata.Id)
+
+	locks, err := e.lockService.ListLocks(ctx, &lock.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, locks.Locks, 1)
+	assert.Equal(t, created.Event.Metadata.Id, locks.Locks[0].EventId)
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.ErrorContains(t, err, "already locked")
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("billing", v1alpha1.Status_success))
+	assert.NoError(t, err, "a finished deployment does not take a lock")
+
+	got, err := e.GetEvent(ctx, &v1alpha1.GetEventRequest{Id: created.Event.Metadata.Id})
+	assert.NoError(t, err)
+	assert.Len(t, got.Event.Changelog, 1)
+	assert.Equal(t, v1alpha1.ChangeType_created, got.Event.Changelog[0].ChangeType)
+}
+
+func TestCreateEventIdempotent(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	request := deploymentRequest("payments", v1alpha1.Status_start)
+	request.IdempotencyKey = "ci-run-42"
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	if assert.NotNil(t, created.Lock) {
+		assert.Equal(t, created.Event.Metadata.Id, created.Lock.EventId)
+	}
+
+	retried, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err, "a retry is not refused by the lock of its first attempt")
+	assert.Equal(t, created.Event.Metadata.Id, retried.Event.Metadata.Id)
+	if assert.NotNil(t, retried.Lock) {
+		assert.Equal(t, created.Lock.Id, retried.Lock.Id)
+	}
+
+	// the key may also come from the Idempotency-Key header
+	header := metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", "ci-run-42"))
+	retried, err = e.CreateEvent(header, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.NoError(t, err)
+	assert.Equal(t, created.Event.Metadata.Id, retried.Event.Metadata.Id)
+
+	conflicting := deploymentRequest("payments", v1alpha1.Status_start)
+	conflicting.Title = "another deployment"
+	conflicting.IdempotencyKey = "ci-run-42"
+	_, err = e.CreateEvent(ctx, conflicting)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	events, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+
+	// once the window has passed, the key creates a new event
+	window := idempotencyWindow
+	idempotencyWindow = -time.Minute
+	defer func() { idempotencyWindow = window }()
+	request = deploymentRequest("billing", v1alpha1.Status_success)
+	request.IdempotencyKey = "ci-run-44"
+	created, err = e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	retried, err = e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	assert.NotEqual(t, created.Event.Metadata.Id, retried.Event.Metadata.Id)
+}
+
+// failingEventStore fails the creation of the next events
+type failingEventStore struct {
+	store.EventStore
+	failures int
+}
+
+func (s *failingEventStore) Create(ctx context.Context, event *v1alpha1.Event) (*v1alpha1.Event, error) {
+	if s.failures > 0 {
+		s.failures--
+		return nil, errors.New("database unavailable")
+	}
+	return s.EventStore.Create(ctx, event)
+}
+
+func TestCreateEventFailedReleasesLock(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	e.store = &failingEventStore{EventStore: e.store, failures: 1}
+
+	request := deploymentRequest("payments", v1alpha1.Status_start)
+	request.IdempotencyKey = "ci-run-49"
+	_, err := e.CreateEvent(ctx, request)
+	assert.ErrorContains(t, err, "database unavailable")
+	locks, err := e.lockService.ListLocks(ctx, &lock.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, locks.Locks, "the lock of the event not created is released")
+
+	retried, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err, "the retry is not refused by the lock of its first attempt")
+	if assert.NotNil(t, retried.Lock) {
+		assert.Equal(t, retried.Event.Metadata.Id, retried.Lock.EventId)
+	}
+}
+
+func TestCreateEventIdempotentInstances(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	// deux instances du tracker partagent les stores, pas leurs verrous
+	instances := []*Event{e, {
+		store:         e.store,
+		catalogStore:  e.catalogStore,
+		lockService:   e.lockService,
+		freezeService: e.freezeService,
+		logger:        e.logger,
+		changes:       e.changes,
+		idempotency:   e.idempotency,
+	}}
+	request := deploymentRequest("payments", v1alpha1.Status_success)
+	request.IdempotencyKey = "ci-run-45"
+	var wg sync.WaitGroup
+	for n := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := instances[n%2].CreateEvent(ctx, request)
+			if err != nil {
+				assert.Equal(t, codes.Unavailable, status.Code(err), "a retry during the creation is told to wait")
+			}
+		}()
+	}
+	wg.Wait()
+
+	events, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+
+	// la clé d'une création en cours n'est pas reprise
+	now := time.Now()
+	_, reserved, err := e.idempotency.Reserve(ctx, &store.Idempotency{Id: "pending", Key: "ci-run-46", RequestHash: "hash",
+		CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+	pending := deploymentRequest("billing", v1alpha1.Status_success)
+	pending.IdempotencyKey = "ci-run-46"
+	hash, err := requestHash(pending)
+	assert.NoError(t, err)
+	_, err = e.CreateEvent(ctx, pending)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "another request holds the key")
+
+	_, _, err = e.idempotency.Reserve(ctx, &store.Idempotency{Id: "pending", Key: "ci-run-47", RequestHash: hash,
+		CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(t, err)
+	pending.IdempotencyKey = "ci-run-47"
+	_, err = e.CreateEvent(ctx, pending)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// une création refusée libère sa clé
+	related := deploymentRequest("search", v1alpha1.Status_success)
+	related.IdempotencyKey = "ci-run-48"
+	related.Attributes.RelatedId = "unknown"
+	_, err = e.CreateEvent(ctx, related)
+	assert.Error(t, err)
+	related.Attributes.RelatedId = ""
+	_, err = e.CreateEvent(ctx, related)
+	assert.NoError(t, err, "the refused creation released its key")
+}
+
+func TestCreateEventIdempotentAfterUpdate(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	request := deploymentRequest("payments", v1alpha1.Status_start)
+	request.IdempotencyKey = "ci-run-43"
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+
+	// a whole update, then a masked one
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Title: "deploy payments v2",
+		Attributes: request.Attributes, Links: request.Links})
+	assert.NoError(t, err)
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Title: "deploy payments v3",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}})
+	assert.NoError(t, err)
+
+	retried, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err, "the updates keep the idempotency key")
+	assert.Equal(t, id, retried.Event.Metadata.Id)
+	assert.Equal(t, "deploy payments v3", retried.Event.Title)
+}
+
+func TestUpdateEventReleasesLock(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.NoError(t, err)
+
+	request := deploymentRequest("payments", v1alpha1.Status_success)
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{
+		Id:         created.Event.Metadata.Id,
+		Title:      request.Title,
+		Attributes: request.Attributes,
+		Links:      request.Links,
+	})
+	assert.NoError(t, err)
+
+	locks, err := e.lockService.ListLocks(ctx, &lock.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, locks.Locks)
+
+	got, err := e.GetEvent(ctx, &v1alpha1.GetEventRequest{Id: created.Event.Metadata.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.Status_success, got.Event.Attributes.Status)
+	assert.NotNil(t, got.Event.Metadata.Duration)
+}
+
+func TestUpdateEventWithMask(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	request := deploymentRequest("payments", v1alpha1.Status_start)
+	request.Attributes.StakeHolders = []string{"bob"}
+	request.Links.Ticket = "OPS-1"
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+
+	updated, err := e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{
+		Id:         id,
+		Title:      "ignored, not in the mask",
+		Attributes: &v1alpha1.EventAttributes{Status: v1alpha1.Status_success, Priority: v1alpha1.Priority_P1, StakeHolders: []string{"bob", "carol"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.status", "attributes.priority", "attributes.stake_holders", "links.pull_request_link"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy payments", updated.Event.Title)
+	assert.Equal(t, v1alpha1.Status_success, updated.Event.Attributes.Status)
+	assert.Equal(t, "payments", updated.Event.Attributes.Service, "fields out of the mask are kept")
+	assert.Equal(t, "OPS-1", updated.Event.Links.Ticket)
+	assert.NotNil(t, updated.Event.Metadata.Duration)
+
+	got, err := e.GetEvent(ctx, &v1alpha1.GetEventRequest{Id: id})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob", "carol"}, got.Event.Attributes.StakeHolders)
+	changelog := got.Event.Changelog[len(created.Event.Changelog):]
+	if assert.Len(t, changelog, 2, "the priority and the pull request link did not change") {
+		assert.Equal(t, "stake_holders", changelog[0].Field)
+		assert.Equal(t, `["bob"]`, changelog[0].OldValue)
+		assert.Equal(t, `["bob","carol"]`, changelog[0].NewValue)
+		assert.Equal(t, v1alpha1.ChangeType_status_changed, changelog[1].ChangeType)
+		assert.Equal(t, "status", changelog[1].Field)
+		assert.Equal(t, "start", changelog[1].OldValue)
+		assert.Equal(t, "success", changelog[1].NewValue)
+	}
+
+	locks, err := e.lockService.ListLocks(ctx, &lock.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, locks.Locks, "the deployment ended")
+
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata.duration"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.team"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetEventAt(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	request := deploymentRequest("payments", v1alpha1.Status_start)
+	request.Attributes.StakeHolders = []string{"bob"}
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+	at := created.Event.Changelog[len(created.Event.Changelog)-1].Timestamp
+
+	update := &v1alpha1.UpdateEventRequest{Id: id, Title: "deploy payments v2", Attributes: proto.Clone(request.Attributes).(*v1alpha1.EventAttributes), Links: request.Links}
+	update.Attributes.Status = v1alpha1.Status_success
+	update.Attributes.Environment = v1alpha1.Environment_preproduction
+	update.Attributes.StakeHolders = nil
+	_, err = e.UpdateEvent(ctx, update)
+	assert.NoError(t, err)
+
+	got, err := e.GetEvent(ctx, &v1alpha1.GetEventRequest{Id: id})
+	assert.NoError(t, err)
+	var fields []string
+	for _, entry := range got.Event.Changelog[len(created.Event.Changelog):] {
+		fields = append(fields, entry.Field)
+	}
+	assert.Equal(t, []string{"title", "status", "environment", "stake_holders"}, fields, "one entry per modified field")
+
+	past, err := e.GetEventAt(ctx, &v1alpha1.GetEventAtRequest{Id: id, At: at})
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy payments", past.Event.Title)
+	assert.Equal(t, v1alpha1.Status_start, past.Event.Attributes.Status)
+	assert.Equal(t, v1alpha1.Environment_production, past.Event.Attributes.Environment)
+	assert.Equal(t, []string{"bob"}, past.Event.Attributes.StakeHolders)
+	assert.Len(t, past.Event.Changelog, len(created.Event.Changelog))
+	assert.Zero(t, past.SkippedEntries)
+
+	_, err = e.GetEventAt(ctx, &v1alpha1.GetEventAtRequest{Id: id, At: timestamppb.New(created.Event.Metadata.CreatedAt.AsTime().Add(-time.Hour))})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestUpdateEventRevision(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+	assert.Equal(t, int64(1), created.Event.Metadata.Revision)
+
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"links.ticket"}}
+	updated, err := e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Links: &v1alpha1.EventLinks{Ticket: "OPS-1"}, UpdateMask: mask, Revision: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updated.Event.Metadata.Revision)
+
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Links: &v1alpha1.EventLinks{Ticket: "OPS-2"}, UpdateMask: mask, Revision: 1})
+	assert.Equal(t, codes.Aborted, status.Code(err), "the event changed since revision 1")
+
+	// the entries appended in between are kept by the next update
+	_, err = e.AddChangelogEntry(ctx, &v1alpha1.AddChangelogEntryRequest{Id: id, Entry: &v1alpha1.ChangelogEntry{User: "bob", Comment: "rollout paused"}})
+	assert.NoError(t, err)
+	ifMatch := metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-if-match", `"3"`))
+	updated, err = e.UpdateEvent(ifMatch, &v1alpha1.UpdateEventRequest{Id: id, Links: &v1alpha1.EventLinks{Ticket: "OPS-2"}, UpdateMask: mask})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), updated.Event.Metadata.Revision)
+	assert.Equal(t, "rollout paused", updated.Event.Changelog[len(updated.Event.Changelog)-2].Comment)
+
+	mux := runtime.NewServeMux(ServeMuxOptions()...)
+	assert.NoError(t, v1alpha1.RegisterEventServiceHandlerServer(ctx, mux, e))
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1alpha1/event/"+id, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, `"4"`, recorder.Header().Get("ETag"))
+
+	request := httptest.NewRequest(http.MethodPatch, "/api/v1alpha1/event", strings.NewReader(`{"id":"`+id+`","title":"late","updateMask":"title"}`))
+	request.Header.Set("If-Match", `"3"`)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+}
+
+func TestCreateEventCoveredByScope(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	freeze, err := e.lockService.CreateLock(ctx, &lock.CreateLockRequest{Service: "payments-*", Who: "bob", Environment: "production", Resource: "*"})
+	assert.NoError(t, err)
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments-api", v1alpha1.Status_start))
+	assert.ErrorContains(t, err, "covered by lock "+freeze.Lock.Id+" on service payments-*")
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("billing", v1alpha1.Status_start))
+	assert.NoError(t, err, "services outside the scope can still deploy")
+}
+
+func TestCreateEventOperationsShareLock(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	audit := deploymentRequest("payments", v1alpha1.Status_start)
+	audit.Attributes.Type = v1alpha1.Type_operation
+	_, err := e.CreateEvent(ctx, audit)
+	assert.NoError(t, err)
+	_, err = e.CreateEvent(ctx, audit)
+	assert.NoError(t, err, "operations run in parallel")
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.Error(t, err, "a deployment waits for the operations")
+
+	locks, err := e.lockService.ListLocks(ctx, &lock.ListLocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, locks.Locks, 2) {
+		assert.Equal(t, lock.LockMode_shared, locks.Locks[0].Mode)
+	}
+}
+
+func TestAggregateEvents(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	now := time.Now().UTC()
+	from, to := now.AddDate(0, 0, -1).Format("2006-01-02"), now.AddDate(0, 0, 1).Format("2006-01-02")
+
+	for _, s := range []v1alpha1.Status{v1alpha1.Status_success, v1alpha1.Status_success, v1alpha1.Status_failure, v1alpha1.Status_start} {
+		_, err := e.CreateEvent(ctx, deploymentRequest("payments", s))
+		assert.NoError(t, err)
+	}
+	incident := deploymentRequest("payments", v1alpha1.Status_open)
+	incident.Attributes.Type = v1alpha1.Type_incident
+	_, err := e.CreateEvent(ctx, incident)
+	assert.NoError(t, err)
+
+	aggregated, err := e.AggregateEvents(ctx, &v1alpha1.AggregateEventsRequest{StartDate: from, EndDate: to, Bucket: v1alpha1.TimeBucket_day,
+		GroupBy: []string{"type", "owner"}, Types: []v1alpha1.Type{v1alpha1.Type_deployment}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), aggregated.TotalCount)
+	if assert.Len(t, aggregated.Aggregations, 1) {
+		aggregation := aggregated.Aggregations[0]
+		assert.Equal(t, map[string]string{"type": "deployment", "owner": "alice"}, aggregation.Group)
+		assert.Equal(t, now.Truncate(24*time.Hour), aggregation.Bucket.AsTime())
+		assert.InDelta(t, 2.0/3, aggregation.SuccessRatio, 1e-9, "over the ended deployments")
+	}
+
+	_, err = e.AggregateEvents(ctx, &v1alpha1.AggregateEventsRequest{StartDate: from, EndDate: to, GroupBy: []string{"title"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNewStatsFilter(t *testing.T) {
+	statsFilter, start, end, err := newStatsFilter(&v1alpha1.GetEventStatsByMonthRequest{StartDate: "2025-01-01", EndDate: "2025-02-01", Service: "payments",
+		Environments: []v1alpha1.Environment{v1alpha1.Environment_production}, Impact: wrapperspb.Bool(true), Statuses: []v1alpha1.Status{v1alpha1.Status_failure}})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), start.UTC())
+	assert.True(t, end.After(start))
+	assert.Equal(t, "payments", statsFilter.Service)
+	assert.Equal(t, []int32{int32(v1alpha1.Environment_production)}, statsFilter.Environments)
+	assert.Equal(t, []int32{int32(v1alpha1.Status_failure)}, statsFilter.Statuses)
+	assert.Nil(t, statsFilter.Types)
+	if assert.NotNil(t, statsFilter.Impact) {
+		assert.True(t, *statsFilter.Impact)
+	}
+
+	_, _, _, err = newStatsFilter(&v1alpha1.GetDoraMetricsRequest{StartDate: "2025-02-01", EndDate: "2025-01-01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, _, _, err = newStatsFilter(&v1alpha1.AggregateEventsRequest{EndDate: "2025-01-01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSearchEventsText(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	rebalance := deploymentRequest("billing", v1alpha1.Status_success)
+	rebalance.Attributes.Message = "kafka rebalance took an hour"
+	_, err := e.CreateEvent(ctx, rebalance)
+	assert.NoError(t, err)
+	title := deploymentRequest("payments", v1alpha1.Status_success)
+	title.Title = "Kafka rebalance of payments"
+	created, err := e.CreateEvent(ctx, title)
+	assert.NoError(t, err)
+	_, err = e.CreateEvent(ctx, deploymentRequest("kafka", v1alpha1.Status_success))
+	assert.NoError(t, err)
+
+	searched, err := e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{Q: `"kafka rebalance"`})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), searched.TotalCount)
+	if assert.Len(t, searched.Hits, 2) {
+		assert.Equal(t, created.Event.Metadata.Id, searched.Hits[0].EventId, "a match in the title ranks first")
+		assert.Greater(t, searched.Hits[0].Score, searched.Hits[1].Score)
+		assert.Equal(t, "<em>Kafka rebalance</em> of payments", searched.Hits[0].Highlights[0].Snippet)
+	}
+
+	searched, err = e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{Q: "rebalance", Service: "billing"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), searched.TotalCount, "the other filters still apply")
+
+	_, err = e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{Q: "kafka", Sort: "-created_at"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSearchEventsEmpty(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	for _, service := range []string{"payments", "billing", "search"} {
+		_, err := e.CreateEvent(ctx, deploymentRequest(service, v1alpha1.Status_success))
+		assert.NoError(t, err)
+	}
+
+	searched, err := e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{PerPage: wrapperspb.UInt32(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), searched.TotalCount, "an empty search matches every event")
+	assert.Len(t, searched.Events, 2)
+	assert.NotEmpty(t, searched.NextPageToken)
+
+	searched, err = e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{PerPage: wrapperspb.UInt32(2), PageToken: searched.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, searched.Events, 1)
+	assert.Empty(t, searched.NextPageToken)
+}
+
+func TestSearchEventsQuery(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	now := time.Now().UTC()
+
+	for _, service := range []string{"payments", "billing", "search"} {
+		for _, s := range []v1alpha1.Status{v1alpha1.Status_success, v1alpha1.Status_failure} {
+			_, err := e.CreateEvent(ctx, deploymentRequest(service, s))
+			assert.NoError(t, err)
+		}
+	}
+
+	searched, err := e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{Query: "service in (payments,billing) and status!=success"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), searched.TotalCount)
+	for _, event := range searched.Events {
+		assert.Equal(t, v1alpha1.Status_failure, event.Attributes.Status)
+	}
+
+	stats, err := e.GetEventStats(ctx, &v1alpha1.GetEventStatsRequest{
+		StartDate: now.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   now.AddDate(0, 0, 1).Format("2006-01-02"),
+		Service:   "search",
+		Query:     `not owner~"bob*"`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stats.TotalCount)
+
+	_, err = e.SearchEvents(ctx, &v1alpha1.SearchEventsRequest{Query: "service in (payments"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "position 21")
+}
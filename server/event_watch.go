@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventChanges is the feed of event changes shared by every service of the process
+var eventChanges = newChangeFeed[*v1alpha1.WatchEventsResponse](watchBacklog)
+
+// publishEvent tells the watchers about a change of event
+func publishEvent(changes *changeFeed[*v1alpha1.WatchEventsResponse], kind v1alpha1.EventChangeKind, event *v1alpha1.Event, entry *v1alpha1.ChangelogEntry) {
+	if changes == nil {
+		return
+	}
+	changes.publish(&v1alpha1.WatchEventsResponse{
+		Kind:      kind,
+		Event:     proto.Clone(event).(*v1alpha1.Event),
+		Entry:     entry,
+		Timestamp: timestamppb.Now(),
+	})
+}
+
+// publishEventUpdate tells the watchers about an update of event, with the
+// attributes it had before so that the watchers it leaves hear of it
+func publishEventUpdate(changes *changeFeed[*v1alpha1.WatchEventsResponse], previous *v1alpha1.Event, event *v1alpha1.Event) {
+	if changes == nil {
+		return
+	}
+	changes.publish(&v1alpha1.WatchEventsResponse{
+		Kind:               v1alpha1.EventChangeKind_event_updated,
+		Event:              proto.Clone(event).(*v1alpha1.Event),
+		PreviousAttributes: proto.Clone(previous.GetAttributes()).(*v1alpha1.EventAttributes),
+		Timestamp:          timestamppb.Now(),
+	})
+}
+
+// watchesEvent tells whether attributes match the filter of i
+func watchesEvent(i *v1alpha1.WatchEventsRequest, attributes *v1alpha1.EventAttributes) bool {
+	switch {
+	case i.Source != "" && attributes.GetSource() != i.Source,
+		i.Type != v1alpha1.Type_TYPE_UNSPECIFIED && attributes.GetType() != i.Type,
+		i.Priority != v1alpha1.Priority_PRIORITY_UNSPECIFIED && attributes.GetPriority() != i.Priority,
+		i.Status != v1alpha1.Status_STATUS_UNSPECIFIED && attributes.GetStatus() != i.Status,
+		i.Service != "" && attributes.GetService() != i.Service,
+		i.Environment != v1alpha1.Environment_ENVIRONMENT_UNSPECIFIED && attributes.GetEnvironment() != i.Environment,
+		i.Impact != nil && attributes.GetImpact() != i.Impact.Value:
+		return false
+	}
+	return true
+}
+
+// watchEvents sends the changes of the events matching i until ctx is done
+func (e *Event) watchEvents(ctx context.Context, i *v1alpha1.WatchEventsRequest, send func(*v1alpha1.WatchEventsResponse) error) error {
+	return watch(ctx, e.changes, i.ResumeToken,
+		func(change *v1alpha1.WatchEventsResponse) (*v1alpha1.WatchEventsResponse, bool) {
+			if watchesEvent(i, change.Event.GetAttributes()) {
+				return change, true
+			}
+			// L'événement sort du filtre : le watcher l'apprend par un event_left
+			if change.Kind != v1alpha1.EventChangeKind_event_updated || change.PreviousAttributes == nil || !watchesEvent(i, change.PreviousAttributes) {
+				return change, false
+			}
+			left := proto.Clone(change).(*v1alpha1.WatchEventsResponse)
+			left.Kind = v1alpha1.EventChangeKind_event_left
+			return left, true
+		},
+		func(change *v1alpha1.WatchEventsResponse, token string) *v1alpha1.WatchEventsResponse {
+			withToken := &v1alpha1.WatchEventsResponse{}
+			if change != nil {
+				withToken = proto.Clone(change).(*v1alpha1.WatchEventsResponse)
+			}
+			withToken.ResumeToken = token
+			return withToken
+		},
+		send,
+	)
+}
+
+func (e *Event) WatchEvents(
+	i *v1alpha1.WatchEventsRequest,
+	stream v1alpha1.EventService_WatchEventsServer,
+) error {
+
+	e.logger.Info("events watched", "service", i.Service, "environment", i.Environment.String(), "resumed", i.ResumeToken != "")
+	return e.watchEvents(stream.Context(), i, stream.Send)
+}
+
+// RegisterEventWatchHandler serves WatchEvents as Server-Sent Events on GET /api/v1alpha1/events/watch.
+// The filter is given as query parameters; each change is an SSE event named after its kind,
+// whose id is its resume token.
+func RegisterEventWatchHandler(mux *runtime.ServeMux, events *Event) error {
+	return mux.HandlePath("GET", "/api/v1alpha1/events/watch", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		serveWatch(mux, w, r, &v1alpha1.WatchEventsRequest{},
+			func(request *v1alpha1.WatchEventsRequest, token string) { request.ResumeToken = token },
+			func(ctx context.Context, request *v1alpha1.WatchEventsRequest, send func(string, string, proto.Message) error) error {
+				return events.watchEvents(ctx, request, func(change *v1alpha1.WatchEventsResponse) error {
+					kind := "watching"
+					if change.Kind != v1alpha1.EventChangeKind_EVENT_CHANGE_KIND_UNSPECIFIED {
+						kind = change.Kind.String()
+					}
+					return send(change.ResumeToken, kind, change)
+				})
+			})
+	})
+}
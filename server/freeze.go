@@ -0,0 +1,493 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	"github.com/bananaops/tracker/internal/config"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Freeze struct {
+	v1alpha1.UnimplementedFreezeServiceServer
+	store        store.FreezeStore
+	catalogStore store.CatalogStore
+	logger       *slog.Logger
+}
+
+func NewFreeze() *Freeze {
+	return &Freeze{
+		UnimplementedFreezeServiceServer: v1alpha1.UnimplementedFreezeServiceServer{},
+		store:                            store.NewFreezeStore(config.ConfigDatabase.FreezeCollection),
+		catalogStore:                     store.NewCatalogStore(config.ConfigDatabase.CatalogCollection),
+		logger:                           slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+	}
+}
+
+func (e *Freeze) CreateFreeze(
+	ctx context.Context,
+	i *v1alpha1.CreateFreezeRequest,
+) (*v1alpha1.CreateFreezeResponse, error) {
+
+	var freeze = &v1alpha1.Freeze{
+		Name:        i.Name,
+		Reason:      i.Reason,
+		Environment: i.Environment,
+		Services:    i.Services,
+		SlaLevels:   i.SlaLevels,
+		Start:       i.Start,
+		End:         i.End,
+		Recurrence:  i.Recurrence,
+		CreatedBy:   i.CreatedBy,
+	}
+	if err := validateFreeze(freeze); err != nil {
+		return nil, err
+	}
+
+	freeze, err := e.store.Create(ctx, freeze)
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("freeze created",
+		"id", freeze.Id,
+		"name", freeze.Name,
+		"environment", freeze.Environment,
+		"created_by", freeze.CreatedBy,
+	)
+
+	return &v1alpha1.CreateFreezeResponse{Freeze: freeze}, nil
+}
+
+func (e *Freeze) GetFreeze(
+	ctx context.Context,
+	i *v1alpha1.GetFreezeRequest,
+) (*v1alpha1.GetFreezeResponse, error) {
+
+	freeze, err := e.get(ctx, i.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.GetFreezeResponse{Freeze: freeze}, nil
+}
+
+// UpdateFreeze replaces the definition of a freeze, its exceptions are kept
+func (e *Freeze) UpdateFreeze(
+	ctx context.Context,
+	i *v1alpha1.UpdateFreezeRequest,
+) (*v1alpha1.UpdateFreezeResponse, error) {
+
+	freeze, err := e.get(ctx, i.Id)
+	if err != nil {
+		return nil, err
+	}
+	freeze.Name = i.Name
+	freeze.Reason = i.Reason
+	freeze.Environment = i.Environment
+	freeze.Services = i.Services
+	freeze.SlaLevels = i.SlaLevels
+	freeze.Start = i.Start
+	freeze.End = i.End
+	freeze.Recurrence = i.Recurrence
+	freeze.UpdatedAt = timestamppb.Now()
+	if err := validateFreeze(freeze); err != nil {
+		return nil, err
+	}
+
+	freeze, err = e.store.Update(ctx, map[string]interface{}{"id": freeze.Id}, freeze)
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("freeze updated", "id", freeze.Id, "name", freeze.Name, "environment", freeze.Environment)
+
+	return &v1alpha1.UpdateFreezeResponse{Freeze: freeze}, nil
+}
+
+func (e *Freeze) DeleteFreeze(
+	ctx context.Context,
+	i *v1alpha1.DeleteFreezeRequest,
+) (*v1alpha1.DeleteFreezeResponse, error) {
+
+	deleted, err := e.store.Delete(ctx, map[string]interface{}{"id": i.Id})
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, status.Errorf(codes.NotFound, "no freeze found in tracker for id %s", i.Id)
+	}
+
+	e.logger.Info("freeze deleted", "id", i.Id)
+
+	return &v1alpha1.DeleteFreezeResponse{Id: i.Id}, nil
+}
+
+func (e *Freeze) ListFreezes(
+	ctx context.Context,
+	i *v1alpha1.ListFreezesRequest,
+) (*v1alpha1.ListFreezesResponse, error) {
+
+	filter := bson.D{}
+	if i.Environment != "" {
+		filter = bson.D{{Key: "environment", Value: i.Environment}}
+	}
+
+	p, err := newPagination(i.PerPage, nil, "", i.PageToken, freezeSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var freezesResult = &v1alpha1.ListFreezesResponse{}
+	freezes, err := e.store.Find(ctx, p.filter(filter), p.options())
+	if err != nil {
+		return nil, err
+	}
+	freezesResult.Freezes, freezesResult.NextPageToken, err = paginate(p, freezes)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := e.store.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	freezesResult.TotalCount = uint32(count)
+
+	return freezesResult, nil
+}
+
+// GetActiveFreezes returns the freezes in force at i.At, now by default. With a
+// service, only the freezes of this service, or of its SLA level, are returned.
+func (e *Freeze) GetActiveFreezes(
+	ctx context.Context,
+	i *v1alpha1.GetActiveFreezesRequest,
+) (*v1alpha1.GetActiveFreezesResponse, error) {
+
+	at := time.Now()
+	if i.At != nil {
+		if err := i.At.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid at: %v", err)
+		}
+		at = i.At.AsTime()
+	}
+
+	active, err := e.activeFreezes(ctx, i.Environment, i.Service, at)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.GetActiveFreezesResponse{Freezes: active}, nil
+}
+
+// RequestFreezeException asks to let a service deploy during a freeze. The
+// exception is only usable once approved.
+func (e *Freeze) RequestFreezeException(
+	ctx context.Context,
+	i *v1alpha1.RequestFreezeExceptionRequest,
+) (*v1alpha1.RequestFreezeExceptionResponse, error) {
+
+	if i.Service == "" || i.RequestedBy == "" || i.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "service, requested_by and reason are required to request a freeze exception")
+	}
+	freeze, err := e.get(ctx, i.FreezeId)
+	if err != nil {
+		return nil, err
+	}
+	if len(freeze.Services) > 0 && !slices.Contains(freeze.Services, i.Service) {
+		return nil, status.Errorf(codes.InvalidArgument, "freeze %s does not freeze service %s", freeze.Id, i.Service)
+	}
+
+	exception := &v1alpha1.FreezeException{
+		Id:          uuid.New().String(),
+		Service:     i.Service,
+		RequestedBy: i.RequestedBy,
+		Reason:      i.Reason,
+		RequestedAt: timestamppb.Now(),
+	}
+	if _, err := e.store.AddException(ctx, freeze.Id, exception); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no freeze found in tracker for id %s", freeze.Id)
+		}
+		return nil, err
+	}
+
+	e.logger.Info("freeze exception requested",
+		"freeze_id", freeze.Id,
+		"exception_id", exception.Id,
+		"service", exception.Service,
+		"requested_by", exception.RequestedBy,
+	)
+
+	return &v1alpha1.RequestFreezeExceptionResponse{Exception: exception}, nil
+}
+
+// ApproveFreezeException approves an exception. Nobody approves their own.
+func (e *Freeze) ApproveFreezeException(
+	ctx context.Context,
+	i *v1alpha1.ApproveFreezeExceptionRequest,
+) (*v1alpha1.ApproveFreezeExceptionResponse, error) {
+
+	if i.ApprovedBy == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "approved_by is required to approve a freeze exception")
+	}
+	freeze, err := e.get(ctx, i.FreezeId)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(freeze.Exceptions, func(exception *v1alpha1.FreezeException) bool { return exception.Id == i.ExceptionId })
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "no exception %s found for freeze %s", i.ExceptionId, freeze.Id)
+	}
+	exception := freeze.Exceptions[index]
+	if exception.ApprovedBy != "" {
+		return &v1alpha1.ApproveFreezeExceptionResponse{Exception: exception}, nil
+	}
+	if exception.RequestedBy == i.ApprovedBy {
+		return nil, status.Errorf(codes.PermissionDenied, "exception %s was requested by %s, someone else must approve it", exception.Id, exception.RequestedBy)
+	}
+
+	freeze, err = e.store.ApproveException(ctx, freeze.Id, exception.Id, i.ApprovedBy, timestamppb.Now())
+	if errors.Is(err, store.ErrNotFound) {
+		// Approuvée entre-temps par quelqu'un d'autre, ou le freeze a été supprimé
+		freeze, err = e.get(ctx, i.FreezeId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	index = slices.IndexFunc(freeze.Exceptions, func(exception *v1alpha1.FreezeException) bool { return exception.Id == i.ExceptionId })
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "no exception %s found for freeze %s", i.ExceptionId, freeze.Id)
+	}
+	exception = freeze.Exceptions[index]
+
+	e.logger.Info("freeze exception approved",
+		"freeze_id", freeze.Id,
+		"exception_id", exception.Id,
+		"service", exception.Service,
+		"approved_by", exception.ApprovedBy,
+	)
+
+	return &v1alpha1.ApproveFreezeExceptionResponse{Exception: exception}, nil
+}
+
+func (e *Freeze) get(ctx context.Context, id string) (*v1alpha1.Freeze, error) {
+	freeze, err := e.store.Get(ctx, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no freeze found in tracker for id %s", id)
+	}
+	return freeze, nil
+}
+
+// activeFreezes returns the freezes of environment in force at, with the end
+// of each. With a service, only the freezes applying to it are kept.
+func (e *Freeze) activeFreezes(ctx context.Context, environment string, service string, at time.Time) ([]*v1alpha1.ActiveFreeze, error) {
+	freezes, err := e.store.Find(ctx, store.FreezesAt(environment, at), store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var level string
+	if service != "" {
+		if catalog, err := e.catalogStore.Get(ctx, map[string]interface{}{"name": service}); err == nil {
+			level = catalog.GetSla().GetLevel().String()
+		}
+	}
+
+	var active []*v1alpha1.ActiveFreeze
+	for _, freeze := range freezes {
+		until, ok := freezeUntil(freeze, at)
+		if !ok {
+			continue
+		}
+		if service != "" && !freezesService(freeze, service, level) {
+			continue
+		}
+		active = append(active, &v1alpha1.ActiveFreeze{Freeze: freeze, Until: timestamppb.New(until)})
+	}
+	return active, nil
+}
+
+// blockingFreeze returns the freeze refusing a deployment of service in
+// environment now, nil when none does. exceptionId lets the service through the
+// freeze owning this approved exception, which is returned along.
+func (e *Freeze) blockingFreeze(ctx context.Context, environment string, service string, exceptionId string) (*v1alpha1.ActiveFreeze, *v1alpha1.FreezeException, error) {
+	active, err := e.activeFreezes(ctx, environment, service, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var used *v1alpha1.FreezeException
+	for _, freeze := range active {
+		if exception := approvedException(freeze.Freeze, service, exceptionId); exception != nil {
+			used = exception
+			continue
+		}
+		return freeze, nil, nil
+	}
+	return nil, used, nil
+}
+
+// approvedException returns the exception id of freeze when it is approved for service
+func approvedException(freeze *v1alpha1.Freeze, service string, id string) *v1alpha1.FreezeException {
+	if id == "" {
+		return nil
+	}
+	for _, exception := range freeze.Exceptions {
+		if exception.Id == id && exception.Service == service && exception.ApprovedBy != "" {
+			return exception
+		}
+	}
+	return nil
+}
+
+// freezesService reports whether freeze applies to service, whose SLA level in
+// the catalog is level. A service without level escapes the freezes of levels.
+func freezesService(freeze *v1alpha1.Freeze, service string, level string) bool {
+	if len(freeze.Services) > 0 && !slices.Contains(freeze.Services, service) {
+		return false
+	}
+	return len(freeze.SlaLevels) == 0 || slices.Contains(freeze.SlaLevels, level)
+}
+
+// freezeUntil returns the end of freeze when it is in force at: the end of its
+// period, or of the current window of its recurrence
+func freezeUntil(freeze *v1alpha1.Freeze, at time.Time) (time.Time, bool) {
+	if freeze.Start != nil && at.Before(freeze.Start.AsTime()) {
+		return time.Time{}, false
+	}
+	if freeze.End != nil && !at.Before(freeze.End.AsTime()) {
+		return time.Time{}, false
+	}
+	if freeze.Recurrence == nil {
+		return freeze.End.AsTime(), true
+	}
+
+	end, ok := recurrenceWindow(freeze.Recurrence, at)
+	if !ok {
+		return time.Time{}, false
+	}
+	if freeze.End != nil && freeze.End.AsTime().Before(end) {
+		end = freeze.End.AsTime()
+	}
+	return end, true
+}
+
+// recurrenceWindow returns the end of the window of recurrence including at. The
+// window of the day before is checked too, as it may run past midnight.
+func recurrenceWindow(recurrence *v1alpha1.Recurrence, at time.Time) (time.Time, bool) {
+	location, err := time.LoadLocation(recurrence.TimeZone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	local := at.In(location)
+	for _, offset := range []int{0, -1} {
+		day := local.AddDate(0, 0, offset)
+		if !slices.Contains(recurrence.Days, weekday(day.Weekday())) {
+			continue
+		}
+		start := atClock(day, recurrence.StartTime)
+		end := atClock(day.AddDate(0, 0, 1), "00:00")
+		if recurrence.EndTime != "" {
+			end = atClock(day, recurrence.EndTime)
+			if !end.After(start) {
+				end = end.AddDate(0, 0, 1)
+			}
+		}
+		if !local.Before(start) && local.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// atClock returns the date of day at clock, "HH:MM" in the location of day
+func atClock(day time.Time, clock string) time.Time {
+	parsed, _ := time.Parse("15:04", clock)
+	return time.Date(day.Year(), day.Month(), day.Day(), parsed.Hour(), parsed.Minute(), 0, 0, day.Location())
+}
+
+func weekday(day time.Weekday) v1alpha1.Weekday {
+	if day == time.Sunday {
+		return v1alpha1.Weekday_sunday
+	}
+	return v1alpha1.Weekday(day)
+}
+
+func validateFreeze(freeze *v1alpha1.Freeze) error {
+	if freeze.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if value, ok := eventv1alpha1.Environment_value[freeze.Environment]; !ok || value == int32(eventv1alpha1.Environment_ENVIRONMENT_UNSPECIFIED) {
+		return status.Errorf(codes.InvalidArgument, "unknown environment %q", freeze.Environment)
+	}
+	for _, service := range freeze.Services {
+		if service == "" || strings.Contains(service, "*") {
+			return status.Errorf(codes.InvalidArgument, "services must be service names, leave them empty to freeze every service")
+		}
+	}
+	for _, level := range freeze.SlaLevels {
+		if value, ok := catalogv1alpha1.SLALevel_value[level]; !ok || value == int32(catalogv1alpha1.SLALevel_SLA_LEVEL_UNSPECIFIED) {
+			return status.Errorf(codes.InvalidArgument, "unknown SLA level %q", level)
+		}
+	}
+	if freeze.Start != nil && freeze.Start.CheckValid() != nil || freeze.End != nil && freeze.End.CheckValid() != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid start or end")
+	}
+	if freeze.Recurrence == nil && (freeze.Start == nil || freeze.End == nil) {
+		return status.Errorf(codes.InvalidArgument, "start and end are required, unless the freeze has a recurrence")
+	}
+	if freeze.Start != nil && freeze.End != nil && !freeze.End.AsTime().After(freeze.Start.AsTime()) {
+		return status.Errorf(codes.InvalidArgument, "end must be after start")
+	}
+	if freeze.Recurrence != nil {
+		return validateRecurrence(freeze.Recurrence)
+	}
+	return nil
+}
+
+func validateRecurrence(recurrence *v1alpha1.Recurrence) error {
+	if len(recurrence.Days) == 0 {
+		return status.Errorf(codes.InvalidArgument, "recurrence.days is required")
+	}
+	for _, day := range recurrence.Days {
+		if _, ok := v1alpha1.Weekday_name[int32(day)]; !ok || day == v1alpha1.Weekday_WEEKDAY_UNSPECIFIED {
+			return status.Errorf(codes.InvalidArgument, "unknown day %v in recurrence.days", day)
+		}
+	}
+	// a window without start_time starts at midnight
+	if recurrence.StartTime == "" {
+		recurrence.StartTime = "00:00"
+	}
+	for _, clock := range []string{recurrence.StartTime, recurrence.EndTime} {
+		if _, err := time.Parse("15:04", clock); clock != "" && err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid time %q in recurrence, expected HH:MM", clock)
+		}
+	}
+	if _, err := time.LoadLocation(recurrence.TimeZone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "unknown time zone %q in recurrence", recurrence.TimeZone)
+	}
+	return nil
+}
+
+// frozenStatus is the error refusing a deployment during a freeze
+func frozenStatus(active *v1alpha1.ActiveFreeze, service string) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("cannot create event: deployments of %s in %s are frozen by %s until %s (freeze_id: %s). Request an exception to deploy anyway",
+		service, active.Freeze.Environment, active.Freeze.Name, active.Until.AsTime().Format(time.RFC3339), active.Freeze.Id))
+	if detailed, err := st.WithDetails(active.Freeze); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+)
+
+func newTestFreeze(t *testing.T) *Freeze {
+	return &Freeze{
+		store:        store.NewMemoryStoreFreeze(t.Name() + "/freezes"),
+		catalogStore: store.NewMemoryStoreCatalog(t.Name() + "/catalog"),
+		logger:       slog.New(slog.NewJSONHandler(io.Discard, nil)),
+	}
+}
+
+func TestRecurrenceWindow(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+	fridays := &v1alpha1.Recurrence{Days: []v1alpha1.Weekday{v1alpha1.Weekday_friday}, StartTime: "16:00", TimeZone: "Europe/Paris"}
+	nights := &v1alpha1.Recurrence{Days: []v1alpha1.Weekday{v1alpha1.Weekday_sunday}, StartTime: "22:00", EndTime: "06:00"}
+
+	for _, tc := range []struct {
+		recurrence *v1alpha1.Recurrence
+		at         time.Time
+		until      time.Time
+		active     bool
+	}{
+		// 2025-03-14 is a Friday
+		{fridays, time.Date(2025, 3, 14, 15, 59, 0, 0, paris), time.Time{}, false},
+		{fridays, time.Date(2025, 3, 14, 16, 0, 0, 0, paris), time.Date(2025, 3, 15, 0, 0, 0, 0, paris), true},
+		{fridays, time.Date(2025, 3, 14, 15, 30, 0, 0, time.UTC), time.Date(2025, 3, 15, 0, 0, 0, 0, paris), true},
+		{fridays, time.Date(2025, 3, 15, 9, 0, 0, 0, paris), time.Time{}, false},
+		{nights, time.Date(2025, 3, 16, 23, 0, 0, 0, time.UTC), time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC), true},
+		{nights, time.Date(2025, 3, 17, 5, 0, 0, 0, time.UTC), time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC), true},
+		{nights, time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC), time.Time{}, false},
+	} {
+		until, active := recurrenceWindow(tc.recurrence, tc.at)
+		assert.Equal(t, tc.active, active, "%v", tc.at)
+		assert.True(t, tc.until.Equal(until), "%v: until %v, expected %v", tc.at, until, tc.until)
+	}
+}
+
+func TestFreezeExceptions(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFreeze(t)
+	now := time.Now()
+
+	for _, invalid := range []*v1alpha1.CreateFreezeRequest{
+		{Name: "no period", Environment: "production"},
+		{Name: "reversed", Environment: "production", Start: timestamppb.New(now), End: timestamppb.New(now.Add(-time.Hour))},
+		{Name: "moon", Environment: "moon", Start: timestamppb.New(now), End: timestamppb.New(now.Add(time.Hour))},
+		{Name: "level", Environment: "production", SlaLevels: []string{"gold"}, Start: timestamppb.New(now), End: timestamppb.New(now.Add(time.Hour))},
+		{Name: "days", Environment: "production", Recurrence: &v1alpha1.Recurrence{StartTime: "16:00"}},
+		{Name: "clock", Environment: "production", Recurrence: &v1alpha1.Recurrence{Days: []v1alpha1.Weekday{v1alpha1.Weekday_friday}, StartTime: "4pm"}},
+		{Environment: "production", Start: timestamppb.New(now), End: timestamppb.New(now.Add(time.Hour))},
+	} {
+		_, err := f.CreateFreeze(ctx, invalid)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", invalid)
+	}
+
+	created, err := f.CreateFreeze(ctx, &v1alpha1.CreateFreezeRequest{Name: "black friday", Environment: "production", Services: []string{"payments"},
+		Start: timestamppb.New(now.Add(-time.Hour)), End: timestamppb.New(now.Add(time.Hour)), CreatedBy: "cab"})
+	assert.NoError(t, err)
+
+	_, err = f.RequestFreezeException(ctx, &v1alpha1.RequestFreezeExceptionRequest{FreezeId: created.Freeze.Id, Service: "billing", RequestedBy: "alice", Reason: "hotfix"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "billing is not frozen")
+	requested, err := f.RequestFreezeException(ctx, &v1alpha1.RequestFreezeExceptionRequest{FreezeId: created.Freeze.Id, Service: "payments", RequestedBy: "alice", Reason: "hotfix"})
+	assert.NoError(t, err)
+
+	_, err = f.ApproveFreezeException(ctx, &v1alpha1.ApproveFreezeExceptionRequest{FreezeId: created.Freeze.Id, ExceptionId: requested.Exception.Id, ApprovedBy: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	approved, err := f.ApproveFreezeException(ctx, &v1alpha1.ApproveFreezeExceptionRequest{FreezeId: created.Freeze.Id, ExceptionId: requested.Exception.Id, ApprovedBy: "bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", approved.Exception.ApprovedBy)
+
+	updated, err := f.UpdateFreeze(ctx, &v1alpha1.UpdateFreezeRequest{Id: created.Freeze.Id, Name: "black friday week", Environment: "production", Services: []string{"payments"},
+		Start: created.Freeze.Start, End: timestamppb.New(now.Add(7 * 24 * time.Hour))})
+	assert.NoError(t, err)
+	assert.Len(t, updated.Freeze.Exceptions, 1, "the exceptions survive an update")
+	assert.Equal(t, "cab", updated.Freeze.CreatedBy)
+
+	list, err := f.ListFreezes(ctx, &v1alpha1.ListFreezesRequest{Environment: "production", PerPage: wrapperspb.UInt32(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), list.TotalCount)
+
+	_, err = f.DeleteFreeze(ctx, &v1alpha1.DeleteFreezeRequest{Id: created.Freeze.Id})
+	assert.NoError(t, err)
+	_, err = f.GetFreeze(ctx, &v1alpha1.GetFreezeRequest{Id: created.Freeze.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestConcurrentFreezeExceptions(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFreeze(t)
+	now := time.Now()
+	created, err := f.CreateFreeze(ctx, &v1alpha1.CreateFreezeRequest{Name: "release", Environment: "production",
+		Start: timestamppb.New(now.Add(-time.Hour)), End: timestamppb.New(now.Add(time.Hour))})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for n := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := f.RequestFreezeException(ctx, &v1alpha1.RequestFreezeExceptionRequest{FreezeId: created.Freeze.Id, Service: fmt.Sprintf("service-%d", n), RequestedBy: "alice", Reason: "hotfix"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	freeze, err := f.GetFreeze(ctx, &v1alpha1.GetFreezeRequest{Id: created.Freeze.Id})
+	assert.NoError(t, err)
+	assert.Len(t, freeze.Freeze.Exceptions, 10, "no request overwrites another")
+
+	approvers := []string{"bob", "carol"}
+	approvals := make([]*v1alpha1.FreezeException, len(approvers))
+	for n, approver := range approvers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			approved, err := f.ApproveFreezeException(ctx, &v1alpha1.ApproveFreezeExceptionRequest{FreezeId: created.Freeze.Id, ExceptionId: freeze.Freeze.Exceptions[0].Id, ApprovedBy: approver})
+			assert.NoError(t, err)
+			approvals[n] = approved.GetException()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, approvals[0].GetApprovedBy(), approvals[1].GetApprovedBy(), "the exception is approved once")
+
+	// Une mise à jour partie d'une lecture antérieure ne perd pas les exceptions
+	_, err = f.store.Update(ctx, map[string]interface{}{"id": created.Freeze.Id}, created.Freeze)
+	assert.NoError(t, err)
+	freeze, err = f.GetFreeze(ctx, &v1alpha1.GetFreezeRequest{Id: created.Freeze.Id})
+	assert.NoError(t, err)
+	assert.Len(t, freeze.Freeze.Exceptions, 10)
+	assert.Equal(t, approvals[0].GetApprovedBy(), freeze.Freeze.Exceptions[0].ApprovedBy)
+}
+
+func TestCreateEventDuringFreeze(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	f := e.freezeService
+	now := time.Now()
+
+	_, err := f.catalogStore.Update(ctx, map[string]interface{}{"name": "payments"}, &catalogv1alpha1.Catalog{Name: "payments",
+		Sla: &catalogv1alpha1.SLA{Level: catalogv1alpha1.SLALevel_critical}})
+	assert.NoError(t, err)
+	frozen, err := f.CreateFreeze(ctx, &v1alpha1.CreateFreezeRequest{Name: "end of year", Environment: "production", SlaLevels: []string{"critical"},
+		Start: timestamppb.New(now.Add(-time.Hour)), End: timestamppb.New(now.Add(time.Hour))})
+	assert.NoError(t, err)
+
+	active, err := f.GetActiveFreezes(ctx, &v1alpha1.GetActiveFreezesRequest{Environment: "production", Service: "payments"})
+	assert.NoError(t, err)
+	assert.Len(t, active.Freezes, 1)
+	active, err = f.GetActiveFreezes(ctx, &v1alpha1.GetActiveFreezesRequest{Environment: "production", Service: "billing"})
+	assert.NoError(t, err)
+	assert.Empty(t, active.Freezes, "billing has no critical SLA")
+	active, err = f.GetActiveFreezes(ctx, &v1alpha1.GetActiveFreezesRequest{Environment: "production", At: timestamppb.New(now.Add(2 * time.Hour))})
+	assert.NoError(t, err)
+	assert.Empty(t, active.Freezes)
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", eventv1alpha1.Status_start))
+	st := status.Convert(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "frozen by end of year")
+	if assert.Len(t, st.Details(), 1) {
+		assert.Equal(t, frozen.Freeze.Id, st.Details()[0].(*v1alpha1.Freeze).Id)
+	}
+
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", eventv1alpha1.Status_success))
+	assert.NoError(t, err, "the end of a deployment started before the freeze is reported")
+	_, err = e.CreateEvent(ctx, deploymentRequest("billing", eventv1alpha1.Status_start))
+	assert.NoError(t, err, "billing is not frozen")
+
+	exception, err := f.RequestFreezeException(ctx, &v1alpha1.RequestFreezeExceptionRequest{FreezeId: frozen.Freeze.Id, Service: "payments", RequestedBy: "alice", Reason: "hotfix"})
+	assert.NoError(t, err)
+	request := deploymentRequest("payments", eventv1alpha1.Status_start)
+	request.FreezeExceptionId = exception.Exception.Id
+	_, err = e.CreateEvent(ctx, request)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the exception is not approved yet")
+
+	_, err = f.ApproveFreezeException(ctx, &v1alpha1.ApproveFreezeExceptionRequest{FreezeId: frozen.Freeze.Id, ExceptionId: exception.Exception.Id, ApprovedBy: "bob"})
+	assert.NoError(t, err)
+	created, err := e.CreateEvent(ctx, request)
+	if assert.NoError(t, err) {
+		assert.Contains(t, created.Event.Changelog[1].Comment, "approved by bob")
+	}
+}
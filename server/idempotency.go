@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/textproto"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyHeader carries the idempotency key of CreateEvent over HTTP
+const idempotencyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds the length of an idempotency key
+const maxIdempotencyKeyLength = 255
+
+// idempotencyWindow is how long a retry with the same idempotency key returns
+// the event created by the first request
+var idempotencyWindow = 24 * time.Hour
+
+// idempotencyLease is how long a creation in progress holds its idempotency
+// key, before another request may take it over
+var idempotencyLease = time.Minute
+
+// idempotencyKey returns the idempotency key of a request: its field, else
+// the Idempotency-Key header, empty when the request has none
+func idempotencyKey(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(idempotencyHeader); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency key is %d bytes long, at most %d are allowed", len(key), maxIdempotencyKeyLength)
+	}
+	return key, nil
+}
+
+// requestHash fingerprints a request, whatever the way its idempotency key
+// was sent
+func requestHash(i *v1alpha1.CreateEventRequest) (string, error) {
+	request := proto.Clone(i).(*v1alpha1.CreateEventRequest)
+	request.IdempotencyKey = ""
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// reserveIdempotencyKey reserves key for the creation of the request hash,
+// on every instance of the tracker. It returns the response to the request
+// that created an event with the key within the window instead. The key
+// cannot be reused for another request, nor while its creation is in
+// progress.
+func (e *Event) reserveIdempotencyKey(ctx context.Context, key string, hash string) (*store.Idempotency, *v1alpha1.CreateEventResponse, error) {
+	now := time.Now()
+	reservation := &store.Idempotency{
+		Id:          uuid.New().String(),
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(idempotencyLease),
+	}
+	held, reserved, err := e.idempotency.Reserve(ctx, reservation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reserved {
+		return reservation, nil, nil
+	}
+
+	if held.RequestHash != hash {
+		return nil, nil, status.Errorf(codes.AlreadyExists, "idempotency key %q was already used by another request", key)
+	}
+	if held.EventId == "" {
+		return nil, nil, status.Errorf(codes.Unavailable, "the request with idempotency key %q is still in progress, retry later", key)
+	}
+	event, err := e.store.Get(ctx, map[string]interface{}{"metadata.id": held.EventId})
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, nil, status.Errorf(codes.NotFound, "event %s created for idempotency key %q was deleted", held.EventId, key)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	e.logger.Info("event creation replayed",
+		"idempotency_key", key,
+		"id", held.EventId,
+	)
+	return nil, &v1alpha1.CreateEventResponse{Event: event, Lock: e.eventLock(ctx, held.EventId)}, nil
+}
+
+// completeIdempotencyKey records the event created for reservation, returned
+// to the retries within the window
+func (e *Event) completeIdempotencyKey(ctx context.Context, reservation *store.Idempotency, eventId string) {
+	reservation.EventId = eventId
+	reservation.ExpiresAt = time.Now().Add(idempotencyWindow)
+	if err := e.idempotency.Complete(ctx, reservation); err != nil {
+		e.logger.Warn("idempotency key not recorded, a retry may create the event again",
+			"idempotency_key", reservation.Key,
+			"id", eventId,
+			"error", err,
+		)
+	}
+}
+
+// releaseIdempotencyKey frees the key of reservation when the creation
+// failed, for the next retry
+func (e *Event) releaseIdempotencyKey(reservation *store.Idempotency) {
+	if reservation.EventId != "" {
+		return
+	}
+	// la requête peut avoir été annulée, la clé doit être libérée quand même
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := e.idempotency.Release(ctx, reservation); err != nil {
+		e.logger.Warn("idempotency key not released, retries wait for its lease",
+			"idempotency_key", reservation.Key,
+			"error", err,
+		)
+	}
+}
+
+// eventLock returns the lock taken for an event, nil when it holds none
+func (e *Event) eventLock(ctx context.Context, eventId string) *lock.Lock {
+	held, err := e.lockService.store.Get(ctx, map[string]interface{}{"eventid": eventId})
+	if err != nil || held == nil || held.Id == "" {
+		return nil
+	}
+	return held
+}
+
+// idempotencyHeaderMatcher forwards the Idempotency-Key header to CreateEvent,
+// and the other headers as the gateway does by default
+func idempotencyHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == idempotencyHeader {
+		return idempotencyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
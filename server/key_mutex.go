@@ -0,0 +1,43 @@
+package server
+
+import "sync"
+
+// keyMutex is a mutex per key: holders of different keys do not wait for each
+// other. The zero value is ready to use, and a key takes no memory once
+// unlocked.
+type keyMutex[K comparable] struct {
+	mu    sync.Mutex
+	locks map[K]*keyLock
+}
+
+type keyLock struct {
+	mu sync.Mutex
+	// users counts the callers holding or waiting for the lock
+	users int
+}
+
+// lock locks key and returns the function unlocking it
+func (m *keyMutex[K]) lock(key K) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[K]*keyLock{}
+	}
+	l := m.locks[key]
+	if l == nil {
+		l = &keyLock{}
+		m.locks[key] = l
+	}
+	l.users++
+	m.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		m.mu.Lock()
+		l.users--
+		if l.users == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
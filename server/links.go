@@ -10,11 +10,11 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 )
 
-var linksStore *store.LinksStoreClient
+var linksStore store.LinksStore
 
 func initLinksStore() {
 	if linksStore == nil {
-		linksStore = store.NewStoreLinks("links")
+		linksStore = store.NewLinksStore("links")
 	}
 }
 
@@ -2,30 +2,46 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
+	"time"
 
 	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
 	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
 	"github.com/bananaops/tracker/internal/config"
 	store "github.com/bananaops/tracker/internal/stores"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Lock struct {
 	v1alpha1.UnimplementedLockServiceServer
-	store      store.LockStoreClient
-	eventStore *store.EventStoreClient
-	logger     *slog.Logger
+	store        store.LockStore
+	eventStore   store.EventStore
+	history      store.LockHistoryStore
+	reservations store.ReservationStore
+	logger       *slog.Logger
+	queue        *lockQueue
+	changes      *changeFeed[*v1alpha1.WatchLocksResponse]
+	eventChanges *changeFeed[*eventv1alpha1.WatchEventsResponse]
 }
 
 func NewLock() *Lock {
 	return &Lock{
 		UnimplementedLockServiceServer: v1alpha1.UnimplementedLockServiceServer{},
-		store:                          *store.NewStoreLock(config.ConfigDatabase.LockCollection),
-		eventStore:                     store.NewStoreEvent(config.ConfigDatabase.EventCollection),
+		store:                          store.NewLockStore(config.ConfigDatabase.LockCollection),
+		eventStore:                     store.NewEventStore(config.ConfigDatabase.EventCollection),
+		history:                        store.NewLockHistoryStore(config.ConfigDatabase.LockHistoryCollection),
+		reservations:                   store.NewReservationStore(config.ConfigDatabase.ReservationCollection),
 		logger:                         slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+		queue:                          lockWaiters,
+		changes:                        lockChanges,
+		eventChanges:                   eventChanges,
 	}
 }
 
@@ -42,20 +58,28 @@ func (e *Lock) CreateLock(
 		EventId:     i.EventId,
 	}
 
-	var lockResult = &v1alpha1.CreateLockResponse{}
 	var err error
-
-	// Check if lock is already present for service + environment + resource
-	filter := map[string]interface{}{
-		"service":     i.Service,
-		"environment": i.Environment,
-		"resource":    i.Resource,
+	if err = validateLockScope(lock); err != nil {
+		return nil, err
+	}
+	var mode v1alpha1.LockMode
+	lock.Resource, mode = lockResource(i.Resource, i.Mode)
+	if lock.Mode, err = lockMode(mode); err != nil {
+		return nil, err
+	}
+	if i.Ttl != nil {
+		if err := i.Ttl.CheckValid(); err != nil || i.Ttl.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be a positive duration")
+		}
+		lock.Ttl = i.Ttl
 	}
 
-	lockPresent, _ := e.store.Get(context.Background(), filter)
+	var lockResult = &v1alpha1.CreateLockResponse{}
 
-	// check if lock is already present
-	if len(lockPresent.Service) != 0 {
+	lockResult.Lock, err = e.acquireInTurn(ctx, lock)
+	var locked *store.LockedError
+	if errors.As(err, &locked) {
+		lockPresent := locked.Holder
 		e.logger.Error("service locking",
 			"service", lockPresent.Service,
 			"environment", lockPresent.Environment,
@@ -65,53 +89,149 @@ func (e *Lock) CreateLock(
 			"event_id", lockPresent.EventId,
 			"created_at", lockPresent.CreatedAt.AsTime(),
 		)
-		return nil, fmt.Errorf("service %s is already locked for %s in %s by %s (lock_id: %s, event_id: %s)",
-			lockPresent.Service, lockPresent.Resource, lockPresent.Environment,
-			lockPresent.Who, lockPresent.Id, lockPresent.EventId)
+		return nil, lockedStatus(locked)
 	}
-
-	lockResult.Lock, err = e.store.Create(context.Background(), lock)
 	if err != nil {
 		return nil, err
 	}
 
-	// Si un event_id est fourni, ajouter une entrée dans le changelog de l'événement
-	if lockResult.Lock.EventId != "" {
-		event, err := e.eventStore.Get(context.Background(), map[string]interface{}{"metadata.id": lockResult.Lock.EventId})
-		if err == nil {
-			// Ajouter l'entrée "locked" dans le changelog
-			entry := &eventv1alpha1.ChangelogEntry{
-				Timestamp:  timestamppb.Now(),
-				User:       lockResult.Lock.Who,
-				ChangeType: eventv1alpha1.ChangeType_locked,
-				Comment:    fmt.Sprintf("Service locked in %s", lockResult.Lock.Environment),
-			}
+	return lockResult, nil
+}
 
-			if event.Changelog == nil {
-				event.Changelog = []*eventv1alpha1.ChangelogEntry{}
-			}
-			event.Changelog = append(event.Changelog, entry)
+// acquire takes lock in the store, releasing its holder first when it has expired
+func (e *Lock) acquire(ctx context.Context, lock *v1alpha1.Lock) (*v1alpha1.Lock, error) {
+	if lock.Ttl != nil {
+		lock.ExpiresAt = timestamppb.New(time.Now().Add(lock.Ttl.AsDuration()))
+	}
 
-			// Mettre à jour l'événement
-			_, err = e.eventStore.Update(context.Background(), map[string]interface{}{"metadata.id": lockResult.Lock.EventId}, event)
-			if err != nil {
-				e.logger.Warn("failed to update event changelog for lock", "error", err, "event_id", lockResult.Lock.EventId)
-			}
+	// The store refuses the lock when service + environment + resource is already locked
+	acquired, err := e.store.Acquire(ctx, lock)
+	var locked *store.LockedError
+	if errors.As(err, &locked) && isExpired(locked.Holder, time.Now()) {
+		// the sweeper has not released the expired holder yet
+		if _, errExpire := e.expire(ctx, locked.Holder); errExpire != nil {
+			return nil, errExpire
 		}
+		acquired, err = e.store.Acquire(ctx, lock)
 	}
+	return acquired, err
+}
+
+// locked records a newly taken lock in the lock history, the changelog of its event and the logs
+func (e *Lock) locked(ctx context.Context, lock *v1alpha1.Lock) {
+	e.record(ctx, v1alpha1.LockHistoryAction_acquired, lock, lock.Who, "")
+
+	// Si un event_id est fourni, ajouter une entrée dans le changelog de l'événement
+	e.addEventChangelog(ctx, lock, eventv1alpha1.ChangeType_locked, lock.Who,
+		fmt.Sprintf("Service locked in %s", lock.Environment))
 
 	// log lock created to json format
 	e.logger.Info("lock created",
-		"service", lockResult.Lock.Service,
-		"environment", lockResult.Lock.Environment,
-		"resource", lockResult.Lock.Resource,
-		"who", lockResult.Lock.Who,
-		"id", lockResult.Lock.Id,
-		"event_id", lockResult.Lock.EventId,
-		"created_at", lockResult.Lock.CreatedAt.AsTime(),
+		"service", lock.Service,
+		"environment", lock.Environment,
+		"resource", lock.Resource,
+		"who", lock.Who,
+		"id", lock.Id,
+		"event_id", lock.EventId,
+		"created_at", lock.CreatedAt.AsTime(),
 	)
+}
 
-	return lockResult, nil
+// addEventChangelog appends an entry to the changelog of the event linked to lock, if any
+func (e *Lock) addEventChangelog(ctx context.Context, lock *v1alpha1.Lock, changeType eventv1alpha1.ChangeType, user string, comment string) {
+	if lock.EventId == "" {
+		return
+	}
+	entry := &eventv1alpha1.ChangelogEntry{
+		Timestamp:  timestamppb.Now(),
+		User:       user,
+		ChangeType: changeType,
+		Comment:    comment,
+	}
+
+	// Ajouter l'entrée sans réécrire l'événement, qui peut changer en parallèle
+	event, err := e.eventStore.AppendChangelog(ctx, map[string]interface{}{"metadata.id": lock.EventId}, entry)
+	if errors.Is(err, store.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		e.logger.Warn("failed to update event changelog for lock", "error", err, "event_id", lock.EventId, "change_type", changeType.String())
+		return
+	}
+	publishEvent(e.eventChanges, eventv1alpha1.EventChangeKind_changelog_added, event, entry)
+}
+
+// validateLockScope checks the scopes of lock: "*" locks every service or
+// resource of the environment, and "payments-*" every one with that prefix
+func validateLockScope(lock *v1alpha1.Lock) error {
+	for _, field := range []struct{ name, value string }{
+		{"service", lock.Service},
+		{"resource", lock.Resource},
+	} {
+		if strings.Contains(strings.TrimSuffix(field.value, "*"), "*") {
+			return status.Errorf(codes.InvalidArgument, "%s %q: * is only allowed at the end", field.name, field.value)
+		}
+	}
+	return nil
+}
+
+// lockMode checks mode, an unspecified mode is exclusive
+func lockMode(mode v1alpha1.LockMode) (v1alpha1.LockMode, error) {
+	switch mode {
+	case v1alpha1.LockMode_LOCK_MODE_UNSPECIFIED, v1alpha1.LockMode_exclusive:
+		return v1alpha1.LockMode_exclusive, nil
+	case v1alpha1.LockMode_shared:
+		return mode, nil
+	}
+	return mode, status.Errorf(codes.InvalidArgument, "unknown lock mode %d", mode)
+}
+
+// legacyOperationResource is the resource operations locked before they shared
+// the deployment resource, still sent by older clients
+const legacyOperationResource = "operation"
+
+// lockResource maps the legacy operation resource to the deployment resource,
+// shared unless another mode is asked, as getResourceType does for operation
+// events
+func lockResource(resource string, mode v1alpha1.LockMode) (string, v1alpha1.LockMode) {
+	if resource != legacyOperationResource {
+		return resource, mode
+	}
+	if mode == v1alpha1.LockMode_LOCK_MODE_UNSPECIFIED {
+		mode = v1alpha1.LockMode_shared
+	}
+	return "deployment", mode
+}
+
+// MigrateOperationLocks moves the locks taken on the legacy operation resource
+// to shared locks on the deployment resource. It returns how many it moved.
+func (e *Lock) MigrateOperationLocks(ctx context.Context) (int, error) {
+	locks, err := e.store.Find(ctx, bson.D{{Key: "resource", Value: legacyOperationResource}}, store.FindOptions{})
+	if err != nil {
+		return 0, err
+	}
+	for _, lock := range locks {
+		lock.Resource, lock.Mode = lockResource(lock.Resource, v1alpha1.LockMode_LOCK_MODE_UNSPECIFIED)
+		if _, err := e.store.Update(ctx, map[string]interface{}{"id": lock.Id}, lock); err != nil {
+			return 0, err
+		}
+		e.logger.Info("operation lock migrated", "id", lock.Id, "service", lock.Service, "environment", lock.Environment)
+	}
+	return len(locks), nil
+}
+
+// isLockScope tells whether lock covers several services or resources
+func isLockScope(lock *v1alpha1.Lock) bool {
+	return strings.HasSuffix(lock.Service, "*") || strings.HasSuffix(lock.Resource, "*")
+}
+
+// lockedStatus converts a LockedError to an AlreadyExists status carrying the holder
+func lockedStatus(locked *store.LockedError) error {
+	st := status.New(codes.AlreadyExists, locked.Error())
+	if detailed, err := st.WithDetails(locked.Holder); err == nil {
+		st = detailed
+	}
+	return st.Err()
 }
 
 func (e *Lock) GetLock(
@@ -126,6 +246,7 @@ func (e *Lock) GetLock(
 	if err != nil {
 		return nil, fmt.Errorf("no event found in tracker for id %s", i.Id)
 	}
+	lockResult.Lock.Waiters = e.queue.list(lockResult.Lock)
 	return lockResult, nil
 }
 
@@ -134,25 +255,32 @@ func (e *Lock) UpdateLock(
 	i *v1alpha1.UpdateLockRequest,
 ) (*v1alpha1.UpdateLockResponse, error) {
 
+	if i.Who == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "who is required to update a lock")
+	}
+
 	// Retrieve existing lock by id
 	existing, err := e.store.Get(ctx, map[string]interface{}{"id": i.Id})
 	if err != nil {
 		return nil, fmt.Errorf("no lock found in tracker for id %s", i.Id)
 	}
-
-	// Update fields only if provided (non-empty)
-	if i.Service != "" {
-		existing.Service = i.Service
-	}
-	if i.Who != "" {
-		existing.Who = i.Who
-	}
-	if i.Environment != "" {
-		existing.Environment = i.Environment
+	// le détenteur ne change pas : sinon on pourrait reprendre un lock pour le
+	// libérer sans passer par ForceUnlock
+	if existing.Who != i.Who {
+		return nil, status.Errorf(codes.PermissionDenied, "lock %s is held by %s, not %s", existing.Id, existing.Who, i.Who)
 	}
-	if i.Resource != "" {
-		existing.Resource = i.Resource
+
+	// Déplacer un lock contournerait la détection des conflits d'Acquire :
+	// service, environment et resource ne changent pas, il faut le libérer
+	// et en prendre un autre
+	resource, _ := lockResource(i.Resource, existing.Mode)
+	if (i.Service != "" && i.Service != existing.Service) ||
+		(i.Environment != "" && i.Environment != existing.Environment) ||
+		(i.Resource != "" && resource != existing.Resource) {
+		return nil, status.Errorf(codes.InvalidArgument, "the service, environment and resource of lock %s cannot be changed, release it and create another lock", existing.Id)
 	}
+
+	// Update fields only if provided (non-empty)
 	if i.EventId != "" {
 		existing.EventId = i.EventId
 	}
@@ -162,6 +290,7 @@ func (e *Lock) UpdateLock(
 	if err != nil {
 		return nil, fmt.Errorf("failed to update lock %s: %w", i.Id, err)
 	}
+	e.record(ctx, v1alpha1.LockHistoryAction_updated, existing, i.Who, "")
 
 	e.logger.Info("lock updated",
 		"id", updated.Id,
@@ -180,56 +309,73 @@ func (e *Lock) UnLock(
 	i *v1alpha1.UnLockRequest,
 ) (*v1alpha1.UnLockResponse, error) {
 
-	var lockResult = &v1alpha1.GetLockResponse{}
-	var err error
+	if i.Who == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "who is required to release a lock")
+	}
 
-	lockResult.Lock, err = e.store.Get(context.Background(), map[string]interface{}{"id": i.Id})
+	lock, err := e.store.Get(ctx, map[string]interface{}{"id": i.Id})
 	if err != nil {
-		return nil, fmt.Errorf("no event found in tracker for id %s", i.Id)
+		return nil, status.Errorf(codes.NotFound, "no lock found in tracker for id %s", i.Id)
+	}
+	if lock.Who != i.Who {
+		return nil, status.Errorf(codes.PermissionDenied, "lock %s is held by %s, not %s. Use ForceUnlock to release it anyway", lock.Id, lock.Who, i.Who)
 	}
 
-	// Si un event_id est fourni, ajouter une entrée dans le changelog de l'événement
-	if lockResult.Lock.EventId != "" {
-		event, err := e.eventStore.Get(context.Background(), map[string]interface{}{"metadata.id": lockResult.Lock.EventId})
-		if err == nil {
-			// Ajouter l'entrée "unlocked" dans le changelog
-			entry := &eventv1alpha1.ChangelogEntry{
-				Timestamp:  timestamppb.Now(),
-				User:       lockResult.Lock.Who,
-				ChangeType: eventv1alpha1.ChangeType_unlocked,
-				Comment:    fmt.Sprintf("Service unlocked in %s", lockResult.Lock.Environment),
-			}
+	return e.unlock(ctx, lock, v1alpha1.LockHistoryAction_released, i.Who, "",
+		fmt.Sprintf("Service unlocked in %s", lock.Environment))
+}
 
-			if event.Changelog == nil {
-				event.Changelog = []*eventv1alpha1.ChangelogEntry{}
-			}
-			event.Changelog = append(event.Changelog, entry)
+func (e *Lock) ForceUnlock(
+	ctx context.Context,
+	i *v1alpha1.ForceUnlockRequest,
+) (*v1alpha1.UnLockResponse, error) {
 
-			// Mettre à jour l'événement
-			_, err = e.eventStore.Update(context.Background(), map[string]interface{}{"metadata.id": lockResult.Lock.EventId}, event)
-			if err != nil {
-				e.logger.Warn("failed to update event changelog for unlock", "error", err, "event_id", lockResult.Lock.EventId)
-			}
-		}
+	if i.Who == "" || strings.TrimSpace(i.Reason) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "who and reason are required to force the release of a lock")
+	}
+
+	lock, err := e.store.Get(ctx, map[string]interface{}{"id": i.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no lock found in tracker for id %s", i.Id)
 	}
 
-	var countUnLock int64
+	e.logger.Warn("lock force released",
+		"id", lock.Id,
+		"holder", lock.Who,
+		"who", i.Who,
+		"reason", i.Reason,
+	)
+
+	return e.unlock(ctx, lock, v1alpha1.LockHistoryAction_force_released, i.Who, i.Reason,
+		fmt.Sprintf("Service unlocked in %s by %s (forced: %s)", lock.Environment, i.Who, i.Reason))
+}
 
-	countUnLock, err = e.store.Unlock(context.Background(), map[string]interface{}{"id": i.Id})
+// unlock releases lock on behalf of actor, then gives it to the next waiter
+func (e *Lock) unlock(ctx context.Context, lock *v1alpha1.Lock, action v1alpha1.LockHistoryAction, actor string, reason string, comment string) (*v1alpha1.UnLockResponse, error) {
+
+	countUnLock, err := e.store.Unlock(ctx, map[string]interface{}{"id": lock.Id})
 	if err != nil {
-		return nil, fmt.Errorf("error to unlock id %s", i.Id)
+		return nil, fmt.Errorf("error to unlock id %s", lock.Id)
+	}
+	if countUnLock > 0 {
+		e.record(ctx, action, lock, actor, reason)
+		// Si un event_id est fourni, ajouter une entrée dans le changelog de l'événement
+		e.addEventChangelog(ctx, lock, eventv1alpha1.ChangeType_unlocked, actor, comment)
 	}
 
 	// log lock delete to json format
 	e.logger.Info("lock deleted",
-		"service", lockResult.Lock.Service,
-		"who", lockResult.Lock.Who,
-		"id", lockResult.Lock.Id,
+		"service", lock.Service,
+		"who", lock.Who,
+		"by", actor,
+		"id", lock.Id,
 	)
 
+	e.release(ctx, lock)
+
 	var UnLockResult = &v1alpha1.UnLockResponse{
 		Message: "lock deleted",
-		Id:      i.Id,
+		Id:      lock.Id,
 		Count:   countUnLock,
 	}
 
@@ -241,14 +387,29 @@ func (e *Lock) ListLocks(
 	i *v1alpha1.ListLocksRequest,
 ) (*v1alpha1.ListLocksResponse, error) {
 
+	p, err := newPagination(i.PerPage, i.Page, i.Sort, i.PageToken, lockSortFields)
+	if err != nil {
+		return nil, err
+	}
+
 	var LocksResult = &v1alpha1.ListLocksResponse{}
-	var err error
+	locks, err := e.store.Find(ctx, p.filter(bson.D{}), p.options())
+	if err != nil {
+		return nil, err
+	}
+	LocksResult.Locks, LocksResult.NextPageToken, err = paginate(p, locks)
+	if err != nil {
+		return nil, err
+	}
+	for _, lock := range LocksResult.Locks {
+		lock.Waiters = e.queue.list(lock)
+	}
 
-	LocksResult.Locks, err = e.store.List(context.Background())
+	count, err := e.store.Count(ctx, bson.D{})
 	if err != nil {
 		return nil, err
 	}
-	LocksResult.TotalCount = uint32(len(LocksResult.Locks))
+	LocksResult.TotalCount = uint32(count)
 
 	return LocksResult, nil
 }
@@ -275,7 +436,7 @@ func (e *Lock) UnlockByEventId(ctx context.Context, eventId string) error {
 		return nil // Pas de lock trouvé
 	}
 
-	_, err = e.store.Unlock(ctx, map[string]interface{}{"id": lock.Id})
+	count, err := e.store.Unlock(ctx, map[string]interface{}{"id": lock.Id})
 	if err != nil {
 		e.logger.Error("failed to unlock by event_id",
 			"event_id", eventId,
@@ -284,6 +445,9 @@ func (e *Lock) UnlockByEventId(ctx context.Context, eventId string) error {
 		)
 		return err
 	}
+	if count > 0 {
+		e.record(ctx, v1alpha1.LockHistoryAction_released, lock, lock.Who, fmt.Sprintf("event %s ended", eventId))
+	}
 
 	e.logger.Info("lock released by event_id",
 		"event_id", eventId,
@@ -293,5 +457,138 @@ func (e *Lock) UnlockByEventId(ctx context.Context, eventId string) error {
 		"resource", lock.Resource,
 	)
 
+	e.release(ctx, lock)
+
 	return nil
 }
+
+func (e *Lock) RenewLock(
+	ctx context.Context,
+	i *v1alpha1.RenewLockRequest,
+) (*v1alpha1.RenewLockResponse, error) {
+
+	existing, err := e.store.Get(ctx, map[string]interface{}{"id": i.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no lock found in tracker for id %s", i.Id)
+	}
+
+	now := time.Now()
+	if isExpired(existing, now) {
+		if _, err := e.expire(ctx, existing); err != nil {
+			return nil, err
+		}
+		e.release(ctx, existing)
+		return nil, status.Errorf(codes.NotFound, "lock %s expired at %s", i.Id, existing.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+
+	ttl := existing.Ttl
+	if i.Ttl != nil {
+		if err := i.Ttl.CheckValid(); err != nil || i.Ttl.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be a positive duration")
+		}
+		ttl = i.Ttl
+	}
+	if ttl == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "lock %s has no ttl, pass one to renew it", i.Id)
+	}
+
+	existing.Ttl = ttl
+	existing.ExpiresAt = timestamppb.New(now.Add(ttl.AsDuration()))
+
+	// the sweeper may have released the lock meanwhile
+	_, err = e.store.Update(ctx, map[string]interface{}{"id": i.Id}, existing)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no lock found in tracker for id %s", i.Id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew lock %s: %w", i.Id, err)
+	}
+	e.record(ctx, v1alpha1.LockHistoryAction_renewed, existing, existing.Who, "")
+
+	e.logger.Info("lock renewed",
+		"id", existing.Id,
+		"service", existing.Service,
+		"environment", existing.Environment,
+		"resource", existing.Resource,
+		"who", existing.Who,
+		"expires_at", existing.ExpiresAt.AsTime(),
+	)
+
+	return &v1alpha1.RenewLockResponse{Lock: existing}, nil
+}
+
+func isExpired(lock *v1alpha1.Lock, now time.Time) bool {
+	return lock.ExpiresAt != nil && !lock.ExpiresAt.AsTime().After(now)
+}
+
+// expire releases an expired lock, unless it was renewed or released meanwhile
+func (e *Lock) expire(ctx context.Context, lock *v1alpha1.Lock) (bool, error) {
+	count, err := e.store.Unlock(ctx, map[string]interface{}{
+		"id":                lock.Id,
+		"expiresat.seconds": lock.ExpiresAt.Seconds,
+		"expiresat.nanos":   lock.ExpiresAt.Nanos,
+	})
+	if err != nil || count == 0 {
+		return false, err
+	}
+
+	e.record(ctx, v1alpha1.LockHistoryAction_expired, lock, "system", fmt.Sprintf("ttl of %s elapsed", lock.Ttl.AsDuration()))
+	e.addEventChangelog(ctx, lock, eventv1alpha1.ChangeType_unlocked, "system",
+		fmt.Sprintf("Service unlocked in %s (expired)", lock.Environment))
+
+	e.logger.Info("lock expired",
+		"id", lock.Id,
+		"service", lock.Service,
+		"environment", lock.Environment,
+		"resource", lock.Resource,
+		"who", lock.Who,
+		"event_id", lock.EventId,
+		"expires_at", lock.ExpiresAt.AsTime(),
+	)
+	return true, nil
+}
+
+// ExpireLocks releases every lock whose ttl has elapsed and returns how many were released
+func (e *Lock) ExpireLocks(ctx context.Context) (int, error) {
+	now := time.Now()
+	locks, err := e.store.Find(ctx, bson.D{{Key: "expiresat.seconds", Value: bson.D{{Key: "$lte", Value: now.Unix()}}}}, store.FindOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	var expired int
+	for _, lock := range locks {
+		if !isExpired(lock, now) {
+			continue
+		}
+		released, err := e.expire(ctx, lock)
+		if err != nil {
+			return expired, err
+		}
+		if released {
+			expired++
+			e.release(ctx, lock)
+		}
+	}
+	return expired, nil
+}
+
+// RunExpiry calls ExpireLocks every interval until ctx is done, drops the abandoned
+// AcquireLock waiters and turns the reservations that started into locks
+func (e *Lock) RunExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := e.ExpireLocks(ctx); err != nil {
+				e.logger.Error("failed to expire locks", "error", err)
+			}
+			e.sweepWaiters(ctx)
+			e.startReservations(ctx)
+		}
+	}
+}
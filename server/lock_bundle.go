@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateLockBundle takes the locks of i one after the other, in a fixed order.
+// When one of them is held, the locks already taken are given back and the
+// holder is returned as for CreateLock.
+func (e *Lock) CreateLockBundle(
+	ctx context.Context,
+	i *v1alpha1.CreateLockBundleRequest,
+) (*v1alpha1.CreateLockBundleResponse, error) {
+
+	if len(i.Locks) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "a lock bundle needs at least one lock")
+	}
+	if i.Who == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "who is required to create a lock bundle")
+	}
+	if i.Ttl != nil {
+		if err := i.Ttl.CheckValid(); err != nil || i.Ttl.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be a positive duration")
+		}
+	}
+
+	bundleId := uuid.New().String()
+	locks := make([]*v1alpha1.Lock, 0, len(i.Locks))
+	for _, entry := range i.Locks {
+		lock := &v1alpha1.Lock{
+			Service:     entry.Service,
+			Who:         i.Who,
+			Environment: entry.Environment,
+			Resource:    entry.Resource,
+			Ttl:         i.Ttl,
+			BundleId:    bundleId,
+			ReleaseId:   i.ReleaseId,
+		}
+		if err := validateLockScope(lock); err != nil {
+			return nil, err
+		}
+		mode, err := lockMode(entry.Mode)
+		if err != nil {
+			return nil, err
+		}
+		lock.Mode = mode
+		locks = append(locks, lock)
+	}
+	// two bundles sharing locks always compete for the first of them
+	sort.Slice(locks, func(a, b int) bool {
+		if locks[a].Environment != locks[b].Environment {
+			return locks[a].Environment < locks[b].Environment
+		}
+		if locks[a].Service != locks[b].Service {
+			return locks[a].Service < locks[b].Service
+		}
+		return locks[a].Resource < locks[b].Resource
+	})
+
+	var bundleResult = &v1alpha1.CreateLockBundleResponse{BundleId: bundleId}
+	for _, lock := range locks {
+		acquired, err := e.acquire(ctx, lock)
+		if err != nil {
+			e.giveBack(ctx, bundleResult.Locks)
+			var locked *store.LockedError
+			if errors.As(err, &locked) {
+				if locked.Holder.BundleId == bundleId {
+					return nil, status.Errorf(codes.InvalidArgument, "the bundle locks service %s, resource %s in %s twice",
+						lock.Service, lock.Resource, lock.Environment)
+				}
+				e.logger.Error("lock bundle refused",
+					"service", lock.Service,
+					"environment", lock.Environment,
+					"resource", lock.Resource,
+					"who", locked.Holder.Who,
+					"id", locked.Holder.Id,
+				)
+				return nil, lockedStatus(locked)
+			}
+			return nil, err
+		}
+		bundleResult.Locks = append(bundleResult.Locks, acquired)
+	}
+
+	for _, lock := range bundleResult.Locks {
+		e.locked(ctx, lock)
+	}
+	e.logger.Info("lock bundle created", "bundle_id", bundleId, "release_id", i.ReleaseId, "who", i.Who, "locks", len(bundleResult.Locks))
+
+	return bundleResult, nil
+}
+
+// giveBack releases the locks of a bundle that could not be taken whole. They
+// were never reported as taken, so nothing is recorded.
+func (e *Lock) giveBack(ctx context.Context, locks []*v1alpha1.Lock) {
+	for _, lock := range locks {
+		if _, err := e.store.Unlock(ctx, map[string]interface{}{"id": lock.Id}); err != nil {
+			e.logger.Error("failed to give back lock of bundle", "id", lock.Id, "bundle_id", lock.BundleId, "error", err)
+			continue
+		}
+		e.release(ctx, lock)
+	}
+}
+
+func (e *Lock) ReleaseLockBundle(
+	ctx context.Context,
+	i *v1alpha1.ReleaseLockBundleRequest,
+) (*v1alpha1.ReleaseLockBundleResponse, error) {
+
+	if i.Who == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "who is required to release a lock bundle")
+	}
+
+	locks, err := e.store.Find(ctx, bson.D{{Key: "bundleid", Value: i.BundleId}}, store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(locks) == 0 || i.BundleId == "" {
+		return nil, status.Errorf(codes.NotFound, "no lock bundle found in tracker for id %s", i.BundleId)
+	}
+	for _, lock := range locks {
+		if lock.Who != i.Who {
+			return nil, status.Errorf(codes.PermissionDenied, "lock bundle %s is held by %s, not %s. Use ForceUnlock to release its locks anyway", i.BundleId, lock.Who, i.Who)
+		}
+	}
+
+	var bundleResult = &v1alpha1.ReleaseLockBundleResponse{BundleId: i.BundleId}
+	for _, lock := range locks {
+		unlocked, err := e.unlock(ctx, lock, v1alpha1.LockHistoryAction_released, i.Who, fmt.Sprintf("bundle %s released", i.BundleId),
+			fmt.Sprintf("Service unlocked in %s", lock.Environment))
+		if err != nil {
+			return nil, err
+		}
+		bundleResult.Count += unlocked.Count
+	}
+
+	return bundleResult, nil
+}
+
+// releaseLock finds the lock of the bundle taken for release that covers lock, if any
+func (e *Lock) releaseLock(ctx context.Context, release string, lock *v1alpha1.Lock) (*v1alpha1.Lock, error) {
+	if release == "" {
+		return nil, nil
+	}
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "releaseid", Value: release}},
+		store.LockConflicts(lock),
+	}}}
+	locks, err := e.store.Find(ctx, filter, store.FindOptions{Limit: 1})
+	if err != nil || len(locks) == 0 {
+		return nil, err
+	}
+	return locks[0], nil
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func bundleEntries(services ...string) []*v1alpha1.LockBundleEntry {
+	var entries []*v1alpha1.LockBundleEntry
+	for _, service := range services {
+		entries = append(entries, &v1alpha1.LockBundleEntry{Service: service, Environment: "production", Resource: "deployment"})
+	}
+	return entries
+}
+
+func TestCreateLockBundle(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "bob", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	_, err = l.CreateLockBundle(ctx, &v1alpha1.CreateLockBundleRequest{Locks: bundleEntries("auth", "payments", "billing"), Who: "alice"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Locks, 1, "the locks taken before the conflict are given back")
+
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Lock.Id, Who: "bob"})
+	assert.NoError(t, err)
+	bundle, err := l.CreateLockBundle(ctx, &v1alpha1.CreateLockBundleRequest{Locks: bundleEntries("auth", "payments", "billing"), Who: "alice", ReleaseId: "release-42"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, bundle.BundleId)
+	if assert.Len(t, bundle.Locks, 3) {
+		assert.Equal(t, bundle.BundleId, bundle.Locks[0].BundleId)
+		assert.Equal(t, "release-42", bundle.Locks[0].ReleaseId)
+	}
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "bob", Environment: "production", Resource: "deployment"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = l.ReleaseLockBundle(ctx, &v1alpha1.ReleaseLockBundleRequest{BundleId: bundle.BundleId, Who: "bob"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	released, err := l.ReleaseLockBundle(ctx, &v1alpha1.ReleaseLockBundleRequest{BundleId: bundle.BundleId, Who: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), released.Count)
+	list, err = l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Locks)
+
+	_, err = l.ReleaseLockBundle(ctx, &v1alpha1.ReleaseLockBundleRequest{BundleId: bundle.BundleId, Who: "alice"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = l.CreateLockBundle(ctx, &v1alpha1.CreateLockBundleRequest{Locks: bundleEntries("auth", "auth"), Who: "alice"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = l.CreateLockBundle(ctx, &v1alpha1.CreateLockBundleRequest{Who: "alice"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	list, err = l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Locks)
+}
+
+func TestCreateEventSharesLockBundle(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	bundle, err := e.lockService.CreateLockBundle(ctx, &v1alpha1.CreateLockBundleRequest{Locks: bundleEntries("auth", "payments"), Who: "alice", ReleaseId: "release-42"})
+	assert.NoError(t, err)
+
+	request := deploymentRequest("payments", eventv1alpha1.Status_start)
+	request.Attributes.ReleaseId = "release-42"
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err, "an event of the release shares the bundle")
+	last := created.Event.Changelog[len(created.Event.Changelog)-1]
+	assert.Equal(t, eventv1alpha1.ChangeType_locked, last.ChangeType)
+	assert.Contains(t, last.Comment, bundle.BundleId)
+
+	request.Attributes.ReleaseId = "release-43"
+	_, err = e.CreateEvent(ctx, request)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "another release does not")
+
+	// the end of the event leaves the bundle to its holder
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", eventv1alpha1.Status_success))
+	assert.NoError(t, err)
+	list, err := e.lockService.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Locks, 2)
+}
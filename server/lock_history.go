@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"github.com/bananaops/tracker/internal/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var lockHoldDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: "tracker_lock_hold_duration_seconds",
+		Help: "How long locks were held before being released or expiring, in seconds",
+		// from 10 seconds to about 2 days
+		Buckets: prometheus.ExponentialBuckets(10, 3, 10),
+	},
+	[]string{"service", "environment", "resource", "action"},
+)
+
+func init() {
+	prometheus.MustRegister(lockHoldDuration)
+}
+
+// isLockEnd tells whether action ends the hold of a lock
+func isLockEnd(action v1alpha1.LockHistoryAction) bool {
+	switch action {
+	case v1alpha1.LockHistoryAction_released, v1alpha1.LockHistoryAction_expired, v1alpha1.LockHistoryAction_force_released:
+		return true
+	}
+	return false
+}
+
+// record appends a change of lock to the lock history. The end of a lock
+// also feeds the hold duration histogram. Failing to write the history is
+// logged but does not fail the change itself.
+func (e *Lock) record(ctx context.Context, action v1alpha1.LockHistoryAction, lock *v1alpha1.Lock, actor string, reason string) {
+	now := time.Now()
+	entry := &v1alpha1.LockHistoryEntry{
+		LockId:      lock.Id,
+		Action:      action,
+		Service:     lock.Service,
+		Environment: lock.Environment,
+		Resource:    lock.Resource,
+		Who:         lock.Who,
+		EventId:     lock.EventId,
+		Actor:       actor,
+		Reason:      reason,
+		Timestamp:   timestamppb.New(now),
+		LockedAt:    lock.CreatedAt,
+	}
+
+	if isLockEnd(action) && lock.CreatedAt != nil {
+		held := now.Sub(lock.CreatedAt.AsTime())
+		entry.HeldFor = durationpb.New(held)
+		lockHoldDuration.With(prometheus.Labels{
+			"service":     lock.Service,
+			"environment": lock.Environment,
+			"resource":    lock.Resource,
+			"action":      action.String(),
+		}).Observe(held.Seconds())
+	}
+
+	if err := e.history.Append(ctx, entry); err != nil {
+		e.logger.Error("failed to record lock history", "error", err, "id", lock.Id, "action", action.String())
+	}
+
+	if e.changes != nil {
+		e.changes.publish(&v1alpha1.WatchLocksResponse{Change: entry, Lock: proto.Clone(lock).(*v1alpha1.Lock)})
+	}
+}
+
+func (e *Lock) ListLockHistory(
+	ctx context.Context,
+	i *v1alpha1.ListLockHistoryRequest,
+) (*v1alpha1.ListLockHistoryResponse, error) {
+
+	filter, err := utils.CreateLockHistoryFilter(i)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	p, err := newPagination(i.PerPage, i.Page, i.Sort, i.PageToken, lockHistorySortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var historyResult = &v1alpha1.ListLockHistoryResponse{}
+	entries, err := e.history.Find(ctx, p.filter(filter), p.options())
+	if err != nil {
+		return nil, err
+	}
+	historyResult.Entries, historyResult.NextPageToken, err = paginate(p, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := e.history.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	historyResult.TotalCount = uint32(count)
+
+	return historyResult, nil
+}
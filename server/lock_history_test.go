@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func actions(entries []*v1alpha1.LockHistoryEntry) []v1alpha1.LockHistoryAction {
+	var result []v1alpha1.LockHistoryAction
+	for _, entry := range entries {
+		result = append(result, entry.Action)
+	}
+	return result
+}
+
+// holdSamples counts the observations of the hold duration histogram for labels
+func holdSamples(t *testing.T, labels ...string) uint64 {
+	metric := &dto.Metric{}
+	assert.NoError(t, lockHoldDuration.WithLabelValues(labels...).(prometheus.Metric).Write(metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestListLockHistory(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	released := holdSamples(t, "payments", "production", "deployment", "released")
+	expired := holdSamples(t, "billing", "staging", "deployment", "expired")
+
+	created, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment", Ttl: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+	_, err = l.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: created.Lock.Id})
+	assert.NoError(t, err)
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", EventId: "event-1"})
+	assert.NoError(t, err)
+	assert.NoError(t, l.UnlockByEventId(ctx, "event-1"))
+
+	stale, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "bob", Environment: "staging", Resource: "deployment", Ttl: durationpb.New(time.Millisecond)})
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	_, err = l.ExpireLocks(ctx)
+	assert.NoError(t, err)
+
+	all, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(6), all.TotalCount)
+	assert.Equal(t, []v1alpha1.LockHistoryAction{
+		v1alpha1.LockHistoryAction_acquired,
+		v1alpha1.LockHistoryAction_renewed,
+		v1alpha1.LockHistoryAction_updated,
+		v1alpha1.LockHistoryAction_released,
+		v1alpha1.LockHistoryAction_acquired,
+		v1alpha1.LockHistoryAction_expired,
+	}, actions(all.Entries))
+
+	release := all.Entries[3]
+	assert.Equal(t, created.Lock.Id, release.LockId)
+	assert.Equal(t, "event-1", release.EventId)
+	assert.Equal(t, "event event-1 ended", release.Reason)
+	assert.NotNil(t, release.HeldFor)
+	assert.Equal(t, created.Lock.CreatedAt.AsTime(), release.LockedAt.AsTime())
+
+	expiry := all.Entries[5]
+	assert.Equal(t, stale.Lock.Id, expiry.LockId)
+	assert.Equal(t, "system", expiry.Actor)
+	assert.GreaterOrEqual(t, expiry.HeldFor.AsDuration(), time.Millisecond)
+
+	byService, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{Service: "billing", Environment: "staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.LockHistoryAction{v1alpha1.LockHistoryAction_acquired, v1alpha1.LockHistoryAction_expired}, actions(byService.Entries))
+
+	// the expiry was made by the system, not by bob, but is still part of his locks
+	byUser, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{Who: "system"})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.LockHistoryAction{v1alpha1.LockHistoryAction_expired}, actions(byUser.Entries))
+	byUser, err = l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{Who: "bob"})
+	assert.NoError(t, err)
+	assert.Len(t, byUser.Entries, 2)
+
+	future, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{StartDate: time.Now().Add(time.Hour).Format(time.RFC3339)})
+	assert.NoError(t, err)
+	assert.Empty(t, future.Entries)
+	past, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{StartDate: "2020-01-01", EndDate: time.Now().Add(time.Hour).Format(time.RFC3339)})
+	assert.NoError(t, err)
+	assert.Len(t, past.Entries, 6)
+
+	page, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{PerPage: wrapperspb.UInt32(4), Sort: "-timestamp"})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.LockHistoryAction_expired, page.Entries[0].Action)
+	page, err = l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{PerPage: wrapperspb.UInt32(4), Sort: "-timestamp", PageToken: page.NextPageToken})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.LockHistoryAction{v1alpha1.LockHistoryAction_renewed, v1alpha1.LockHistoryAction_acquired}, actions(page.Entries))
+
+	_, err = l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{StartDate: "2025-02-01", EndDate: "2025-01-01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Equal(t, released+1, holdSamples(t, "payments", "production", "deployment", "released"))
+	assert.Equal(t, expired+1, holdSamples(t, "billing", "staging", "deployment", "expired"))
+}
@@ -0,0 +1,517 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultAcquireTimeout is how long AcquireLock waits when the request has no timeout
+	defaultAcquireTimeout = 5 * time.Minute
+	// lockQueueRetry is how often a waiter retries on its own, to see the locks released by other instances
+	lockQueueRetry = 2 * time.Second
+	// lockWaiterLease is how long a waiter keeps its place once its caller went away
+	lockWaiterLease = 30 * time.Second
+)
+
+// lockPollWindow is how long a REST call to AcquireLock blocks before answering with the queue position
+var lockPollWindow = 25 * time.Second
+
+// lockWaiters is the queue shared by every lock service of the process
+var lockWaiters = newLockQueue()
+
+type lockQueueKey struct {
+	service     string
+	environment string
+	resource    string
+}
+
+func queueKey(lock *v1alpha1.Lock) lockQueueKey {
+	return lockQueueKey{service: lock.Service, environment: lock.Environment, resource: lock.Resource}
+}
+
+// lockWaiter is a caller of AcquireLock waiting for its turn
+type lockWaiter struct {
+	id       string
+	request  *v1alpha1.Lock
+	since    time.Time
+	deadline time.Time
+	// lease is when a detached waiter loses its place, zero while a call waits on it
+	lease   time.Time
+	granted *v1alpha1.Lock
+	changed chan struct{}
+}
+
+// lockQueue keeps the AcquireLock waiters in arrival order, per service + environment + resource
+type lockQueue struct {
+	// mu guards the maps, never held while calling the store
+	mu      sync.Mutex
+	queues  map[lockQueueKey][]*lockWaiter
+	waiters map[string]*lockWaiter
+	// granting lets one grant at a time give the lock of a key
+	granting keyMutex[lockQueueKey]
+}
+
+func newLockQueue() *lockQueue {
+	return &lockQueue{
+		queues:  map[lockQueueKey][]*lockWaiter{},
+		waiters: map[string]*lockWaiter{},
+	}
+}
+
+// notify wakes up w without blocking, a pending wake up is enough
+func (w *lockWaiter) notify() {
+	select {
+	case w.changed <- struct{}{}:
+	default:
+	}
+}
+
+// stale tells whether w has timed out, or was left by its caller for longer than its lease.
+// A granted waiter only loses its lock through its lease.
+func (w *lockWaiter) stale(now time.Time) bool {
+	leaseOver := !w.lease.IsZero() && now.After(w.lease)
+	if w.granted != nil {
+		return leaseOver
+	}
+	return now.After(w.deadline) || leaseOver
+}
+
+// enqueue adds w at the end of its queue. The caller holds q.mu.
+func (q *lockQueue) enqueue(w *lockWaiter) {
+	key := queueKey(w.request)
+	q.queues[key] = append(q.queues[key], w)
+	q.waiters[w.id] = w
+}
+
+// remove takes w out of its queue and tells the waiters behind it. The caller holds q.mu.
+func (q *lockQueue) remove(w *lockWaiter) {
+	delete(q.waiters, w.id)
+	key := queueKey(w.request)
+	queue := q.queues[key]
+	for i, queued := range queue {
+		if queued != w {
+			continue
+		}
+		queue = append(queue[:i:i], queue[i+1:]...)
+		for _, behind := range queue[i:] {
+			behind.notify()
+		}
+		break
+	}
+	if len(queue) == 0 {
+		delete(q.queues, key)
+	} else {
+		q.queues[key] = queue
+	}
+}
+
+// position returns the 1-based place of w in its queue, 0 when it is not queued. The caller holds q.mu.
+func (q *lockQueue) position(w *lockWaiter) uint32 {
+	for i, queued := range q.queues[queueKey(w.request)] {
+		if queued == w {
+			return uint32(i + 1)
+		}
+	}
+	return 0
+}
+
+// list returns the waiters queued for lock
+func (q *lockQueue) list(lock *v1alpha1.Lock) []*v1alpha1.LockWaiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var waiters []*v1alpha1.LockWaiter
+	for i, w := range q.queues[queueKey(lock)] {
+		waiters = append(waiters, &v1alpha1.LockWaiter{
+			WaiterId: w.id,
+			Who:      w.request.Who,
+			EventId:  w.request.EventId,
+			Position: uint32(i + 1),
+			Since:    timestamppb.New(w.since),
+		})
+	}
+	return waiters
+}
+
+// grant gives the lock to the first waiters of key while it is free for them:
+// the first one, and the shared waiters following a shared one. The store is
+// called without q.mu, so that a slow store only holds up the queue of key.
+func (e *Lock) grant(ctx context.Context, key lockQueueKey) {
+	q := e.queue
+	defer q.granting.lock(key)()
+
+	for {
+		q.mu.Lock()
+		now := time.Now()
+		for _, w := range q.queues[key] {
+			if w.stale(now) {
+				q.remove(w)
+			}
+		}
+		if len(q.queues[key]) == 0 {
+			q.mu.Unlock()
+			return
+		}
+		head := q.queues[key][0]
+		q.mu.Unlock()
+
+		lock, err := e.acquire(ctx, head.request)
+		var locked *store.LockedError
+		if errors.As(err, &locked) {
+			return
+		}
+		if err != nil {
+			e.logger.Error("failed to grant lock", "waiter_id", head.id, "service", key.service, "environment", key.environment, "resource", key.resource, "error", err)
+			return
+		}
+
+		q.mu.Lock()
+		queued := q.position(head) > 0
+		if queued {
+			head.granted = lock
+			q.remove(head)
+			// the waiter stays known until its caller collects the lock
+			q.waiters[head.id] = head
+			head.notify()
+		}
+		q.mu.Unlock()
+
+		if !queued {
+			// the waiter timed out meanwhile, the lock goes to the next one
+			if _, err := e.store.Unlock(ctx, map[string]interface{}{"id": lock.Id}); err != nil {
+				e.logger.Error("failed to release lock of a gone waiter", "waiter_id", head.id, "id", lock.Id, "error", err)
+				return
+			}
+			continue
+		}
+		e.locked(ctx, lock)
+	}
+}
+
+// acquireInTurn takes lock for a caller that does not wait: directly when
+// nobody waits for its service, environment and resource, else through the
+// queue, so that it does not pass the waiters. It returns a *store.LockedError
+// when the lock is held, AlreadyExists when it goes to the waiters.
+func (e *Lock) acquireInTurn(ctx context.Context, lock *v1alpha1.Lock) (*v1alpha1.Lock, error) {
+	q := e.queue
+	key := queueKey(lock)
+
+	q.mu.Lock()
+	if len(q.queues[key]) == 0 {
+		q.mu.Unlock()
+		acquired, err := e.acquire(ctx, lock)
+		if err != nil {
+			return nil, err
+		}
+		e.locked(ctx, acquired)
+		return acquired, nil
+	}
+	now := time.Now()
+	w := &lockWaiter{
+		id:       uuid.New().String(),
+		request:  lock,
+		since:    now,
+		deadline: now.Add(time.Minute),
+		changed:  make(chan struct{}, 1),
+	}
+	q.enqueue(w)
+	q.mu.Unlock()
+
+	e.grant(ctx, key)
+
+	q.mu.Lock()
+	granted, ahead := w.granted, q.position(w)-1
+	if granted == nil {
+		q.remove(w)
+	} else {
+		delete(q.waiters, w.id)
+	}
+	q.mu.Unlock()
+	if granted != nil {
+		return granted, nil
+	}
+
+	if holders, err := e.store.Find(ctx, store.LockConflicts(lock), store.FindOptions{Limit: 1}); err == nil && len(holders) > 0 {
+		return nil, &store.LockedError{Holder: holders[0], Requested: lock}
+	}
+	return nil, status.Errorf(codes.AlreadyExists, "service %s in %s goes to the %d callers of AcquireLock queued first, call AcquireLock to wait in line",
+		lock.Service, lock.Environment, ahead)
+}
+
+// release lets the next waiter of lock take it, or the next waiter of every
+// queue of the environment when lock was a scope
+func (e *Lock) release(ctx context.Context, lock *v1alpha1.Lock) {
+	if !isLockScope(lock) {
+		e.grant(ctx, queueKey(lock))
+		return
+	}
+
+	e.queue.mu.Lock()
+	var keys []lockQueueKey
+	for key := range e.queue.queues {
+		if key.environment == lock.Environment {
+			keys = append(keys, key)
+		}
+	}
+	e.queue.mu.Unlock()
+
+	for _, key := range keys {
+		e.grant(ctx, key)
+	}
+}
+
+// sweepWaiters drops the waiters whose caller did not come back and gives their locks to the next ones
+func (e *Lock) sweepWaiters(ctx context.Context) {
+	q := e.queue
+	q.mu.Lock()
+	now := time.Now()
+	var abandoned []*v1alpha1.Lock
+	keys := make([]lockQueueKey, 0, len(q.queues))
+	for key := range q.queues {
+		keys = append(keys, key)
+	}
+	for _, w := range q.waiters {
+		if !w.stale(now) {
+			continue
+		}
+		q.remove(w)
+		if w.granted != nil {
+			abandoned = append(abandoned, w.granted)
+		}
+	}
+	q.mu.Unlock()
+
+	for _, lock := range abandoned {
+		if _, err := e.unlock(ctx, lock, v1alpha1.LockHistoryAction_released, "system", "granted to a waiter that did not come back",
+			fmt.Sprintf("Service unlocked in %s (abandoned)", lock.Environment)); err != nil {
+			e.logger.Error("failed to release abandoned lock", "id", lock.Id, "error", err)
+		}
+	}
+	for _, key := range keys {
+		e.grant(ctx, key)
+	}
+}
+
+// join queues a new waiter for i, or finds back the waiter i resumes
+func (e *Lock) join(i *v1alpha1.AcquireLockRequest) (*lockWaiter, error) {
+	q := e.queue
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if i.WaiterId != "" {
+		w, ok := q.waiters[i.WaiterId]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "waiter %s is no longer queued", i.WaiterId)
+		}
+		w.lease = time.Time{}
+		return w, nil
+	}
+
+	if err := validateLockScope(&v1alpha1.Lock{Service: i.Service, Resource: i.Resource}); err != nil {
+		return nil, err
+	}
+	resource, mode := lockResource(i.Resource, i.Mode)
+	mode, err := lockMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	if i.Ttl != nil {
+		if err := i.Ttl.CheckValid(); err != nil || i.Ttl.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be a positive duration")
+		}
+	}
+	timeout := defaultAcquireTimeout
+	if i.Timeout != nil {
+		if err := i.Timeout.CheckValid(); err != nil || i.Timeout.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "timeout must be a positive duration")
+		}
+		timeout = i.Timeout.AsDuration()
+	}
+
+	now := time.Now()
+	w := &lockWaiter{
+		id: uuid.New().String(),
+		request: &v1alpha1.Lock{
+			Service:     i.Service,
+			Who:         i.Who,
+			Environment: i.Environment,
+			Resource:    resource,
+			EventId:     i.EventId,
+			Ttl:         i.Ttl,
+			Mode:        mode,
+		},
+		since:    now,
+		deadline: now.Add(timeout),
+		changed:  make(chan struct{}, 1),
+	}
+	q.enqueue(w)
+	return w, nil
+}
+
+// leave detaches the caller from w. A waiter that timed out is dropped, others keep their place for lockWaiterLease.
+func (e *Lock) leave(w *lockWaiter, granted bool) {
+	q := e.queue
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	switch {
+	case granted:
+		delete(q.waiters, w.id)
+	case now.After(w.deadline):
+		q.remove(w)
+	default:
+		w.lease = now.Add(lockWaiterLease)
+	}
+}
+
+// wait blocks until w gets the lock or until has passed. update is called each time the queue position changes.
+// It returns a nil lock when until passed first.
+func (e *Lock) wait(ctx context.Context, w *lockWaiter, until time.Time, update func(*v1alpha1.AcquireLockResponse) error) (*v1alpha1.Lock, error) {
+	key := queueKey(w.request)
+	var last uint32
+	for {
+		e.grant(ctx, key)
+
+		e.queue.mu.Lock()
+		granted, position := w.granted, e.queue.position(w)
+		e.queue.mu.Unlock()
+		if granted != nil {
+			return granted, nil
+		}
+		if position == 0 {
+			if time.Now().After(w.deadline) {
+				return nil, nil
+			}
+			return nil, status.Errorf(codes.NotFound, "waiter %s is no longer queued", w.id)
+		}
+
+		if position != last {
+			last = position
+			if err := update(e.waiting(ctx, w, position)); err != nil {
+				return nil, err
+			}
+		}
+
+		now := time.Now()
+		if !now.Before(until) {
+			return nil, nil
+		}
+		timer := time.NewTimer(min(until.Sub(now), lockQueueRetry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-w.changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// waiting describes w waiting at position, with the current holder of the lock
+func (e *Lock) waiting(ctx context.Context, w *lockWaiter, position uint32) *v1alpha1.AcquireLockResponse {
+	response := &v1alpha1.AcquireLockResponse{WaiterId: w.id, Position: position}
+	holders, err := e.store.Find(ctx, store.LockConflicts(w.request), store.FindOptions{Limit: 1})
+	if err == nil && len(holders) > 0 {
+		response.Holder = holders[0]
+	}
+	return response
+}
+
+func (e *Lock) AcquireLock(
+	i *v1alpha1.AcquireLockRequest,
+	stream v1alpha1.LockService_AcquireLockServer,
+) error {
+
+	w, err := e.join(i)
+	if err != nil {
+		return err
+	}
+
+	lock, err := e.wait(stream.Context(), w, w.deadline, stream.Send)
+	e.leave(w, lock != nil)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return status.Errorf(codes.DeadlineExceeded, "lock of service %s in %s was not granted in time", w.request.Service, w.request.Environment)
+	}
+	return stream.Send(&v1alpha1.AcquireLockResponse{WaiterId: w.id, Lock: lock})
+}
+
+// RegisterLockAcquireHandler serves AcquireLock as a long poll on POST /api/v1alpha1/lock/acquire.
+// The call answers 200 with the lock once granted, or 202 with the queue position after lockPollWindow;
+// the client then calls again with the waiter_id to keep its place.
+func RegisterLockAcquireHandler(mux *runtime.ServeMux, locks *Lock) error {
+	return mux.HandlePath("POST", "/api/v1alpha1/lock/acquire", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		var request v1alpha1.AcquireLockRequest
+		body, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = protojson.Unmarshal(body, &request)
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err))
+			return
+		}
+
+		waiter, err := locks.join(&request)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		var response *v1alpha1.AcquireLockResponse
+		until := time.Now().Add(lockPollWindow)
+		if waiter.deadline.Before(until) {
+			until = waiter.deadline
+		}
+		lock, err := locks.wait(ctx, waiter, until, func(update *v1alpha1.AcquireLockResponse) error {
+			response = update
+			return nil
+		})
+		locks.leave(waiter, lock != nil)
+
+		code := http.StatusOK
+		switch {
+		case err != nil:
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		case lock != nil:
+			response = &v1alpha1.AcquireLockResponse{WaiterId: waiter.id, Lock: lock}
+		case !time.Now().Before(waiter.deadline):
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.DeadlineExceeded,
+				"lock of service %s in %s was not granted in time", waiter.request.Service, waiter.request.Environment))
+			return
+		default:
+			code = http.StatusAccepted
+		}
+
+		out, err := marshaler.Marshal(response)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, fmt.Errorf("failed to encode response: %w", err))
+			return
+		}
+		w.Header().Set("Content-Type", marshaler.ContentType(response))
+		w.WriteHeader(code)
+		_, _ = w.Write(out)
+	})
+}
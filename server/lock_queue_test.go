@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+)
+
+// acquireStream records the responses AcquireLock streams
+type acquireStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *v1alpha1.AcquireLockResponse
+}
+
+func (s *acquireStream) Context() context.Context { return s.ctx }
+
+func (s *acquireStream) Send(response *v1alpha1.AcquireLockResponse) error {
+	s.responses <- response
+	return nil
+}
+
+// startAcquire calls AcquireLock in the background, its error is sent on the returned channel
+func startAcquire(l *Lock, request *v1alpha1.AcquireLockRequest) (*acquireStream, chan error) {
+	stream := &acquireStream{ctx: context.Background(), responses: make(chan *v1alpha1.AcquireLockResponse, 10)}
+	done := make(chan error, 1)
+	go func() { done <- l.AcquireLock(request, stream) }()
+	return stream, done
+}
+
+func nextAcquire(t *testing.T, stream *acquireStream) *v1alpha1.AcquireLockResponse {
+	t.Helper()
+	select {
+	case response := <-stream.responses:
+		return response
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response from AcquireLock")
+		return nil
+	}
+}
+
+func acquireRequest(who string) *v1alpha1.AcquireLockRequest {
+	return &v1alpha1.AcquireLockRequest{Service: "payments", Who: who, Environment: "production", Resource: "deployment", EventId: "event-" + who}
+}
+
+func TestAcquireLockQueue(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	bob, bobDone := startAcquire(l, acquireRequest("bob"))
+	waiting := nextAcquire(t, bob)
+	assert.Equal(t, uint32(1), waiting.Position)
+	assert.Equal(t, "alice", waiting.Holder.Who)
+
+	carol, carolDone := startAcquire(l, acquireRequest("carol"))
+	assert.Equal(t, uint32(2), nextAcquire(t, carol).Position)
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Locks, 1) && assert.Len(t, list.Locks[0].Waiters, 2) {
+		assert.Equal(t, "bob", list.Locks[0].Waiters[0].Who)
+		assert.Equal(t, "carol", list.Locks[0].Waiters[1].Who)
+		assert.Equal(t, uint32(2), list.Locks[0].Waiters[1].Position)
+	}
+
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	granted := nextAcquire(t, bob)
+	assert.Equal(t, "bob", granted.Lock.Who)
+	assert.Equal(t, uint32(0), granted.Position)
+	assert.NoError(t, <-bobDone)
+
+	waiting = nextAcquire(t, carol)
+	assert.Equal(t, uint32(1), waiting.Position, "carol moves up once bob got the lock")
+	assert.Equal(t, "bob", waiting.Holder.Who)
+
+	assert.NoError(t, l.UnlockByEventId(ctx, "event-bob"))
+	assert.Equal(t, "carol", nextAcquire(t, carol).Lock.Who)
+	assert.NoError(t, <-carolDone)
+
+	list, err = l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Locks, 1) {
+		assert.Equal(t, "carol", list.Locks[0].Who)
+		assert.Empty(t, list.Locks[0].Waiters)
+	}
+}
+
+func TestCreateLockKeepsQueueOrder(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	bob, bobDone := startAcquire(l, acquireRequest("bob"))
+	assert.Equal(t, uint32(1), nextAcquire(t, bob).Position)
+
+	// released by another instance: the queue of this one has not granted it yet
+	_, err = l.store.Unlock(ctx, map[string]interface{}{"id": held.Lock.Id})
+	assert.NoError(t, err)
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "dave", Environment: "production", Resource: "deployment"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, err.Error(), "by bob", "dave does not pass bob")
+	assert.Equal(t, "bob", nextAcquire(t, bob).Lock.Who)
+	assert.NoError(t, <-bobDone)
+}
+
+// slowLockStore blocks the acquisitions of a service until release is closed
+type slowLockStore struct {
+	store.LockStore
+	service string
+	release chan struct{}
+}
+
+func (s *slowLockStore) Acquire(ctx context.Context, lock *v1alpha1.Lock) (*v1alpha1.Lock, error) {
+	if lock.Service == s.service {
+		<-s.release
+	}
+	return s.LockStore.Acquire(ctx, lock)
+}
+
+func TestGrantDoesNotBlockOtherQueues(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	slow := &slowLockStore{LockStore: l.store, service: "payments", release: make(chan struct{})}
+	l.store = slow
+
+	_, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	payments, paymentsDone := startAcquire(l, acquireRequest("bob"))
+	billingRequest := acquireRequest("carol")
+	billingRequest.Service = "billing"
+	billing, billingDone := startAcquire(l, billingRequest)
+
+	// the grant of payments waits for the store, the queue of billing still moves
+	assert.Equal(t, uint32(1), nextAcquire(t, billing).Position)
+	close(slow.release)
+	assert.Equal(t, "bob", nextAcquire(t, payments).Lock.Who)
+	assert.NoError(t, <-paymentsDone)
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	for _, lock := range list.Locks {
+		if lock.Service == "billing" {
+			_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: lock.Id, Who: "alice"})
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, "carol", nextAcquire(t, billing).Lock.Who)
+	assert.NoError(t, <-billingDone)
+}
+
+func TestAcquireLockFreeLock(t *testing.T) {
+	l := newTestLock(t)
+
+	request := acquireRequest("alice")
+	request.Ttl = durationpb.New(time.Hour)
+	stream, done := startAcquire(l, request)
+	granted := nextAcquire(t, stream)
+	assert.Equal(t, "alice", granted.Lock.Who)
+	assert.NotNil(t, granted.Lock.ExpiresAt)
+	assert.NoError(t, <-done)
+}
+
+func TestAcquireLockTimeout(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	_, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	request := acquireRequest("bob")
+	request.Timeout = durationpb.New(50 * time.Millisecond)
+	stream, done := startAcquire(l, request)
+	assert.Equal(t, uint32(1), nextAcquire(t, stream).Position)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(<-done))
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Locks[0].Waiters, "a waiter that timed out leaves the queue")
+
+	request.Timeout = durationpb.New(-time.Second)
+	_, done = startAcquire(l, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(<-done))
+}
+
+func TestAcquireLockHTTP(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	mux := runtime.NewServeMux()
+	assert.NoError(t, RegisterLockAcquireHandler(mux, l))
+
+	defer func(window time.Duration) { lockPollWindow = window }(lockPollWindow)
+	lockPollWindow = 50 * time.Millisecond
+
+	post := func(body string) (*httptest.ResponseRecorder, *v1alpha1.AcquireLockResponse) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1alpha1/lock/acquire", strings.NewReader(body)))
+		response := &v1alpha1.AcquireLockResponse{}
+		_ = protojson.Unmarshal(recorder.Body.Bytes(), response)
+		return recorder, response
+	}
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	recorder, waiting := post(`{"service":"payments","who":"bob","environment":"production","resource":"deployment"}`)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, uint32(1), waiting.Position)
+	assert.Equal(t, "alice", waiting.Holder.Who)
+	assert.NotEmpty(t, waiting.WaiterId)
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Locks[0].Waiters, 1, "the waiter keeps its place between two polls")
+
+	// the lock is granted while bob is between two polls, the next poll collects it
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	recorder, granted := post(`{"waiterId":"` + waiting.WaiterId + `"}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "bob", granted.Lock.Who)
+
+	recorder, _ = post(`{"waiterId":"` + waiting.WaiterId + `"}`)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder, _ = post(`{"service":"payments","who":"carol","environment":"production","resource":"deployment","timeout":"0.01s"}`)
+	assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+
+	recorder, _ = post(`not json`)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestSweepWaiters(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	bob, err := l.join(acquireRequest("bob"))
+	assert.NoError(t, err)
+	carol, err := l.join(acquireRequest("carol"))
+	assert.NoError(t, err)
+
+	// bob left without coming back for the lock granted to him
+	l.leave(bob, false)
+	l.leave(carol, false)
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	bob.lease = time.Now().Add(-time.Second)
+
+	l.sweepWaiters(ctx)
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Locks, 1) {
+		assert.Equal(t, "carol", list.Locks[0].Who, "the lock abandoned by bob goes to carol")
+	}
+}
+
+func TestAcquireLockCoveredByScope(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	freeze, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "*", Who: "alice", Environment: "production", Resource: "*"})
+	assert.NoError(t, err)
+
+	bob, bobDone := startAcquire(l, acquireRequest("bob"))
+	waiting := nextAcquire(t, bob)
+	assert.Equal(t, uint32(1), waiting.Position)
+	assert.Equal(t, "*", waiting.Holder.Service, "the holder of the covering lock is reported")
+
+	// the queue of every lock covered by the scope is served on release
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: freeze.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", nextAcquire(t, bob).Lock.Who)
+	assert.NoError(t, <-bobDone)
+}
+
+func TestAcquireLockShared(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	held, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	bobRequest, carolRequest := acquireRequest("bob"), acquireRequest("carol")
+	bobRequest.Mode, carolRequest.Mode = v1alpha1.LockMode_shared, v1alpha1.LockMode_shared
+	bob, bobDone := startAcquire(l, bobRequest)
+	assert.Equal(t, uint32(1), nextAcquire(t, bob).Position)
+	carol, carolDone := startAcquire(l, carolRequest)
+	assert.Equal(t, uint32(2), nextAcquire(t, carol).Position)
+
+	// both shared waiters are granted together once the exclusive lock is released
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.LockMode_shared, nextAcquire(t, bob).Lock.Mode)
+	assert.NoError(t, <-bobDone)
+	assert.Equal(t, "carol", nextAcquire(t, carol).Lock.Who)
+	assert.NoError(t, <-carolDone)
+}
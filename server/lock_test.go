@@ -0,0 +1,335 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+)
+
+// newTestLock builds a lock service backed by the in-memory stores, isolated by test name
+func newTestLock(t *testing.T) *Lock {
+	return &Lock{
+		store:        store.NewMemoryStoreLock(t.Name() + "/locks"),
+		eventStore:   store.NewMemoryStoreEvent(t.Name() + "/events"),
+		history:      store.NewMemoryStoreLockHistory(t.Name() + "/lock_history"),
+		reservations: store.NewMemoryStoreReservation(t.Name() + "/reservations"),
+		logger:       slog.New(slog.NewJSONHandler(io.Discard, nil)),
+		queue:        newLockQueue(),
+		changes:      newChangeFeed[*v1alpha1.WatchLocksResponse](watchBacklog),
+		eventChanges: newChangeFeed[*eventv1alpha1.WatchEventsResponse](watchBacklog),
+	}
+}
+
+func TestCreateLock(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	created, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Lock.Id)
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "bob", Environment: "production", Resource: "deployment"})
+	assert.ErrorContains(t, err, "already locked")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "bob", Environment: "development", Resource: "deployment"})
+	assert.NoError(t, err, "another environment is not locked")
+
+	got, err := l.GetLock(ctx, &v1alpha1.GetLockRequest{Id: created.Lock.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", got.Lock.Who)
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), list.TotalCount)
+
+	unlocked, err := l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: created.Lock.Id, Who: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), unlocked.Count)
+
+	_, err = l.GetLock(ctx, &v1alpha1.GetLockRequest{Id: created.Lock.Id})
+	assert.Error(t, err)
+}
+
+func TestUnLockOwnership(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", eventv1alpha1.Status_start))
+	assert.NoError(t, err)
+	list, err := e.lockService.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	held := list.Locks[0]
+
+	_, err = e.lockService.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Id, Who: "bob"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = e.lockService.UnLock(ctx, &v1alpha1.UnLockRequest{Id: held.Id})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = e.lockService.UnLock(ctx, &v1alpha1.UnLockRequest{Id: "unknown", Who: "alice"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = e.lockService.ForceUnlock(ctx, &v1alpha1.ForceUnlockRequest{Id: held.Id, Who: "bob"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a forced release needs a reason")
+	forced, err := e.lockService.ForceUnlock(ctx, &v1alpha1.ForceUnlockRequest{Id: held.Id, Who: "bob", Reason: "pipeline crashed"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), forced.Count)
+
+	history, err := e.lockService.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{LockId: held.Id})
+	assert.NoError(t, err)
+	last := history.Entries[len(history.Entries)-1]
+	assert.Equal(t, v1alpha1.LockHistoryAction_force_released, last.Action)
+	assert.Equal(t, "bob", last.Actor)
+	assert.Equal(t, "alice", last.Who)
+	assert.Equal(t, "pipeline crashed", last.Reason)
+
+	got, err := e.GetEvent(ctx, &eventv1alpha1.GetEventRequest{Id: created.Event.Metadata.Id})
+	assert.NoError(t, err)
+	change := got.Event.Changelog[len(got.Event.Changelog)-1]
+	assert.Equal(t, eventv1alpha1.ChangeType_unlocked, change.ChangeType)
+	assert.Equal(t, "bob", change.User)
+	assert.Equal(t, "Service unlocked in production by bob (forced: pipeline crashed)", change.Comment)
+
+	// already released by another caller: nothing is deleted, nothing is written
+	gone, err := e.lockService.unlock(ctx, held, v1alpha1.LockHistoryAction_released, "carol", "", "Service unlocked in production")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), gone.Count)
+	again, err := e.GetEvent(ctx, &eventv1alpha1.GetEventRequest{Id: created.Event.Metadata.Id})
+	assert.NoError(t, err)
+	assert.Len(t, again.Event.Changelog, len(got.Event.Changelog))
+}
+
+func TestCreateLockScopes(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	_, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments-api", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "*", Who: "bob", Environment: "production", Resource: "*"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "an environment cannot be locked while one of its services is")
+
+	freeze, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "*", Who: "bob", Environment: "staging", Resource: "*"})
+	assert.NoError(t, err)
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments-api", Who: "alice", Environment: "staging", Resource: "deployment"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.ErrorContains(t, err, "covered for deployment in staging by lock "+freeze.Lock.Id)
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "pay*ments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateLockModes(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	audit := &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment", Mode: v1alpha1.LockMode_shared}
+	first, err := l.CreateLock(ctx, audit)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.LockMode_shared, first.Lock.Mode)
+	audit.Who = "bob"
+	_, err = l.CreateLock(ctx, audit)
+	assert.NoError(t, err, "shared holders run in parallel")
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "carol", Environment: "production", Resource: "deployment"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "a lock without mode is exclusive")
+
+	deploy, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "carol", Environment: "production", Resource: "deployment", Mode: v1alpha1.LockMode_exclusive})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.LockMode_exclusive, deploy.Lock.Mode)
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "alice", Environment: "production", Resource: "deployment", Mode: v1alpha1.LockMode_shared})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "auth", Who: "alice", Environment: "production", Resource: "deployment", Mode: v1alpha1.LockMode(42)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestLegacyOperationLocks(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	// stored before operations shared the deployment resource
+	legacy, err := l.store.Create(ctx, &v1alpha1.Lock{Service: "payments", Who: "alice", Environment: "production", Resource: "operation"})
+	assert.NoError(t, err)
+	migrated, err := l.MigrateOperationLocks(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+	got, err := l.store.Get(ctx, map[string]interface{}{"id": legacy.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "deployment", got.Resource)
+	assert.Equal(t, v1alpha1.LockMode_shared, got.Mode)
+
+	// older clients still send the operation resource
+	operation, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "bob", Environment: "production", Resource: "operation"})
+	assert.NoError(t, err, "operations share the lock")
+	assert.Equal(t, "deployment", operation.Lock.Resource)
+	assert.Equal(t, v1alpha1.LockMode_shared, operation.Lock.Mode)
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "carol", Environment: "production", Resource: "deployment"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "a deployment waits for the operations")
+}
+
+func TestUpdateLock(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	created, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+
+	// un autre ne peut ni reprendre le lock ni le modifier
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "mallory"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, EventId: "event-2"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: created.Lock.Id, Who: "mallory"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// le lock ne peut pas être déplacé sur le service d'un autre
+	other, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "bob", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", Service: "billing"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", Environment: "staging"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", Resource: "database"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", Service: "payments", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err, "the same service, environment and resource are accepted")
+	_, err = l.UnLock(ctx, &v1alpha1.UnLockRequest{Id: other.Lock.Id, Who: "bob"})
+	assert.NoError(t, err)
+
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: created.Lock.Id, Who: "alice", EventId: "event-1"})
+	assert.NoError(t, err)
+	history, err := l.ListLockHistory(ctx, &v1alpha1.ListLockHistoryRequest{LockId: created.Lock.Id})
+	assert.NoError(t, err)
+	updated := history.Entries[len(history.Entries)-1]
+	assert.Equal(t, v1alpha1.LockHistoryAction_updated, updated.Action)
+	assert.Equal(t, "alice", updated.Actor)
+	assert.Equal(t, "alice", updated.Who)
+
+	assert.NoError(t, l.UnlockByEventId(ctx, "event-1"))
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Locks)
+
+	_, err = l.UpdateLock(ctx, &v1alpha1.UpdateLockRequest{Id: "unknown", Who: "alice"})
+	assert.Error(t, err)
+}
+
+func TestCreateLockConcurrently(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	const pipelines = 20
+
+	var wg sync.WaitGroup
+	results := make(chan error, pipelines)
+	for i := 0; i < pipelines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: fmt.Sprintf("pipeline-%d", i), Environment: "production", Resource: "deployment"})
+			results <- err
+		}(i)
+	}
+	wg.Wait()
+	close(results)
+
+	list, err := l.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Locks, 1)
+
+	var winners int
+	for err := range results {
+		if err == nil {
+			winners++
+			continue
+		}
+		st := status.Convert(err)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		if assert.Len(t, st.Details(), 1) {
+			holder := st.Details()[0].(*v1alpha1.Lock)
+			assert.Equal(t, list.Locks[0].Id, holder.Id)
+			assert.Equal(t, list.Locks[0].Who, holder.Who)
+		}
+	}
+	assert.Equal(t, 1, winners)
+}
+
+func TestRenewLock(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	created, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment", Ttl: durationpb.New(time.Minute)})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), created.Lock.ExpiresAt.AsTime(), 5*time.Second)
+
+	renewed, err := l.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: created.Lock.Id, Ttl: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), renewed.Lock.ExpiresAt.AsTime(), 5*time.Second)
+
+	renewed, err = l.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: created.Lock.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, renewed.Lock.Ttl.AsDuration(), "the last ttl is kept")
+
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "alice", Environment: "production", Resource: "deployment", Ttl: durationpb.New(-time.Second)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	forever, err := l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	assert.Nil(t, forever.Lock.ExpiresAt)
+	_, err = l.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: forever.Lock.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = l.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestExpireLocks(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	request := deploymentRequest("payments", eventv1alpha1.Status_start)
+	request.LockTtl = durationpb.New(time.Millisecond)
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err)
+	_, err = e.lockService.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "alice", Environment: "production", Resource: "deployment", Ttl: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	expired, err := e.lockService.ExpireLocks(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	list, err := e.lockService.ListLocks(ctx, &v1alpha1.ListLocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Locks, 1) {
+		assert.Equal(t, "billing", list.Locks[0].Service)
+	}
+
+	got, err := e.GetEvent(ctx, &eventv1alpha1.GetEventRequest{Id: created.Event.Metadata.Id})
+	assert.NoError(t, err)
+	last := got.Event.Changelog[len(got.Event.Changelog)-1]
+	assert.Equal(t, eventv1alpha1.ChangeType_unlocked, last.ChangeType)
+	assert.Equal(t, "Service unlocked in production (expired)", last.Comment)
+
+	// an expired lock not swept yet does not block a new one
+	stale, err := e.lockService.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "auth", Who: "alice", Environment: "production", Resource: "deployment", Ttl: durationpb.New(time.Millisecond)})
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	_, err = e.lockService.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "auth", Who: "bob", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	_, err = e.lockService.RenewLock(ctx, &v1alpha1.RenewLockRequest{Id: stale.Lock.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
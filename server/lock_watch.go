@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// lockChanges is the feed of lock changes shared by every service of the process
+var lockChanges = newChangeFeed[*v1alpha1.WatchLocksResponse](watchBacklog)
+
+// watchesLock tells whether the change matches the filter of i
+func watchesLock(i *v1alpha1.WatchLocksRequest, change *v1alpha1.LockHistoryEntry) bool {
+	switch {
+	case i.Service != "" && change.GetService() != i.Service,
+		i.Environment != "" && change.GetEnvironment() != i.Environment,
+		i.Resource != "" && change.GetResource() != i.Resource:
+		return false
+	}
+	return true
+}
+
+// watchLocks sends the changes of the locks matching i until ctx is done
+func (e *Lock) watchLocks(ctx context.Context, i *v1alpha1.WatchLocksRequest, send func(*v1alpha1.WatchLocksResponse) error) error {
+	return watch(ctx, e.changes, i.ResumeToken,
+		func(change *v1alpha1.WatchLocksResponse) (*v1alpha1.WatchLocksResponse, bool) {
+			return change, watchesLock(i, change.Change)
+		},
+		func(change *v1alpha1.WatchLocksResponse, token string) *v1alpha1.WatchLocksResponse {
+			withToken := &v1alpha1.WatchLocksResponse{}
+			if change != nil {
+				withToken = proto.Clone(change).(*v1alpha1.WatchLocksResponse)
+			}
+			withToken.ResumeToken = token
+			return withToken
+		},
+		send,
+	)
+}
+
+func (e *Lock) WatchLocks(
+	i *v1alpha1.WatchLocksRequest,
+	stream v1alpha1.LockService_WatchLocksServer,
+) error {
+
+	e.logger.Info("locks watched", "service", i.Service, "environment", i.Environment, "resumed", i.ResumeToken != "")
+	return e.watchLocks(stream.Context(), i, stream.Send)
+}
+
+// RegisterLockWatchHandler serves WatchLocks as Server-Sent Events on GET /api/v1alpha1/locks/watch.
+// The filter is given as query parameters; each change is an SSE event named after its action,
+// whose id is its resume token.
+func RegisterLockWatchHandler(mux *runtime.ServeMux, locks *Lock) error {
+	return mux.HandlePath("GET", "/api/v1alpha1/locks/watch", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		serveWatch(mux, w, r, &v1alpha1.WatchLocksRequest{},
+			func(request *v1alpha1.WatchLocksRequest, token string) { request.ResumeToken = token },
+			func(ctx context.Context, request *v1alpha1.WatchLocksRequest, send func(string, string, proto.Message) error) error {
+				return locks.watchLocks(ctx, request, func(change *v1alpha1.WatchLocksResponse) error {
+					kind := "watching"
+					if change.Change != nil {
+						kind = change.Change.Action.String()
+					}
+					return send(change.ResumeToken, kind, change)
+				})
+			})
+	})
+}
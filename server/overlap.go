@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/bananaops/tracker/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// period is the span of an event as shown in the UI: from its start date, or
+// its creation date, to its end date, or its start when it has none
+type period struct {
+	event      *v1alpha1.Event
+	start, end time.Time
+}
+
+func eventPeriod(event *v1alpha1.Event) (period, bool) {
+	var p = period{event: event}
+	switch {
+	case event.Attributes.GetStartDate() != nil:
+		p.start = event.Attributes.StartDate.AsTime()
+	case event.Metadata.GetCreatedAt() != nil:
+		p.start = event.Metadata.CreatedAt.AsTime()
+	default:
+		return p, false
+	}
+	p.end = p.start
+	if event.Attributes.EndDate != nil {
+		p.end = event.Attributes.EndDate.AsTime()
+	}
+	return p, true
+}
+
+// ownerLookup returns a function giving the owner of a service in the
+// catalog, empty when unknown, which looks each service up once
+func (e *Event) ownerLookup(ctx context.Context) func(service string) string {
+	owners := map[string]string{}
+	return func(service string) string {
+		if cached, ok := owners[service]; ok {
+			return cached
+		}
+		owners[service] = ""
+		if catalog, err := e.catalogStore.Get(ctx, map[string]interface{}{"name": service}); err == nil {
+			owners[service] = catalog.Owner
+		}
+		return owners[service]
+	}
+}
+
+// GetOverlaps returns the pairs of events of the period whose spans intersect,
+// in the same environment and, unless i.EnvironmentOnly, for the same service
+func (e *Event) GetOverlaps(
+	ctx context.Context,
+	i *v1alpha1.GetOverlapsRequest,
+) (*v1alpha1.GetOverlapsResponse, error) {
+
+	filter, err := utils.CreateOverlapsFilter(i)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	events, err := e.store.Find(ctx, filter, store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]period, 0, len(events))
+	for _, event := range events {
+		if p, ok := eventPeriod(event); ok {
+			periods = append(periods, p)
+		}
+	}
+	sort.SliceStable(periods, func(a, b int) bool { return periods[a].start.Before(periods[b].start) })
+
+	owner := e.ownerLookup(ctx)
+
+	var overlapsResult = &v1alpha1.GetOverlapsResponse{}
+	for a, first := range periods {
+		// sorted by start, the events after the end of first cannot overlap it
+		for _, second := range periods[a+1:] {
+			if second.start.After(first.end) {
+				break
+			}
+			if first.event.Attributes.Environment != second.event.Attributes.Environment {
+				continue
+			}
+			if !i.EnvironmentOnly && first.event.Attributes.Service != second.event.Attributes.Service {
+				continue
+			}
+			end := first.end
+			if second.end.Before(end) {
+				end = second.end
+			}
+			overlapsResult.Overlaps = append(overlapsResult.Overlaps, &v1alpha1.Overlap{
+				First:       first.event,
+				Second:      second.event,
+				FirstOwner:  owner(first.event.Attributes.Service),
+				SecondOwner: owner(second.event.Attributes.Service),
+				Start:       timestamppb.New(second.start),
+				End:         timestamppb.New(end),
+				Duration:    durationpb.New(end.Sub(second.start)),
+			})
+		}
+	}
+	sort.SliceStable(overlapsResult.Overlaps, func(a, b int) bool {
+		return overlapsResult.Overlaps[a].Start.AsTime().Before(overlapsResult.Overlaps[b].Start.AsTime())
+	})
+
+	return overlapsResult, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+)
+
+func TestGetOverlaps(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	plan := func(title string, service string, environment v1alpha1.Environment, from, to int) *v1alpha1.Event {
+		event, err := e.store.Create(ctx, &v1alpha1.Event{
+			Title: title,
+			Attributes: &v1alpha1.EventAttributes{Type: v1alpha1.Type_deployment, Status: v1alpha1.Status_planned, Service: service, Environment: environment,
+				StartDate: timestamppb.New(day.Add(time.Duration(from) * time.Hour)), EndDate: timestamppb.New(day.Add(time.Duration(to) * time.Hour))},
+			Metadata: &v1alpha1.EventMetadata{},
+		})
+		assert.NoError(t, err)
+		return event
+	}
+	migration := plan("migration", "payments", v1alpha1.Environment_production, 9, 12)
+	release := plan("release", "payments", v1alpha1.Environment_production, 11, 14)
+	plan("release", "payments", v1alpha1.Environment_UAT, 10, 11)
+	plan("release", "billing", v1alpha1.Environment_production, 13, 15)
+	plan("next week", "payments", v1alpha1.Environment_production, 24*7, 24*7+1)
+
+	_, err := e.catalogStore.Update(ctx, map[string]interface{}{"name": "payments"}, &catalogv1alpha1.Catalog{Name: "payments", Owner: "team-payments"})
+	assert.NoError(t, err)
+
+	overlaps, err := e.GetOverlaps(ctx, &v1alpha1.GetOverlapsRequest{StartDate: "2025-03-10", EndDate: "2025-03-11"})
+	assert.NoError(t, err)
+	if assert.Len(t, overlaps.Overlaps, 1, "same environment and service") {
+		overlap := overlaps.Overlaps[0]
+		assert.Equal(t, migration.Metadata.Id, overlap.First.Metadata.Id)
+		assert.Equal(t, release.Metadata.Id, overlap.Second.Metadata.Id)
+		assert.Equal(t, "team-payments", overlap.FirstOwner)
+		assert.Equal(t, day.Add(11*time.Hour), overlap.Start.AsTime())
+		assert.Equal(t, day.Add(12*time.Hour), overlap.End.AsTime())
+		assert.Equal(t, time.Hour, overlap.Duration.AsDuration())
+	}
+
+	overlaps, err = e.GetOverlaps(ctx, &v1alpha1.GetOverlapsRequest{StartDate: "2025-03-10", EndDate: "2025-03-11", EnvironmentOnly: true,
+		Environments: []v1alpha1.Environment{v1alpha1.Environment_production}})
+	assert.NoError(t, err)
+	if assert.Len(t, overlaps.Overlaps, 2) {
+		assert.Equal(t, "billing", overlaps.Overlaps[1].Second.Attributes.Service)
+		assert.Empty(t, overlaps.Overlaps[1].SecondOwner, "billing is not in the catalog")
+	}
+
+	overlaps, err = e.GetOverlaps(ctx, &v1alpha1.GetOverlapsRequest{StartDate: "2025-03-10T12:30:00Z", EndDate: "2025-03-11", Service: "payments"})
+	assert.NoError(t, err)
+	assert.Empty(t, overlaps.Overlaps, "the migration ended before the period")
+
+	_, err = e.GetOverlaps(ctx, &v1alpha1.GetOverlapsRequest{StartDate: "2025-03-10"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,285 @@
+package server
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	store "github.com/bananaops/tracker/internal/stores"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// maxPerPage caps per_page on every list and search RPC
+const maxPerPage = 1000
+
+// sortFields maps the sort names accepted by an RPC to the stored document paths
+type sortFields struct {
+	fields     map[string][]string
+	byDefault  string
+	tieBreaker string
+}
+
+var eventSortFields = sortFields{
+	fields: map[string][]string{
+		"created_at":  {"metadata.createdat.seconds", "metadata.createdat.nanos"},
+		"start_date":  {"attributes.startdate.seconds", "attributes.startdate.nanos"},
+		"end_date":    {"attributes.enddate.seconds", "attributes.enddate.nanos"},
+		"title":       {"title"},
+		"service":     {"attributes.service"},
+		"priority":    {"attributes.priority"},
+		"status":      {"attributes.status"},
+		"environment": {"attributes.environment"},
+		"type":        {"attributes.type"},
+	},
+	byDefault:  "created_at",
+	tieBreaker: "metadata.id",
+}
+
+var lockSortFields = sortFields{
+	fields: map[string][]string{
+		"created_at":  {"createdat.seconds", "createdat.nanos"},
+		"service":     {"service"},
+		"environment": {"environment"},
+		"resource":    {"resource"},
+		"who":         {"who"},
+	},
+	byDefault:  "created_at",
+	tieBreaker: "id",
+}
+
+var lockHistorySortFields = sortFields{
+	fields: map[string][]string{
+		"timestamp":   {"timestamp.seconds", "timestamp.nanos"},
+		"service":     {"service"},
+		"environment": {"environment"},
+		"who":         {"who"},
+		"action":      {"action"},
+	},
+	byDefault:  "timestamp",
+	tieBreaker: "id",
+}
+
+var reservationSortFields = sortFields{
+	fields: map[string][]string{
+		"start": {"start.seconds"},
+	},
+	byDefault:  "start",
+	tieBreaker: "id",
+}
+
+var freezeSortFields = sortFields{
+	fields: map[string][]string{
+		"created": {"createdat.seconds", "createdat.nanos"},
+	},
+	byDefault:  "created",
+	tieBreaker: "id",
+}
+
+var catalogSortFields = sortFields{
+	fields: map[string][]string{
+		"name":       {"name"},
+		"owner":      {"owner"},
+		"type":       {"type"},
+		"version":    {"version"},
+		"created_at": {"createdat.seconds", "createdat.nanos"},
+		"updated_at": {"updatedat.seconds", "updatedat.nanos"},
+	},
+	byDefault:  "name",
+	tieBreaker: "name",
+}
+
+// pageToken is the content of a next_page_token: the sort it was built for and
+// the sort values of the last item returned
+type pageToken struct {
+	Sort   string `bson:"sort"`
+	Values bson.A `bson:"values"`
+}
+
+// pagination holds the paging and sorting parameters of a list request.
+//
+// Without per_page every match is returned, as before pagination existed.
+// page skips (page-1)*per_page items while a page_token resumes right after
+// the last item of the previous page, so pages stay stable when items are
+// inserted in between.
+type pagination struct {
+	sort    string
+	fields  []store.SortField
+	perPage int64
+	skip    int64
+	after   []interface{}
+}
+
+func newPagination(perPage *wrapperspb.UInt32Value, page *wrapperspb.Int32Value, sortBy string, token string, allowed sortFields) (*pagination, error) {
+	p := &pagination{}
+
+	if perPage != nil && perPage.Value > 0 {
+		p.perPage = int64(perPage.Value)
+		if p.perPage > maxPerPage {
+			p.perPage = maxPerPage
+		}
+	}
+
+	sortBy = strings.ReplaceAll(sortBy, " ", "")
+	if sortBy == "" {
+		sortBy = allowed.byDefault
+	}
+	p.sort = sortBy
+
+	tieBreaker := true
+	for _, name := range strings.Split(sortBy, ",") {
+		descending := strings.HasPrefix(name, "-")
+		paths, ok := allowed.fields[strings.TrimPrefix(name, "-")]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown sort field %q, expected one of %s", name, strings.Join(allowed.names(), ", "))
+		}
+		for _, path := range paths {
+			p.fields = append(p.fields, store.SortField{Path: path, Descending: descending})
+			if path == allowed.tieBreaker {
+				tieBreaker = false
+			}
+		}
+	}
+	// a unique field makes the order total, so no item is skipped or repeated between pages
+	if tieBreaker {
+		p.fields = append(p.fields, store.SortField{Path: allowed.tieBreaker})
+	}
+
+	if token != "" {
+		decoded, err := decodePageToken(token)
+		if err != nil || len(decoded.Values) != len(p.fields) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		if decoded.Sort != p.sort {
+			return nil, status.Errorf(codes.InvalidArgument, "page_token was issued for sort %q, not %q", decoded.Sort, p.sort)
+		}
+		p.after = decoded.Values
+	} else if p.perPage > 0 && page != nil && page.Value > 1 {
+		p.skip = int64(page.Value-1) * p.perPage
+	}
+
+	return p, nil
+}
+
+func (s sortFields) names() []string {
+	names := make([]string, 0, len(s.fields))
+	for name := range s.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// filter restricts base to the items following the page token
+func (p *pagination) filter(base bson.D) bson.D {
+	if p.after == nil {
+		return base
+	}
+
+	// (f1 > v1) or (f1 = v1 and f2 > v2) or ...
+	var branches bson.A
+	var equal bson.A
+	for i, field := range p.fields {
+		value := p.after[i]
+		if next := after(field, value); next != nil {
+			branches = append(branches, bson.D{{Key: "$and", Value: append(append(bson.A{}, equal...), next)}})
+		}
+		equal = append(equal, bson.D{{Key: field.Path, Value: value}})
+	}
+
+	keyset := bson.D{{Key: "$or", Value: branches}}
+	if len(base) == 0 {
+		return keyset
+	}
+	return bson.D{{Key: "$and", Value: bson.A{base, keyset}}}
+}
+
+// after matches the values sorted after value on field, nil if there is none.
+// Nulls sort first, and comparison operators never match them.
+func after(field store.SortField, value interface{}) bson.D {
+	isNull := bson.D{{Key: field.Path, Value: nil}}
+	switch {
+	case !field.Descending && value == nil:
+		return bson.D{{Key: "$nor", Value: bson.A{isNull}}}
+	case !field.Descending:
+		return bson.D{{Key: field.Path, Value: bson.D{{Key: "$gt", Value: value}}}}
+	case value == nil:
+		return nil
+	default:
+		return bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: field.Path, Value: bson.D{{Key: "$lt", Value: value}}}},
+			isNull,
+		}}}
+	}
+}
+
+// options returns the store options fetching one item more than per_page,
+// to know whether a next page exists
+func (p *pagination) options() store.FindOptions {
+	opts := store.FindOptions{Sort: p.fields, Skip: p.skip}
+	if p.perPage > 0 {
+		opts.Limit = p.perPage + 1
+	}
+	return opts
+}
+
+// paginate trims the items fetched with p.options() to the page and returns
+// the token of the next page, empty on the last page
+func paginate[T any](p *pagination, items []T) ([]T, string, error) {
+	if p.perPage == 0 || int64(len(items)) <= p.perPage {
+		return items, "", nil
+	}
+	items = items[:p.perPage]
+
+	raw, err := bson.Marshal(items[len(items)-1])
+	if err != nil {
+		return nil, "", err
+	}
+	document := bson.Raw(raw)
+	token := pageToken{Sort: p.sort}
+	for _, field := range p.fields {
+		var value interface{}
+		if v, err := document.LookupErr(strings.Split(field.Path, ".")...); err == nil {
+			if err := v.Unmarshal(&value); err != nil {
+				return nil, "", err
+			}
+		}
+		token.Values = append(token.Values, value)
+	}
+
+	encoded, err := bson.Marshal(token)
+	if err != nil {
+		return nil, "", err
+	}
+	return items, base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+func decodePageToken(token string) (*pageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	decoded := &pageToken{}
+	if err := bson.Unmarshal(raw, decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// filterDocument converts a filter built by utils.CreateFilter to a bson.D,
+// with a stable key order
+func filterDocument(filter map[string]interface{}) bson.D {
+	keys := make([]string, 0, len(filter))
+	for key := range filter {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	document := bson.D{}
+	for _, key := range keys {
+		document = append(document, bson.E{Key: key, Value: filter[key]})
+	}
+	return document
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func createEvents(t *testing.T, e *Event, services ...string) {
+	for _, service := range services {
+		_, err := e.CreateEvent(context.Background(), deploymentRequest(service, v1alpha1.Status_success))
+		assert.NoError(t, err)
+	}
+}
+
+func services(events []*v1alpha1.Event) []string {
+	var names []string
+	for _, event := range events {
+		names = append(names, event.Attributes.Service)
+	}
+	return names
+}
+
+func TestListEventsPagination(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	createEvents(t, e, "c", "a", "e", "b", "d")
+
+	all, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "e", "b", "d"}, services(all.Events), "events are listed by creation date")
+	assert.Equal(t, uint32(5), all.TotalCount)
+	assert.Empty(t, all.NextPageToken)
+
+	page, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(2), Page: wrapperspb.Int32(2), Sort: "service"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, services(page.Events))
+	assert.Equal(t, uint32(5), page.TotalCount)
+
+	var walked []string
+	request := &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(2), Sort: "-service"}
+	for i := 0; i < 5; i++ {
+		page, err := e.ListEvents(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), page.TotalCount)
+		walked = append(walked, services(page.Events)...)
+		if page.NextPageToken == "" {
+			break
+		}
+		request.PageToken = page.NextPageToken
+	}
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, walked)
+
+	// events have no end date yet: the token resumes on null sort values too
+	walked = nil
+	request = &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(2), Sort: "-end_date"}
+	for i := 0; i < 5; i++ {
+		page, err := e.ListEvents(ctx, request)
+		assert.NoError(t, err)
+		walked = append(walked, services(page.Events)...)
+		if page.NextPageToken == "" {
+			break
+		}
+		request.PageToken = page.NextPageToken
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e"}, walked)
+}
+
+func TestListEventsPageTokenIsStable(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	createEvents(t, e, "a", "b", "c")
+
+	first, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, services(first.Events))
+	assert.NotEmpty(t, first.NextPageToken)
+
+	// an event created between two pages ends up on the last page instead of shifting it
+	createEvents(t, e, "d")
+	next, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(2), PageToken: first.NextPageToken})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, services(next.Events))
+	assert.Equal(t, uint32(4), next.TotalCount)
+	assert.Empty(t, next.NextPageToken)
+}
+
+func TestSearchEventsPagination(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	createEvents(t, e, "a", "b", "a", "a")
+
+	search := &v1alpha1.SearchEventsRequest{Service: "a", PerPage: wrapperspb.UInt32(2)}
+	page, err := e.SearchEvents(ctx, search)
+	assert.NoError(t, err)
+	assert.Len(t, page.Events, 2)
+	assert.Equal(t, uint32(3), page.TotalCount, "total_count counts every match, not the page")
+
+	search.PageToken = page.NextPageToken
+	page, err = e.SearchEvents(ctx, search)
+	assert.NoError(t, err)
+	assert.Len(t, page.Events, 1)
+	assert.Empty(t, page.NextPageToken)
+}
+
+func TestPaginationErrors(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	createEvents(t, e, "a", "b", "c")
+
+	_, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{Sort: "message"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PageToken: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	page, err := e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(1)})
+	assert.NoError(t, err)
+	_, err = e.ListEvents(ctx, &v1alpha1.ListEventsRequest{PerPage: wrapperspb.UInt32(1), Sort: "service", PageToken: page.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a token only resumes the sort it was issued for")
+}
+
+func TestListLocksPagination(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	for i := 0; i < 3; i++ {
+		_, err := l.CreateLock(ctx, &lock.CreateLockRequest{Service: fmt.Sprintf("service-%d", i), Who: "alice", Environment: "production", Resource: "deployment"})
+		assert.NoError(t, err)
+	}
+
+	page, err := l.ListLocks(ctx, &lock.ListLocksRequest{PerPage: wrapperspb.UInt32(2), Sort: "-service"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), page.TotalCount)
+	assert.Equal(t, "service-2", page.Locks[0].Service)
+	assert.Equal(t, "service-1", page.Locks[1].Service)
+
+	page, err = l.ListLocks(ctx, &lock.ListLocksRequest{PerPage: wrapperspb.UInt32(2), Sort: "-service", PageToken: page.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, page.Locks, 1)
+	assert.Equal(t, "service-0", page.Locks[0].Service)
+	assert.Empty(t, page.NextPageToken)
+}
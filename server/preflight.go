@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReasons bounds the reasons of each check listed by CanDeploy
+const maxReasons = 20
+
+// CanDeploy runs the checks of CreateEvent for a starting deployment, or
+// operation, without creating anything. Rather than stopping at the first one,
+// it returns every reason refusing the deployment now.
+func (e *Event) CanDeploy(
+	ctx context.Context,
+	i *v1alpha1.CanDeployRequest,
+) (*v1alpha1.CanDeployResponse, error) {
+
+	if i.Service == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "service is required")
+	}
+	if i.Environment == v1alpha1.Environment_ENVIRONMENT_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "environment is required")
+	}
+	eventType := i.Type
+	if eventType == v1alpha1.Type_TYPE_UNSPECIFIED {
+		eventType = v1alpha1.Type_deployment
+	}
+	if eventType != v1alpha1.Type_deployment && eventType != v1alpha1.Type_operation {
+		return nil, status.Errorf(codes.InvalidArgument, "only deployments and operations can be checked, not %s", eventType)
+	}
+
+	resource, mode := getResourceType(eventType)
+	wanted := &lock.Lock{
+		Service:     i.Service,
+		Environment: i.Environment.String(),
+		Resource:    resource,
+		Mode:        mode,
+	}
+	now := time.Now()
+
+	var checks = []func(context.Context, *v1alpha1.CanDeployRequest, *lock.Lock, time.Time) ([]*v1alpha1.BlockingReason, error){
+		e.lockReasons,
+		e.inProgressReasons,
+		e.plannedReasons,
+	}
+	if eventType == v1alpha1.Type_deployment {
+		checks = append(checks, e.freezeReasons)
+	}
+
+	var verdict = &v1alpha1.CanDeployResponse{}
+	for _, check := range checks {
+		reasons, err := check(ctx, i, wanted, now)
+		if err != nil {
+			return nil, err
+		}
+		if len(reasons) > maxReasons {
+			reasons, verdict.Truncated = reasons[:maxReasons], true
+		}
+		verdict.Reasons = append(verdict.Reasons, reasons...)
+	}
+	verdict.Allowed = len(verdict.Reasons) == 0
+
+	e.logger.Info("deployment checked",
+		"service", i.Service,
+		"environment", wanted.Environment,
+		"type", eventType.String(),
+		"allowed", verdict.Allowed,
+		"reasons", len(verdict.Reasons),
+	)
+
+	return verdict, nil
+}
+
+// lockReasons returns the reservation of another team and the locks held on
+// the service, except those the event would share as CreateEvent does
+func (e *Event) lockReasons(ctx context.Context, i *v1alpha1.CanDeployRequest, wanted *lock.Lock, now time.Time) ([]*v1alpha1.BlockingReason, error) {
+	var reasons []*v1alpha1.BlockingReason
+
+	reservation, err := e.lockService.activeReservation(ctx, wanted.Environment, wanted.Service)
+	if err != nil {
+		return nil, err
+	}
+	if reservation != nil && reservation.Owner != i.Owner {
+		reasons = append(reasons, &v1alpha1.BlockingReason{
+			Kind:    v1alpha1.BlockingReasonKind_reservation,
+			Message: fmt.Sprintf("service %s in %s is reserved by %s", wanted.Service, reservation.Environment, reservation.Owner),
+			Id:      reservation.Id,
+			Until:   reservation.End,
+		})
+	}
+
+	locks, err := e.lockService.store.Find(ctx, store.LockConflicts(wanted), store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, held := range locks {
+		if i.ReleaseId != "" && held.ReleaseId == i.ReleaseId {
+			continue
+		}
+		if reservation != nil && reservation.Owner == i.Owner && held.ReservationId == reservation.Id {
+			continue
+		}
+		reasons = append(reasons, &v1alpha1.BlockingReason{
+			Kind: v1alpha1.BlockingReasonKind_lock,
+			Message: fmt.Sprintf("service %s, resource %s in %s is locked by %s",
+				held.Service, held.Resource, held.Environment, held.Who),
+			Id:    held.Id,
+			Until: held.ExpiresAt,
+		})
+	}
+	return reasons, nil
+}
+
+// inProgressReasons returns the deployments and operations of the service
+// started and not ended yet, but those of the release of the request. An
+// operation only waits for deployments. An event ends with an event related to
+// it, or with a later one of its service, environment and type without
+// relation.
+func (e *Event) inProgressReasons(ctx context.Context, i *v1alpha1.CanDeployRequest, wanted *lock.Lock, now time.Time) ([]*v1alpha1.BlockingReason, error) {
+	types := []v1alpha1.Type{v1alpha1.Type_deployment}
+	if wanted.Mode == lock.LockMode_exclusive {
+		types = append(types, v1alpha1.Type_operation)
+	}
+	ends := bson.D{{Key: "$in", Value: bson.A{int32(v1alpha1.Status_success), int32(v1alpha1.Status_failure), int32(v1alpha1.Status_done)}}}
+
+	// Seule la dernière fin sans relation de chaque type compte : les
+	// événements démarrés avant elle sont terminés
+	var byType bson.A
+	for _, eventType := range types {
+		latest, err := e.store.Find(ctx, bson.D{
+			{Key: "attributes.service", Value: i.Service},
+			{Key: "attributes.environment", Value: int32(i.Environment)},
+			{Key: "attributes.type", Value: int32(eventType)},
+			{Key: "attributes.status", Value: ends},
+			{Key: "attributes.relatedid", Value: ""},
+		}, store.FindOptions{
+			Sort:  []store.SortField{{Path: "metadata.createdat.seconds", Descending: true}},
+			Limit: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		since := bson.D{{Key: "attributes.type", Value: int32(eventType)}}
+		if len(latest) > 0 {
+			since = append(since, bson.E{Key: "metadata.createdat.seconds", Value: bson.D{{Key: "$gt", Value: latest[0].Metadata.CreatedAt.GetSeconds()}}})
+		}
+		byType = append(byType, since)
+	}
+
+	conditions := bson.A{
+		bson.D{{Key: "attributes.service", Value: i.Service}},
+		bson.D{{Key: "attributes.environment", Value: int32(i.Environment)}},
+		bson.D{{Key: "attributes.status", Value: bson.D{{Key: "$in", Value: bson.A{int32(v1alpha1.Status_start), int32(v1alpha1.Status_in_progress)}}}}},
+		bson.D{{Key: "$or", Value: byType}},
+	}
+	if i.ReleaseId != "" {
+		conditions = append(conditions, bson.D{{Key: "attributes.releaseid", Value: bson.D{{Key: "$ne", Value: i.ReleaseId}}}})
+	}
+	started, err := e.store.Find(ctx, bson.D{{Key: "$and", Value: conditions}}, store.FindOptions{
+		Sort: []store.SortField{{Path: "metadata.createdat.seconds", Descending: true}},
+	})
+	if err != nil || len(started) == 0 {
+		return nil, err
+	}
+
+	// Puis les fins liées aux événements restants, en une seule requête
+	ids := make(bson.A, 0, len(started))
+	for _, event := range started {
+		ids = append(ids, event.Metadata.Id)
+	}
+	related, err := e.store.Find(ctx, bson.D{
+		{Key: "attributes.relatedid", Value: bson.D{{Key: "$in", Value: ids}}},
+		{Key: "attributes.status", Value: ends},
+	}, store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ended := make(map[string]bool, len(related))
+	for _, event := range related {
+		ended[event.Attributes.RelatedId] = true
+	}
+
+	var reasons []*v1alpha1.BlockingReason
+	for _, event := range started {
+		if ended[event.Metadata.Id] {
+			continue
+		}
+		reasons = append(reasons, &v1alpha1.BlockingReason{
+			Kind: v1alpha1.BlockingReasonKind_in_progress_event,
+			Message: fmt.Sprintf("%s %q of %s in %s is in progress since %s", event.Attributes.Type, event.Title,
+				event.Attributes.Service, event.Attributes.Environment, event.Metadata.CreatedAt.AsTime().Format(time.RFC3339)),
+			Id: event.Metadata.Id,
+		})
+	}
+	return reasons, nil
+}
+
+// plannedReasons returns the planned events of the service whose window includes now
+func (e *Event) plannedReasons(ctx context.Context, i *v1alpha1.CanDeployRequest, wanted *lock.Lock, now time.Time) ([]*v1alpha1.BlockingReason, error) {
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "attributes.service", Value: i.Service}},
+		bson.D{{Key: "attributes.environment", Value: int32(i.Environment)}},
+		bson.D{{Key: "attributes.status", Value: int32(v1alpha1.Status_planned)}},
+		bson.D{{Key: "attributes.startdate.seconds", Value: bson.D{{Key: "$lte", Value: now.Unix()}}}},
+		bson.D{{Key: "attributes.enddate.seconds", Value: bson.D{{Key: "$gt", Value: now.Unix()}}}},
+	}}}
+	planned, err := e.store.Find(ctx, filter, store.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []*v1alpha1.BlockingReason
+	for _, event := range planned {
+		reasons = append(reasons, &v1alpha1.BlockingReason{
+			Kind: v1alpha1.BlockingReasonKind_planned_event,
+			Message: fmt.Sprintf("%s %q of %s in %s is planned until %s", event.Attributes.Type, event.Title,
+				event.Attributes.Service, event.Attributes.Environment, event.Attributes.EndDate.AsTime().Format(time.RFC3339)),
+			Id:    event.Metadata.Id,
+			Until: event.Attributes.EndDate,
+		})
+	}
+	return reasons, nil
+}
+
+// freezeReasons returns the freezes of the service in force, except the one
+// of the approved exception of the request
+func (e *Event) freezeReasons(ctx context.Context, i *v1alpha1.CanDeployRequest, wanted *lock.Lock, now time.Time) ([]*v1alpha1.BlockingReason, error) {
+	active, err := e.freezeService.activeFreezes(ctx, wanted.Environment, wanted.Service, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []*v1alpha1.BlockingReason
+	for _, frozen := range active {
+		if approvedException(frozen.Freeze, wanted.Service, i.FreezeExceptionId) != nil {
+			continue
+		}
+		reasons = append(reasons, &v1alpha1.BlockingReason{
+			Kind:    v1alpha1.BlockingReasonKind_freeze,
+			Message: fmt.Sprintf("deployments of %s in %s are frozen by %s", wanted.Service, wanted.Environment, frozen.Freeze.Name),
+			Id:      frozen.Freeze.Id,
+			Until:   frozen.Until,
+		})
+	}
+	return reasons, nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	freezev1alpha1 "github.com/bananaops/tracker/generated/proto/freeze/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func reasonKinds(verdict *v1alpha1.CanDeployResponse) []v1alpha1.BlockingReasonKind {
+	var kinds []v1alpha1.BlockingReasonKind
+	for _, reason := range verdict.Reasons {
+		kinds = append(kinds, reason.Kind)
+	}
+	return kinds
+}
+
+func TestCanDeploy(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	now := time.Now()
+	check := &v1alpha1.CanDeployRequest{Service: "payments", Environment: v1alpha1.Environment_production}
+
+	verdict, err := e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.True(t, verdict.Allowed)
+	assert.Empty(t, verdict.Reasons)
+
+	started, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_start))
+	assert.NoError(t, err)
+	_, err = e.store.Create(ctx, &v1alpha1.Event{
+		Title: "database migration",
+		Attributes: &v1alpha1.EventAttributes{Type: v1alpha1.Type_operation, Status: v1alpha1.Status_planned, Service: "payments",
+			Environment: v1alpha1.Environment_production, StartDate: timestamppb.New(now.Add(-time.Hour)), EndDate: timestamppb.New(now.Add(time.Hour))},
+		Metadata: &v1alpha1.EventMetadata{},
+	})
+	assert.NoError(t, err)
+	frozen, err := e.freezeService.CreateFreeze(ctx, &freezev1alpha1.CreateFreezeRequest{Name: "black friday", Environment: "production",
+		Start: timestamppb.New(now.Add(-time.Hour)), End: timestamppb.New(now.Add(time.Hour))})
+	assert.NoError(t, err)
+
+	verdict, err = e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.False(t, verdict.Allowed)
+	assert.Equal(t, []v1alpha1.BlockingReasonKind{
+		v1alpha1.BlockingReasonKind_lock,
+		v1alpha1.BlockingReasonKind_in_progress_event,
+		v1alpha1.BlockingReasonKind_planned_event,
+		v1alpha1.BlockingReasonKind_freeze,
+	}, reasonKinds(verdict), "every reason is listed")
+	assert.Equal(t, started.Event.Metadata.Id, verdict.Reasons[1].Id)
+	assert.Equal(t, frozen.Freeze.Id, verdict.Reasons[3].Id)
+
+	// the deployment ends and its lock is released
+	_, err = e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_success))
+	assert.NoError(t, err)
+	verdict, err = e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.BlockingReasonKind_lock, verdict.Reasons[0].Kind)
+	assert.NotEqual(t, v1alpha1.BlockingReasonKind_in_progress_event, verdict.Reasons[1].Kind)
+	_, err = e.lockService.UnLock(ctx, &lock.UnLockRequest{Id: verdict.Reasons[0].Id, Who: "alice"})
+	assert.NoError(t, err)
+	verdict, err = e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.BlockingReasonKind{v1alpha1.BlockingReasonKind_planned_event, v1alpha1.BlockingReasonKind_freeze}, reasonKinds(verdict))
+
+	// operations are not frozen, and share their lock
+	_, err = e.lockService.CreateLock(ctx, &lock.CreateLockRequest{Service: "billing", Who: "bob", Environment: "production", Resource: "deployment", Mode: lock.LockMode_shared})
+	assert.NoError(t, err)
+	verdict, err = e.CanDeploy(ctx, &v1alpha1.CanDeployRequest{Service: "billing", Environment: v1alpha1.Environment_production, Type: v1alpha1.Type_operation})
+	assert.NoError(t, err)
+	assert.True(t, verdict.Allowed)
+
+	_, err = e.CanDeploy(ctx, &v1alpha1.CanDeployRequest{Service: "payments", Environment: v1alpha1.Environment_production, Type: v1alpha1.Type_incident})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = e.CanDeploy(ctx, &v1alpha1.CanDeployRequest{Service: "payments"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCanDeployInProgressEvents(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	check := &v1alpha1.CanDeployRequest{Service: "payments", Environment: v1alpha1.Environment_production, ReleaseId: "v2"}
+	create := func(status v1alpha1.Status, relatedId string, releaseId string) *v1alpha1.Event {
+		event, err := e.store.Create(ctx, &v1alpha1.Event{
+			Title: "deploy payments",
+			Attributes: &v1alpha1.EventAttributes{Type: v1alpha1.Type_deployment, Status: status, Service: "payments",
+				Environment: v1alpha1.Environment_production, RelatedId: relatedId, ReleaseId: releaseId},
+			Metadata: &v1alpha1.EventMetadata{},
+		})
+		assert.NoError(t, err)
+		return event
+	}
+
+	var started []*v1alpha1.Event
+	for range maxReasons + 5 {
+		started = append(started, create(v1alpha1.Status_start, "", ""))
+	}
+	create(v1alpha1.Status_start, "", "v2")
+	create(v1alpha1.Status_success, started[0].Metadata.Id, "")
+
+	verdict, err := e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.Len(t, verdict.Reasons, maxReasons)
+	assert.True(t, verdict.Truncated, "%d deployments are in progress", maxReasons+4)
+	for _, reason := range verdict.Reasons {
+		assert.NotEqual(t, started[0].Metadata.Id, reason.Id, "the deployment ended by a related event")
+	}
+
+	// a later deployment without relation ends every earlier one
+	create(v1alpha1.Status_success, "", "")
+	verdict, err = e.CanDeploy(ctx, check)
+	assert.NoError(t, err)
+	assert.True(t, verdict.Allowed)
+	assert.False(t, verdict.Truncated)
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e *Lock) CreateReservation(
+	ctx context.Context,
+	i *v1alpha1.CreateReservationRequest,
+) (*v1alpha1.CreateReservationResponse, error) {
+
+	if value, ok := eventv1alpha1.Environment_value[i.Environment]; !ok || value == int32(eventv1alpha1.Environment_ENVIRONMENT_UNSPECIFIED) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown environment %q", i.Environment)
+	}
+	if i.Owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner is required to reserve an environment")
+	}
+	if isLockScope(&v1alpha1.Lock{Service: i.Service}) {
+		return nil, status.Errorf(codes.InvalidArgument, "service must be a single service, leave it empty to reserve the whole environment")
+	}
+	if i.Start.CheckValid() != nil || i.End.CheckValid() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start and end are required")
+	}
+	// periods are compared to the second
+	start, end := i.Start.AsTime().Truncate(time.Second), i.End.AsTime().Truncate(time.Second)
+	if !end.After(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "end must be after start")
+	}
+	if !end.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "end must be in the future")
+	}
+
+	reservation, err := e.reservations.Create(ctx, &v1alpha1.Reservation{
+		Environment: i.Environment,
+		Service:     i.Service,
+		Owner:       i.Owner,
+		Purpose:     i.Purpose,
+		Start:       timestamppb.New(start),
+		End:         timestamppb.New(end),
+	})
+	var overlap *store.ReservationOverlapError
+	if errors.As(err, &overlap) {
+		st := status.New(codes.AlreadyExists, overlap.Error())
+		if detailed, err := st.WithDetails(overlap.Existing); err == nil {
+			st = detailed
+		}
+		return nil, st.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("reservation created",
+		"id", reservation.Id,
+		"environment", reservation.Environment,
+		"service", reservation.Service,
+		"owner", reservation.Owner,
+		"start", start,
+		"end", end,
+	)
+
+	// a reservation starting now is locked at once rather than on the next tick
+	if !start.After(time.Now()) {
+		e.startReservation(ctx, reservation)
+	}
+
+	return &v1alpha1.CreateReservationResponse{Reservation: reservation}, nil
+}
+
+func (e *Lock) GetReservation(
+	ctx context.Context,
+	i *v1alpha1.GetReservationRequest,
+) (*v1alpha1.GetReservationResponse, error) {
+
+	reservation, err := e.reservations.Get(ctx, map[string]interface{}{"id": i.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no reservation found in tracker for id %s", i.Id)
+	}
+	return &v1alpha1.GetReservationResponse{Reservation: reservation}, nil
+}
+
+func (e *Lock) CancelReservation(
+	ctx context.Context,
+	i *v1alpha1.CancelReservationRequest,
+) (*v1alpha1.CancelReservationResponse, error) {
+
+	if i.Owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner is required to cancel a reservation")
+	}
+	reservation, err := e.reservations.Get(ctx, map[string]interface{}{"id": i.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no reservation found in tracker for id %s", i.Id)
+	}
+	if reservation.Owner != i.Owner {
+		return nil, status.Errorf(codes.PermissionDenied, "reservation %s is owned by %s, not %s", reservation.Id, reservation.Owner, i.Owner)
+	}
+
+	if lock := e.reservationLock(ctx, reservation); lock != nil {
+		if _, err := e.unlock(ctx, lock, v1alpha1.LockHistoryAction_released, i.Owner, fmt.Sprintf("reservation %s cancelled", reservation.Id),
+			fmt.Sprintf("Service unlocked in %s (reservation cancelled)", lock.Environment)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := e.reservations.Delete(ctx, map[string]interface{}{"id": reservation.Id}); err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("reservation cancelled", "id", reservation.Id, "environment", reservation.Environment, "owner", reservation.Owner)
+
+	return &v1alpha1.CancelReservationResponse{Id: reservation.Id}, nil
+}
+
+func (e *Lock) ListReservations(
+	ctx context.Context,
+	i *v1alpha1.ListReservationsRequest,
+) (*v1alpha1.ListReservationsResponse, error) {
+
+	conditions := bson.A{bson.D{{Key: "end.seconds", Value: bson.D{{Key: "$gt", Value: time.Now().Unix()}}}}}
+	if i.Environment != "" {
+		conditions = append(conditions, bson.D{{Key: "environment", Value: i.Environment}})
+	}
+	if i.Service != "" {
+		conditions = append(conditions, bson.D{{Key: "service", Value: bson.D{{Key: "$in", Value: bson.A{"", i.Service}}}}})
+	}
+	if i.Owner != "" {
+		conditions = append(conditions, bson.D{{Key: "owner", Value: i.Owner}})
+	}
+	filter := bson.D{{Key: "$and", Value: conditions}}
+
+	p, err := newPagination(i.PerPage, nil, "", i.PageToken, reservationSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservationsResult = &v1alpha1.ListReservationsResponse{}
+	reservations, err := e.reservations.Find(ctx, p.filter(filter), p.options())
+	if err != nil {
+		return nil, err
+	}
+	reservationsResult.Reservations, reservationsResult.NextPageToken, err = paginate(p, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := e.reservations.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	reservationsResult.TotalCount = uint32(count)
+
+	return reservationsResult, nil
+}
+
+// activeReservation returns the reservation of service in environment at the
+// moment, nil when there is none
+func (e *Lock) activeReservation(ctx context.Context, environment string, service string) (*v1alpha1.Reservation, error) {
+	now := time.Now().Unix()
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "environment", Value: environment}},
+		bson.D{{Key: "service", Value: bson.D{{Key: "$in", Value: bson.A{"", service}}}}},
+		bson.D{{Key: "start.seconds", Value: bson.D{{Key: "$lte", Value: now}}}},
+		bson.D{{Key: "end.seconds", Value: bson.D{{Key: "$gt", Value: now}}}},
+	}}}
+	reservations, err := e.reservations.Find(ctx, filter, store.FindOptions{Limit: 1})
+	if err != nil || len(reservations) == 0 {
+		return nil, err
+	}
+	return reservations[0], nil
+}
+
+// reservationLock returns the lock still held for reservation, if any
+func (e *Lock) reservationLock(ctx context.Context, reservation *v1alpha1.Reservation) *v1alpha1.Lock {
+	if reservation.LockId == "" {
+		return nil
+	}
+	lock, err := e.store.Get(ctx, map[string]interface{}{"id": reservation.LockId})
+	if err != nil || lock.Id == "" {
+		return nil
+	}
+	return lock
+}
+
+// reservedStatus is the error refusing an event during the reservation of another team
+func reservedStatus(reservation *v1alpha1.Reservation, service string) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("cannot create event: service %s in %s is reserved by %s until %s (reservation_id: %s)",
+		service, reservation.Environment, reservation.Owner, reservation.End.AsTime().Format(time.RFC3339), reservation.Id))
+	if detailed, err := st.WithDetails(reservation); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// startReservations locks the reservations whose start has passed
+func (e *Lock) startReservations(ctx context.Context) {
+	now := time.Now().Unix()
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "start.seconds", Value: bson.D{{Key: "$lte", Value: now}}}},
+		bson.D{{Key: "end.seconds", Value: bson.D{{Key: "$gt", Value: now}}}},
+		bson.D{{Key: "lockid", Value: ""}},
+	}}}
+	reservations, err := e.reservations.Find(ctx, filter, store.FindOptions{})
+	if err != nil {
+		e.logger.Error("failed to find the reservations to start", "error", err)
+		return
+	}
+	for _, reservation := range reservations {
+		e.startReservation(ctx, reservation)
+	}
+}
+
+// startReservation locks the service of reservation, or its whole environment,
+// for every resource until its end. A lock held at that time delays it to the
+// next tick.
+func (e *Lock) startReservation(ctx context.Context, reservation *v1alpha1.Reservation) {
+	service := reservation.Service
+	if service == "" {
+		service = "*"
+	}
+	lock, err := e.acquire(ctx, &v1alpha1.Lock{
+		Service:       service,
+		Who:           reservation.Owner,
+		Environment:   reservation.Environment,
+		Resource:      "*",
+		Mode:          v1alpha1.LockMode_exclusive,
+		Ttl:           durationpb.New(time.Until(reservation.End.AsTime())),
+		ReservationId: reservation.Id,
+	})
+	var locked *store.LockedError
+	if errors.As(err, &locked) {
+		e.logger.Warn("reservation waits for a lock",
+			"id", reservation.Id,
+			"environment", reservation.Environment,
+			"service", service,
+			"lock_id", locked.Holder.Id,
+			"who", locked.Holder.Who,
+		)
+		return
+	}
+	if err != nil {
+		e.logger.Error("failed to lock reservation", "id", reservation.Id, "error", err)
+		return
+	}
+
+	reservation.LockId = lock.Id
+	if _, err := e.reservations.Update(ctx, map[string]interface{}{"id": reservation.Id}, reservation); err != nil {
+		e.logger.Error("failed to record the lock of reservation", "id", reservation.Id, "lock_id", lock.Id, "error", err)
+	}
+	e.locked(ctx, lock)
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+)
+
+func reservationRequest(owner string, service string, from time.Duration, to time.Duration) *v1alpha1.CreateReservationRequest {
+	now := time.Now()
+	return &v1alpha1.CreateReservationRequest{
+		Environment: "UAT",
+		Service:     service,
+		Owner:       owner,
+		Purpose:     "release testing",
+		Start:       timestamppb.New(now.Add(from)),
+		End:         timestamppb.New(now.Add(to)),
+	}
+}
+
+func TestCreateReservation(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+
+	tomorrow, err := l.CreateReservation(ctx, reservationRequest("team-a", "", 24*time.Hour, 26*time.Hour))
+	assert.NoError(t, err)
+	assert.Zero(t, tomorrow.Reservation.Start.Nanos, "periods are kept to the second")
+
+	_, err = l.CreateReservation(ctx, reservationRequest("team-b", "payments", 25*time.Hour, 27*time.Hour))
+	st := status.Convert(err)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		assert.Equal(t, tomorrow.Reservation.Id, st.Details()[0].(*v1alpha1.Reservation).Id)
+	}
+
+	later, err := l.CreateReservation(ctx, reservationRequest("team-b", "payments", 48*time.Hour, 50*time.Hour))
+	assert.NoError(t, err)
+
+	for _, invalid := range []*v1alpha1.CreateReservationRequest{
+		reservationRequest("team-a", "", 2*time.Hour, time.Hour),
+		reservationRequest("team-a", "", -2*time.Hour, -time.Hour),
+		reservationRequest("", "", time.Hour, 2*time.Hour),
+		reservationRequest("team-a", "pay*", time.Hour, 2*time.Hour),
+		{Environment: "moon", Owner: "team-a", Start: timestamppb.Now(), End: timestamppb.New(time.Now().Add(time.Hour))},
+		{Environment: "UAT", Owner: "team-a"},
+	} {
+		_, err = l.CreateReservation(ctx, invalid)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", invalid)
+	}
+
+	upcoming, err := l.ListReservations(ctx, &v1alpha1.ListReservationsRequest{Environment: "UAT", PerPage: wrapperspb.UInt32(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), upcoming.TotalCount)
+	if assert.Len(t, upcoming.Reservations, 1) {
+		assert.Equal(t, tomorrow.Reservation.Id, upcoming.Reservations[0].Id, "soonest first")
+	}
+	upcoming, err = l.ListReservations(ctx, &v1alpha1.ListReservationsRequest{Environment: "UAT", PerPage: wrapperspb.UInt32(1), PageToken: upcoming.NextPageToken})
+	assert.NoError(t, err)
+	if assert.Len(t, upcoming.Reservations, 1) {
+		assert.Equal(t, later.Reservation.Id, upcoming.Reservations[0].Id)
+	}
+	byOwner, err := l.ListReservations(ctx, &v1alpha1.ListReservationsRequest{Owner: "team-b"})
+	assert.NoError(t, err)
+	assert.Len(t, byOwner.Reservations, 1)
+
+	_, err = l.CancelReservation(ctx, &v1alpha1.CancelReservationRequest{Id: later.Reservation.Id, Owner: "team-a"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = l.CancelReservation(ctx, &v1alpha1.CancelReservationRequest{Id: later.Reservation.Id, Owner: "team-b"})
+	assert.NoError(t, err)
+	_, err = l.GetReservation(ctx, &v1alpha1.GetReservationRequest{Id: later.Reservation.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestStartReservations(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	l := e.lockService
+
+	// a reservation starting now is locked at once
+	now, err := l.CreateReservation(ctx, reservationRequest("team-a", "", -time.Minute, time.Hour))
+	assert.NoError(t, err)
+	got, err := l.GetReservation(ctx, &v1alpha1.GetReservationRequest{Id: now.Reservation.Id})
+	assert.NoError(t, err)
+	held, err := l.GetLock(ctx, &v1alpha1.GetLockRequest{Id: got.Reservation.LockId})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "*", held.Lock.Service)
+		assert.Equal(t, "team-a", held.Lock.Who)
+		assert.Equal(t, now.Reservation.Id, held.Lock.ReservationId)
+		assert.WithinDuration(t, now.Reservation.End.AsTime(), held.Lock.ExpiresAt.AsTime(), time.Second, "the lock ends with the reservation")
+	}
+
+	request := deploymentRequest("payments", eventv1alpha1.Status_start)
+	request.Attributes.Environment = eventv1alpha1.Environment_UAT
+	request.Attributes.Owner = "team-b"
+	_, err = e.CreateEvent(ctx, request)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "another team cannot deploy during the reservation")
+	assert.ErrorContains(t, err, "reserved by team-a")
+
+	request.Attributes.Owner = "team-a"
+	created, err := e.CreateEvent(ctx, request)
+	assert.NoError(t, err, "the team of the reservation shares its lock")
+	assert.Contains(t, created.Event.Changelog[len(created.Event.Changelog)-1].Comment, "by reservation "+now.Reservation.Id)
+
+	// a reservation whose start came is locked by the janitor, unless a lock is held
+	_, err = l.CreateLock(ctx, &v1alpha1.CreateLockRequest{Service: "billing", Who: "bob", Environment: "recette", Resource: "deployment"})
+	assert.NoError(t, err)
+	_, err = l.reservations.Create(ctx, &v1alpha1.Reservation{Environment: "recette", Service: "billing", Owner: "team-b",
+		Start: timestamppb.New(time.Now().Add(-time.Second)), End: timestamppb.New(time.Now().Add(time.Hour))})
+	assert.NoError(t, err)
+	l.startReservations(ctx)
+	started, err := l.ListReservations(ctx, &v1alpha1.ListReservationsRequest{Environment: "recette"})
+	assert.NoError(t, err)
+	assert.Empty(t, started.Reservations[0].LockId, "the reservation waits for the lock of bob")
+
+	_, err = l.CancelReservation(ctx, &v1alpha1.CancelReservationRequest{Id: now.Reservation.Id, Owner: "team-a"})
+	assert.NoError(t, err)
+	_, err = l.GetLock(ctx, &v1alpha1.GetLockRequest{Id: got.Reservation.LockId})
+	assert.Error(t, err, "cancelling a started reservation releases its lock")
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	catalogv1alpha1 "github.com/bananaops/tracker/generated/proto/catalog/v1alpha1"
+	eventv1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// revisionAttempts bounds the retries of an update that does not expect a
+// revision, when other writers keep changing the document under it
+const revisionAttempts = 5
+
+// expectedRevision returns the revision an update expects: the revision of
+// the request, else the If-Match header, 0 when the update is unconditional
+func expectedRevision(ctx context.Context, revision int64) (int64, error) {
+	if revision != 0 {
+		return revision, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("if-match")
+	if len(values) == 0 {
+		// the gateway forwards If-Match with its prefix
+		values = md.Get(runtime.MetadataPrefix + "if-match")
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(strings.TrimSpace(values[0]), "W/"), `"`)
+	expected, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || expected <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match %q, expected the ETag of a previous response", values[0])
+	}
+	return expected, nil
+}
+
+// checkRevision fails with Aborted when an update expects another revision
+// than the current one of the document
+func checkRevision(kind string, key string, current int64, expected int64) error {
+	if expected != 0 && current != expected {
+		return status.Errorf(codes.Aborted, "%s %s is at revision %d, not %d", kind, key, current, expected)
+	}
+	return nil
+}
+
+// revisionError converts the revision conflict of a store into Aborted
+func revisionError(err error, kind string, key string) error {
+	if errors.Is(err, store.ErrRevisionConflict) {
+		return status.Errorf(codes.Aborted, "%s %s was modified concurrently, retry with its new revision", kind, key)
+	}
+	return err
+}
+
+// retryOnConflict runs update again while it is aborted by a concurrent
+// write. An update expecting a revision is not retried: the conflict is its
+// answer.
+func retryOnConflict(expected int64, update func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := update()
+		if status.Code(err) != codes.Aborted || expected != 0 || attempt == revisionAttempts {
+			return err
+		}
+	}
+}
+
+// ServeMuxOptions are the options of the HTTP gateway: the responses holding
+// an event or a catalog entry carry its revision as ETag, the updates refused
+// for their revision answer 412 Precondition Failed, and the Idempotency-Key
+// header reaches CreateEvent
+func ServeMuxOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithErrorHandler(revisionErrorHandler),
+		runtime.WithIncomingHeaderMatcher(idempotencyHeaderMatcher),
+	}
+}
+
+// eventResponse is a response holding an event
+type eventResponse interface {
+	GetEvent() *eventv1alpha1.Event
+}
+
+// catalogResponse is a response holding a catalog entry
+type catalogResponse interface {
+	GetCatalog() *catalogv1alpha1.Catalog
+}
+
+func setETag(ctx context.Context, w http.ResponseWriter, response proto.Message) error {
+	var revision int64
+	switch r := response.(type) {
+	case eventResponse:
+		revision = r.GetEvent().GetMetadata().GetRevision()
+	case catalogResponse:
+		revision = r.GetCatalog().GetRevision()
+	}
+	if revision > 0 {
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, revision))
+	}
+	return nil
+}
+
+func revisionErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.Aborted {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
@@ -0,0 +1,548 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bananaops/tracker/internal/config"
+	store "github.com/bananaops/tracker/internal/stores"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// watchBacklog is how many changes a feed keeps for the watchers resuming after a disconnect
+	watchBacklog = 1024
+	// watchBuffer is how many changes a watcher may lag behind before its watch is ended
+	watchBuffer = 256
+)
+
+// watchHeartbeat is how often an idle Server-Sent Events stream sends a comment,
+// to keep proxies from closing it
+var watchHeartbeat = 15 * time.Second
+
+// watchPoll is how often a persisted feed reads the changes published by the
+// other instances of the tracker
+var watchPoll = time.Second
+
+// watchGap is how long a persisted feed waits for a missing change, before
+// skipping it and ending the watches
+var watchGap = 5 * time.Second
+
+// watchAppendAttempts bounds the attempts to store a change of a persisted feed
+const watchAppendAttempts = 3
+
+// feedChange is a change published on a feed, numbered in publication order
+type feedChange[T proto.Message] struct {
+	seq    uint64
+	change T
+}
+
+// feedWatcher receives the changes published after it subscribed, its
+// channel is closed when it is dropped for not keeping up, or with err when
+// changes were lost
+type feedWatcher[T proto.Message] struct {
+	changes chan feedChange[T]
+	err     error
+}
+
+// changeFeed broadcasts the changes to the watchers, keeping the last ones
+// so that a watcher can resume where it left off. The resume token of a
+// change is the epoch of the feed and the number of the change: a token of
+// another epoch, or of a change out of the backlog, cannot be resumed.
+//
+// An in-memory feed has an epoch of its own, its tokens are only valid in its
+// process. A persisted feed stores its changes instead, its epoch is its name:
+// every instance of the tracker numbers and sends the same changes, and the
+// tokens outlive a restart.
+type changeFeed[T proto.Message] struct {
+	mu       sync.Mutex
+	epoch    string
+	seq      uint64
+	capacity int
+	backlog  []feedChange[T]
+	watchers map[*feedWatcher[T]]struct{}
+
+	// changes stores the changes of a persisted feed, nil for an in-memory one
+	changes store.ChangeStore
+	logger  *slog.Logger
+	// published wakes follow up when this instance publishes a change
+	published chan struct{}
+	// gap is when follow found a change missing, zero when none is
+	gap time.Time
+}
+
+func newChangeFeed[T proto.Message](capacity int) *changeFeed[T] {
+	return &changeFeed[T]{
+		epoch:    uuid.New().String(),
+		capacity: capacity,
+		watchers: map[*feedWatcher[T]]struct{}{},
+	}
+}
+
+// persist stores the changes of the feed in changes under name from now on,
+// and sends the watchers the changes of every instance until ctx is done
+func (f *changeFeed[T]) persist(ctx context.Context, changes store.ChangeStore, name string, logger *slog.Logger) error {
+	last, err := changes.Last(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.epoch = name
+	f.seq = uint64(last) // #nosec G115 -- sequences start at 1
+	f.backlog = nil
+	f.changes = changes
+	f.logger = logger
+	f.published = make(chan struct{}, 1)
+	f.mu.Unlock()
+
+	go f.follow(ctx, watchPoll, watchGap)
+	return nil
+}
+
+// token returns the resume token of the change seq
+func (f *changeFeed[T]) token(seq uint64) string {
+	return f.epoch + "." + strconv.FormatUint(seq, 10)
+}
+
+// publish numbers change and sends it to the watchers. A persisted feed
+// stores it, follow then sends it.
+func (f *changeFeed[T]) publish(change T) {
+	f.mu.Lock()
+	if f.changes == nil {
+		defer f.mu.Unlock()
+		f.seq++
+		f.broadcast(feedChange[T]{seq: f.seq, change: change})
+		return
+	}
+	changes, name := f.changes, f.epoch
+	f.mu.Unlock()
+
+	data, err := proto.Marshal(change)
+	for attempt := 1; err == nil; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err = changes.Append(ctx, name, data, int64(f.capacity))
+		cancel()
+		if err == nil || attempt == watchAppendAttempts {
+			break
+		}
+		err = nil
+		time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+	}
+	if err != nil {
+		// les watchers de cette instance doivent resynchroniser plutôt que
+		// manquer le changement sans le savoir
+		f.logger.Error("change not published to the watchers", "feed", name, "error", err)
+		f.mu.Lock()
+		f.lose(f.seq)
+		f.mu.Unlock()
+		return
+	}
+	select {
+	case f.published <- struct{}{}:
+	default:
+	}
+}
+
+// broadcast sends a change to the watchers, and keeps it in the backlog of
+// an in-memory feed. A watcher too late to take it is dropped, it resumes
+// from its last token. f.mu must be held.
+func (f *changeFeed[T]) broadcast(published feedChange[T]) {
+	if f.changes == nil {
+		f.backlog = append(f.backlog, published)
+		if len(f.backlog) > f.capacity {
+			f.backlog = f.backlog[len(f.backlog)-f.capacity:]
+		}
+	}
+	for w := range f.watchers {
+		select {
+		case w.changes <- published:
+		default:
+			delete(f.watchers, w)
+			close(w.changes)
+		}
+	}
+}
+
+// lose ends every watch with OutOfRange, the changes after seq being lost
+// for them. f.mu must be held.
+func (f *changeFeed[T]) lose(seq uint64) {
+	err := status.Errorf(codes.OutOfRange, "changes after resume_token %q were lost, list the current state and watch again", f.token(seq))
+	for w := range f.watchers {
+		w.err = err
+		delete(f.watchers, w)
+		close(w.changes)
+	}
+}
+
+// follow sends the watchers the changes stored by every instance, in
+// sequence order, reading them every poll until ctx is done. A missing change
+// is waited for up to gap.
+func (f *changeFeed[T]) follow(ctx context.Context, poll time.Duration, gap time.Duration) {
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-f.published:
+		}
+		if err := f.catchUp(ctx, gap); err != nil && ctx.Err() == nil {
+			f.logger.Error("changes not read for the watchers", "feed", f.epoch, "error", err)
+		}
+	}
+}
+
+// catchUp sends the watchers the changes stored after the current position.
+// The changes are stored with their sequence, a missing one is not expected:
+// catchUp still waits for it up to gap, then skips it and ends the watches,
+// which would miss it.
+func (f *changeFeed[T]) catchUp(ctx context.Context, gap time.Duration) error {
+	for {
+		f.mu.Lock()
+		seq := f.seq
+		f.mu.Unlock()
+
+		stored, err := f.changes.Since(ctx, f.epoch, int64(seq), watchBuffer) // #nosec G115
+		if err != nil {
+			return err
+		}
+		for _, change := range stored {
+			next := uint64(change.Sequence) // #nosec G115 -- sequences start at 1
+			if next != seq+1 {
+				if f.gap.IsZero() {
+					f.gap = time.Now()
+				}
+				if time.Since(f.gap) < gap {
+					return nil
+				}
+				f.logger.Error("changes missing, watches ended", "feed", f.epoch, "after", seq, "next", next)
+			}
+			f.gap = time.Time{}
+
+			published, err := f.decode(change)
+			f.mu.Lock()
+			if next != seq+1 {
+				f.lose(seq)
+			}
+			f.seq = next
+			if err == nil {
+				f.broadcast(published)
+			}
+			f.mu.Unlock()
+			if err != nil {
+				f.logger.Error("change not sent to the watchers", "feed", f.epoch, "sequence", next, "error", err)
+			}
+			seq = next
+		}
+		if len(stored) < watchBuffer {
+			return nil
+		}
+	}
+}
+
+// decode returns the change stored by a persisted feed
+func (f *changeFeed[T]) decode(change *store.Change) (feedChange[T], error) {
+	var message T
+	message = message.ProtoReflect().Type().New().Interface().(T)
+	err := proto.Unmarshal(change.Data, message)
+	return feedChange[T]{seq: uint64(change.Sequence), change: message}, err // #nosec G115
+}
+
+// subscribe registers a watcher, with the changes made after resumeToken
+// when given. It also returns the sequence of the current position, the
+// watcher has no change up to it.
+func (f *changeFeed[T]) subscribe(ctx context.Context, resumeToken string) (*feedWatcher[T], []feedChange[T], uint64, error) {
+	var after uint64
+	if resumeToken != "" {
+		epoch, number, found := strings.Cut(resumeToken, ".")
+		seq, err := strconv.ParseUint(number, 10, 64)
+		if !found || err != nil {
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "invalid resume_token %q", resumeToken)
+		}
+		f.mu.Lock()
+		current := f.epoch
+		f.mu.Unlock()
+		if epoch != current {
+			return nil, nil, 0, outOfRange(resumeToken)
+		}
+		after = seq
+	}
+
+	f.mu.Lock()
+	w := &feedWatcher[T]{changes: make(chan feedChange[T], watchBuffer)}
+	if f.changes == nil {
+		defer f.mu.Unlock()
+		var missed []feedChange[T]
+		if resumeToken != "" {
+			oldest := f.seq + 1
+			if len(f.backlog) > 0 {
+				oldest = f.backlog[0].seq
+			}
+			if after > f.seq || after+1 < oldest {
+				return nil, nil, 0, outOfRange(resumeToken)
+			}
+			for _, change := range f.backlog {
+				if change.seq > after {
+					missed = append(missed, change)
+				}
+			}
+		}
+		f.watchers[w] = struct{}{}
+		return w, missed, f.seq, nil
+	}
+	f.watchers[w] = struct{}{}
+	current := f.seq
+	f.mu.Unlock()
+	if resumeToken == "" {
+		return w, nil, current, nil
+	}
+
+	// Les changements manqués sont relus dans le store, hors du mutex
+	missed, position, err := f.missed(ctx, after, current)
+	if err != nil {
+		f.unsubscribe(w)
+		if err == errOutOfRange {
+			err = outOfRange(resumeToken)
+		}
+		return nil, nil, 0, err
+	}
+	return w, missed, position, nil
+}
+
+// errOutOfRange tells that a resume token is out of the changes kept
+var errOutOfRange = errors.New("out of the changes kept")
+
+// missed returns the changes stored after the sequence after up to current,
+// the position of a persisted feed. A token of a change not followed yet, but
+// stored by another instance, is ahead of current: the position is then the
+// token.
+func (f *changeFeed[T]) missed(ctx context.Context, after uint64, current uint64) ([]feedChange[T], uint64, error) {
+	if after > current {
+		last, err := f.changes.Last(ctx, f.epoch)
+		if err != nil {
+			return nil, 0, err
+		}
+		if after > uint64(last) { // #nosec G115
+			return nil, 0, errOutOfRange
+		}
+		return nil, after, nil
+	}
+	if after+uint64(f.capacity) < current {
+		return nil, 0, errOutOfRange
+	}
+	if after == current {
+		return nil, current, nil
+	}
+
+	var missed []feedChange[T]
+	stored, err := f.changes.Since(ctx, f.epoch, int64(after), int64(current-after)) // #nosec G115
+	if err != nil {
+		return nil, 0, err
+	}
+	for n, change := range stored {
+		// un trou dans les changements manqués ne peut pas être repris
+		if change.Sequence != int64(after)+int64(n)+1 { // #nosec G115
+			return nil, 0, errOutOfRange
+		}
+		published, err := f.decode(change)
+		if err != nil {
+			return nil, 0, err
+		}
+		if published.seq <= current {
+			missed = append(missed, published)
+		}
+	}
+	if uint64(len(missed)) < current-after {
+		return nil, 0, errOutOfRange
+	}
+	return missed, current, nil
+}
+
+func outOfRange(resumeToken string) error {
+	return status.Errorf(codes.OutOfRange, "resume_token %q can no longer be resumed, list the current state and watch again", resumeToken)
+}
+
+// unsubscribe drops w, if the feed did not already
+func (f *changeFeed[T]) unsubscribe(w *feedWatcher[T]) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.watchers[w]; ok {
+		delete(f.watchers, w)
+		close(w.changes)
+	}
+}
+
+// watch sends the changes of f as view shows them to the watcher until ctx
+// is done: first the current position, then the changes missed since
+// resumeToken, then the new ones. view returns false for the changes the
+// watcher does not see. withToken returns a copy of a change carrying its
+// resume token.
+func watch[T proto.Message](
+	ctx context.Context,
+	f *changeFeed[T],
+	resumeToken string,
+	view func(T) (T, bool),
+	withToken func(change T, token string) T,
+	send func(T) error,
+) error {
+	w, missed, current, err := f.subscribe(ctx, resumeToken)
+	if err != nil {
+		return err
+	}
+	defer f.unsubscribe(w)
+
+	var start T
+	if err := send(withToken(start, f.token(current))); err != nil {
+		return err
+	}
+
+	deliver := func(change feedChange[T]) error {
+		seen, ok := view(change.change)
+		if !ok {
+			return nil
+		}
+		return send(withToken(seen, f.token(change.seq)))
+	}
+	for _, change := range missed {
+		if err := deliver(change); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-w.changes:
+			if !ok {
+				if w.err != nil {
+					return w.err
+				}
+				return status.Errorf(codes.ResourceExhausted, "the watch fell behind the changes, resume it with the last resume_token")
+			}
+			if change.seq <= current {
+				continue
+			}
+			if err := deliver(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PersistWatchFeeds stores the changes of the event and lock watches in the
+// change store until ctx is done, so that every instance of the tracker
+// serves the same changes and resume tokens outlive a restart
+func PersistWatchFeeds(ctx context.Context) error {
+	changes := store.NewChangeStore(config.ConfigDatabase.ChangeCollection)
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	if err := eventChanges.persist(ctx, changes, "events", logger); err != nil {
+		return err
+	}
+	return lockChanges.persist(ctx, changes, "locks", logger)
+}
+
+// serveWatch serves a watch as Server-Sent Events: the query parameters fill
+// request, the Last-Event-ID header sent by browsers when reconnecting
+// overrides its resume token, and each change is sent with its resume token as id
+func serveWatch[Req proto.Message](
+	mux *runtime.ServeMux,
+	w http.ResponseWriter,
+	r *http.Request,
+	request Req,
+	resume func(Req, string),
+	run func(ctx context.Context, request Req, send func(id string, kind string, message proto.Message) error) error,
+) {
+	ctx := r.Context()
+	_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+	if err := runtime.PopulateQueryParameters(request, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+		runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "invalid query: %v", err))
+		return
+	}
+	if lastEventId := r.Header.Get("Last-Event-ID"); lastEventId != "" {
+		resume(request, lastEventId)
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.Unimplemented, "streaming is not supported"))
+		return
+	}
+
+	var mu sync.Mutex
+	started := false
+	write := func(format string, args ...any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+		}
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var heartbeats sync.WaitGroup
+	defer func() {
+		cancel()
+		heartbeats.Wait()
+	}()
+	heartbeats.Add(1)
+	go func() {
+		defer heartbeats.Done()
+		ticker := time.NewTicker(watchHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if write(": heartbeat\n\n") != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err := run(ctx, request, func(id string, kind string, message proto.Message) error {
+		data, err := marshaler.Marshal(message)
+		if err != nil {
+			return err
+		}
+		return write("id: %s\nevent: %s\ndata: %s\n\n", id, kind, data)
+	})
+	if err == nil {
+		return
+	}
+	mu.Lock()
+	wasStarted := started
+	mu.Unlock()
+	if !wasStarted {
+		runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	// the stream is open, the error is the last event
+	_ = write("event: error\ndata: %s\n\n", strconv.Quote(status.Convert(err).Message()))
+}
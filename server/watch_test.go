@@ -0,0 +1,284 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1alpha1 "github.com/bananaops/tracker/generated/proto/event/v1alpha1"
+	lock "github.com/bananaops/tracker/generated/proto/lock/v1alpha1"
+	store "github.com/bananaops/tracker/internal/stores"
+)
+
+func TestChangeFeedResume(t *testing.T) {
+	feed := newChangeFeed[*lock.WatchLocksResponse](2)
+	for range 3 {
+		feed.publish(&lock.WatchLocksResponse{})
+	}
+
+	ctx := context.Background()
+	_, missed, current, err := feed.subscribe(ctx, feed.token(1))
+	assert.NoError(t, err)
+	assert.Len(t, missed, 2, "the changes after the token are still kept")
+	assert.Equal(t, uint64(3), current)
+
+	_, _, _, err = feed.subscribe(ctx, feed.token(0))
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "the change 1 left the backlog")
+	_, _, _, err = feed.subscribe(ctx, newChangeFeed[*lock.WatchLocksResponse](2).token(3))
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "token of another process")
+	_, _, _, err = feed.subscribe(ctx, "3")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPersistedChangeFeed(t *testing.T) {
+	ctx := t.Context()
+	poll := watchPoll
+	watchPoll = 10 * time.Millisecond
+	t.Cleanup(func() { watchPoll = poll })
+	changes := store.NewMemoryStoreChange(t.Name())
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	// deux instances du tracker partagent le store
+	first := newChangeFeed[*lock.WatchLocksResponse](2)
+	assert.NoError(t, first.persist(ctx, changes, "locks", logger))
+	second := newChangeFeed[*lock.WatchLocksResponse](2)
+	assert.NoError(t, second.persist(ctx, changes, "locks", logger))
+
+	w, _, current, err := second.subscribe(ctx, "")
+	assert.NoError(t, err)
+	defer second.unsubscribe(w)
+	first.publish(&lock.WatchLocksResponse{Lock: &lock.Lock{Service: "payments"}})
+	change := nextChange(t, w.changes)
+	assert.Equal(t, current+1, change.seq)
+	assert.Equal(t, "payments", change.change.Lock.Service, "a change of another instance is sent")
+
+	for _, service := range []string{"billing", "search"} {
+		second.publish(&lock.WatchLocksResponse{Lock: &lock.Lock{Service: service}})
+		nextChange(t, w.changes)
+	}
+
+	// un redémarrage reprend les jetons des autres instances
+	restarted := newChangeFeed[*lock.WatchLocksResponse](2)
+	assert.NoError(t, restarted.persist(ctx, changes, "locks", logger))
+	_, missed, position, err := restarted.subscribe(ctx, first.token(change.seq))
+	assert.NoError(t, err)
+	assert.Equal(t, change.seq+2, position)
+	if assert.Len(t, missed, 2) {
+		assert.Equal(t, "billing", missed[0].change.Lock.Service)
+	}
+	_, _, _, err = restarted.subscribe(ctx, first.token(change.seq-1))
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "the change left the store")
+	_, _, _, err = restarted.subscribe(ctx, first.token(position+1))
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "the change was never published")
+	_, _, _, err = restarted.subscribe(ctx, newChangeFeed[*lock.WatchLocksResponse](2).token(position))
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "token of an in-memory feed")
+}
+
+// lossyChangeStore fails the appends while failing is set, and hides the
+// change hidden from the reads
+type lossyChangeStore struct {
+	store.ChangeStore
+	failing atomic.Bool
+	hidden  atomic.Int64
+}
+
+func (s *lossyChangeStore) Append(ctx context.Context, feed string, data []byte, capacity int64) (int64, error) {
+	if s.failing.Load() {
+		return 0, errors.New("database unavailable")
+	}
+	return s.ChangeStore.Append(ctx, feed, data, capacity)
+}
+
+func (s *lossyChangeStore) Since(ctx context.Context, feed string, after int64, limit int64) ([]*store.Change, error) {
+	changes, err := s.ChangeStore.Since(ctx, feed, after, limit)
+	return slices.DeleteFunc(changes, func(change *store.Change) bool { return change.Sequence == s.hidden.Load() }), err
+}
+
+func TestPersistedChangeFeedLost(t *testing.T) {
+	ctx := t.Context()
+	poll, gap := watchPoll, watchGap
+	watchPoll, watchGap = 10*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() { watchPoll, watchGap = poll, gap })
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	// un changement qui n'a pas pu être stocké termine les watches
+	changes := &lossyChangeStore{ChangeStore: store.NewMemoryStoreChange(t.Name())}
+	feed := newChangeFeed[*lock.WatchLocksResponse](8)
+	assert.NoError(t, feed.persist(ctx, changes, "locks", logger))
+	w, _, _, err := feed.subscribe(ctx, "")
+	assert.NoError(t, err)
+	changes.failing.Store(true)
+	feed.publish(&lock.WatchLocksResponse{Lock: &lock.Lock{Service: "payments"}})
+	_, open := <-w.changes
+	assert.False(t, open)
+	assert.Equal(t, codes.OutOfRange, status.Code(w.err))
+	changes.failing.Store(false)
+
+	// un trou dans les changements stockés aussi, et ne peut pas être repris
+	w, _, current, err := feed.subscribe(ctx, "")
+	assert.NoError(t, err)
+	changes.hidden.Store(int64(current) + 2) // #nosec G115
+	for _, service := range []string{"payments", "billing", "search"} {
+		feed.publish(&lock.WatchLocksResponse{Lock: &lock.Lock{Service: service}})
+	}
+	assert.Equal(t, current+1, nextChange(t, w.changes).seq)
+	_, open = <-w.changes
+	assert.False(t, open, "the watch ends instead of skipping the change")
+	assert.Equal(t, codes.OutOfRange, status.Code(w.err))
+	_, _, _, err = feed.subscribe(ctx, feed.token(current+1))
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+// startWatchEvents calls watchEvents in the background until the returned cancel is called
+func startWatchEvents(e *Event, request *v1alpha1.WatchEventsRequest) (chan *v1alpha1.WatchEventsResponse, chan error, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan *v1alpha1.WatchEventsResponse, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- e.watchEvents(ctx, request, func(change *v1alpha1.WatchEventsResponse) error {
+			changes <- change
+			return nil
+		})
+	}()
+	return changes, done, cancel
+}
+
+func nextChange[T any](t *testing.T, changes chan T) T {
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(time.Second):
+		t.Fatal("no change received")
+	}
+	var none T
+	return none
+}
+
+func TestWatchEvents(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+
+	changes, done, cancel := startWatchEvents(e, &v1alpha1.WatchEventsRequest{Service: "payments"})
+	start := nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_EVENT_CHANGE_KIND_UNSPECIFIED, start.Kind)
+	assert.NotEmpty(t, start.ResumeToken)
+
+	_, err := e.CreateEvent(ctx, deploymentRequest("billing", v1alpha1.Status_success))
+	assert.NoError(t, err)
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_success))
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+
+	change := nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_event_created, change.Kind, "billing is filtered out")
+	assert.Equal(t, id, change.Event.Metadata.Id)
+	resumeFrom := change.ResumeToken
+
+	// the watcher disconnects, the event changes meanwhile
+	cancel()
+	assert.NoError(t, <-done)
+	_, err = e.AddChangelogEntry(ctx, &v1alpha1.AddChangelogEntryRequest{Id: id,
+		Entry: &v1alpha1.ChangelogEntry{User: "alice", ChangeType: v1alpha1.ChangeType_commented, Comment: "rolled out", Timestamp: timestamppb.Now()}})
+	assert.NoError(t, err)
+	_, err = e.DeleteEvent(ctx, &v1alpha1.DeleteEventRequest{Id: id})
+	assert.NoError(t, err)
+
+	changes, done, cancel = startWatchEvents(e, &v1alpha1.WatchEventsRequest{Service: "payments", ResumeToken: resumeFrom})
+	defer cancel()
+	nextChange(t, changes)
+	change = nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_changelog_added, change.Kind)
+	assert.Equal(t, "rolled out", change.Entry.Comment)
+	change = nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_event_deleted, change.Kind)
+	assert.Equal(t, "payments", change.Event.Attributes.Service)
+
+	_, done, _ = startWatchEvents(e, &v1alpha1.WatchEventsRequest{ResumeToken: "elsewhere.1"})
+	assert.Equal(t, codes.OutOfRange, status.Code(<-done))
+}
+
+func TestWatchEventsLeft(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEvent(t)
+	created, err := e.CreateEvent(ctx, deploymentRequest("payments", v1alpha1.Status_success))
+	assert.NoError(t, err)
+	id := created.Event.Metadata.Id
+
+	changes, done, cancel := startWatchEvents(e, &v1alpha1.WatchEventsRequest{Service: "payments"})
+	defer func() {
+		cancel()
+		assert.NoError(t, <-done)
+	}()
+	nextChange(t, changes)
+
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Attributes: &v1alpha1.EventAttributes{Service: "billing"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.service"}}})
+	assert.NoError(t, err)
+	change := nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_event_left, change.Kind)
+	assert.Equal(t, "billing", change.Event.Attributes.Service)
+	assert.Equal(t, "payments", change.PreviousAttributes.Service)
+
+	_, err = e.UpdateEvent(ctx, &v1alpha1.UpdateEventRequest{Id: id, Attributes: &v1alpha1.EventAttributes{Service: "payments"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.service"}}})
+	assert.NoError(t, err)
+	change = nextChange(t, changes)
+	assert.Equal(t, v1alpha1.EventChangeKind_event_updated, change.Kind, "the event is back in the filter")
+}
+
+func TestWatchLocksServerSentEvents(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLock(t)
+	mux := runtime.NewServeMux()
+	assert.NoError(t, RegisterLockWatchHandler(mux, l))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1alpha1/locks/watch?service=payments", nil)
+	assert.NoError(t, err)
+	response, err := http.DefaultClient.Do(request)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer response.Body.Close()
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	lines := make(chan string, 20)
+	go func() {
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	assert.True(t, strings.HasPrefix(nextChange(t, lines), "id: "))
+	assert.Equal(t, "event: watching", nextChange(t, lines))
+
+	_, err = l.CreateLock(ctx, &lock.CreateLockRequest{Service: "payments", Who: "alice", Environment: "production", Resource: "deployment"})
+	assert.NoError(t, err)
+	for line := nextChange(t, lines); line != "event: acquired"; line = nextChange(t, lines) {
+		assert.NotContains(t, line, "event: ", "only the lock of payments is sent")
+	}
+	assert.Contains(t, nextChange(t, lines), `"who":"alice"`)
+
+	response, err = http.Get(server.URL + "/api/v1alpha1/locks/watch?resume_token=elsewhere.1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		response.Body.Close()
+	}
+}
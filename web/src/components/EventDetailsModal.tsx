@@ -38,6 +38,10 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
   const [lockUserError, setLockUserError] = useState(false)
   const [existingLock, setExistingLock] = useState<any>(null)
   const [checkingLock, setCheckingLock] = useState(false)
+  const [blockingLock, setBlockingLock] = useState<any>(null)
+  const [pendingLock, setPendingLock] = useState<any>(null)
+  const [forceReason, setForceReason] = useState('')
+  const [forceReasonError, setForceReasonError] = useState(false)
   const [showApprovalPrompt, setShowApprovalPrompt] = useState(false)
   const [showStatusDropdown, setShowStatusDropdown] = useState(false)
   const [approvalUser, setApprovalUser] = useState('')
@@ -221,7 +225,7 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
       setLockingService(true)
       setShowLockPrompt(false)
       
-      await locksApi.unlock(existingLock.id)
+      await locksApi.unlock(existingLock.id, lockUser.trim())
       setExistingLock(null)
       setToastMessage(`${editedEvent.attributes.service} is unlocked`)
       setShowToast(true)
@@ -319,10 +323,12 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
         environment: editedEvent.attributes.environment,
       }
       
-      // Ajouter le type d'événement comme resource si c'est deployment ou operation
+      // Les déploiements prennent un lock exclusif sur la ressource deployment,
+      // les opérations un lock partagé sur la même ressource
       const eventType = String(editedEvent.attributes.type).toLowerCase()
       if (eventType === 'deployment' || eventType === 'operation') {
-        lockData.resource = eventType
+        lockData.resource = 'deployment'
+        lockData.mode = eventType === 'operation' ? 'shared' : 'exclusive'
       }
       
       // Ajouter l'event_id
@@ -345,12 +351,18 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
                    l.environment === editedEvent.attributes.environment
           )
           
-          if (foundLock) {
-            // Déverrouiller l'ancien lock
-            await locksApi.unlock(foundLock.id)
-            // Créer le nouveau lock
+          if (foundLock && foundLock.who === lockData.who) {
+            // Son propre lock : le libérer puis le reprendre pour cet événement
+            await locksApi.unlock(foundLock.id, lockData.who)
             const createdLock = await locksApi.create(lockData)
             setExistingLock(createdLock)
+          } else if (foundLock) {
+            // Le lock d'un autre : ne le forcer que sur demande explicite, avec une raison
+            setPendingLock(lockData)
+            setBlockingLock(foundLock)
+            setForceReason('')
+            setForceReasonError(false)
+            return
           } else {
             throw createErr
           }
@@ -380,6 +392,38 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
     }
   }
 
+  const handleForceConfirm = async () => {
+    if (!forceReason.trim()) {
+      setForceReasonError(true)
+      return
+    }
+    if (!blockingLock || !pendingLock) return
+
+    try {
+      setLockingService(true)
+      await locksApi.forceUnlock(blockingLock.id, pendingLock.who, forceReason.trim())
+      const createdLock = await locksApi.create(pendingLock)
+      setExistingLock(createdLock)
+      setBlockingLock(null)
+      setPendingLock(null)
+      setToastMessage(`${editedEvent.attributes.service} is locked`)
+      setShowToast(true)
+    } catch (err: any) {
+      const errorMessage = err.response?.data?.message || err.message || 'Error forcing unlock'
+      setToastMessage(errorMessage)
+      setShowToast(true)
+      console.error('Error forcing unlock:', err)
+    } finally {
+      setLockingService(false)
+    }
+  }
+
+  const handleForceCancel = () => {
+    setBlockingLock(null)
+    setPendingLock(null)
+    setForceReason('')
+    setForceReasonError(false)
+  }
 
   // HUD color helpers
   const hud = {
@@ -1049,6 +1093,85 @@ export default function EventDetailsModal({ event, onClose }: EventDetailsModalP
         </div>
       )}
 
+      {/* Force Unlock Prompt */}
+      {blockingLock && (
+        <div className="fixed inset-0 z-50 overflow-y-auto">
+          <div 
+            className="fixed inset-0 bg-black bg-opacity-50 transition-opacity"
+            onClick={handleForceCancel}
+          />
+          <div className="flex min-h-full items-center justify-center p-4">
+            <div className="relative bg-white dark:bg-gray-800 rounded-lg shadow-xl max-w-md w-full p-6">
+              <h3 className="text-lg font-semibold text-gray-900 dark:text-white mb-4">
+                Service Already Locked
+              </h3>
+              <p className="text-sm text-gray-600 dark:text-gray-400 mb-4">
+                <span className="font-semibold">{blockingLock.service}</span> is locked in{' '}
+                <span className="font-semibold">{blockingLock.environment}</span> by{' '}
+                <span className="font-semibold">{blockingLock.who}</span>
+                {(blockingLock.event_id || blockingLock.eventId) && (
+                  <> for event <span className="font-mono">{blockingLock.event_id || blockingLock.eventId}</span></>
+                )}
+                . Force unlocking releases their lock and records your reason in the lock history.
+              </p>
+              <div className="mb-4">
+                <label className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
+                  Reason <span className="text-red-500">*</span>
+                </label>
+                <input
+                  type="text"
+                  value={forceReason}
+                  onChange={(e) => {
+                    setForceReason(e.target.value)
+                    setForceReasonError(false)
+                  }}
+                  onKeyDown={(e) => {
+                    if (e.key === 'Enter') {
+                      handleForceConfirm()
+                    }
+                  }}
+                  placeholder="e.g., previous deployment abandoned"
+                  className={`w-full px-4 py-2 border rounded-lg focus:ring-2 focus:ring-blue-500 focus:border-transparent dark:bg-gray-700 dark:text-white ${
+                    forceReasonError ? 'border-red-500' : 'border-gray-300 dark:border-gray-600'
+                  }`}
+                  autoFocus
+                />
+                {forceReasonError && (
+                  <p className="mt-1 text-sm text-red-600 dark:text-red-400">
+                    Reason is required
+                  </p>
+                )}
+              </div>
+              <div className="flex gap-3">
+                <button
+                  onClick={handleForceCancel}
+                  className="flex-1 px-4 py-2 text-sm font-medium text-gray-700 bg-white border border-gray-300 rounded-lg hover:bg-gray-50 dark:bg-gray-700 dark:text-gray-300 dark:border-gray-600 dark:hover:bg-gray-600"
+                >
+                  Cancel
+                </button>
+                <button
+                  onClick={handleForceConfirm}
+                  disabled={lockingService}
+                  className="flex-1 px-4 py-2 text-sm font-medium text-white rounded-lg disabled:opacity-50 disabled:cursor-not-allowed flex items-center justify-center gap-2 bg-red-600 hover:bg-red-700"
+                >
+                  {lockingService ? (
+                    <>
+                      <div className="w-4 h-4 border-2 border-white border-t-transparent rounded-full animate-spin" />
+                      Forcing...
+                    </>
+                  ) : (
+                    <>
+                      <Unlock className="w-4 h-4" />
+                      Force unlock
+                    </>
+                  )}
+                </button>
+              </div>
+            </div>
+          </div>
+        </div>
+      )}
+
       {/* Approval User Prompt */}
       {showApprovalPrompt && (
         <div className="fixed inset-0 z-50 overflow-y-auto">
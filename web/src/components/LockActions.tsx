@@ -52,15 +52,14 @@ export default function LockActions({
   const handleUnlock = async () => {
     if (!lockId) return
     
-    if (!confirm('Êtes-vous sûr de vouloir déverrouiller ce service ?')) {
-      return
-    }
+    const who = prompt('Qui déverrouille ce service ?', 'user')
+    if (!who) return
 
     try {
       setLoading(true)
       setError(null)
       
-      await locksApi.unlock(lockId)
+      await locksApi.unlock(lockId, who)
       onLockChange?.()
     } catch (err: any) {
       setError(err.response?.data?.message || 'Erreur lors du déverrouillage')
@@ -434,6 +434,21 @@ export interface Lock {
   eventId?: string
   created_at?: string | { seconds: number; nanos?: number }
   createdAt?: string | { seconds: number; nanos?: number }
+  ttl?: string
+  expiresAt?: string
+  mode?: 'exclusive' | 'shared'
+  bundleId?: string
+  releaseId?: string
+  reservationId?: string
+  waiters?: LockWaiter[]
+}
+
+export interface LockWaiter {
+  waiterId: string
+  who: string
+  eventId?: string
+  position: number
+  since?: string
 }
 
 export interface ListLocksResponse {
@@ -447,13 +462,18 @@ const realLocksApi = {
     return data
   },
 
-  create: async (lock: { service: string; who: string; environment: string; resource: string; event_id?: string }) => {
+  create: async (lock: { service: string; who: string; environment: string; resource: string; event_id?: string; mode?: 'exclusive' | 'shared' }) => {
     const { data } = await axiosInstance.post<{ lock: Lock }>('/lock', lock)
     return data.lock
   },
 
-  unlock: async (id: string) => {
-    const { data } = await axiosInstance.get(`/unlock/${id}`)
+  unlock: async (id: string, who: string) => {
+    const { data } = await axiosInstance.delete(`/lock/${id}`, { params: { who } })
+    return data
+  },
+
+  forceUnlock: async (id: string, who: string, reason: string) => {
+    const { data } = await axiosInstance.post(`/lock/${id}/force-unlock`, { who, reason })
     return data
   },
 }
@@ -208,6 +208,10 @@ export const staticLocksApi = {
   unlock: async () => {
     throw new Error('Unlock operation not available in static demo mode')
   },
+
+  forceUnlock: async () => {
+    throw new Error('Unlock operation not available in static demo mode')
+  },
 }
 
 export const getMetadata = async () => {
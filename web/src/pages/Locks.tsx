@@ -70,7 +70,7 @@ export default function Locks() {
     try {
       setUnlocking(selectedLock.id)
       setShowUnlockPrompt(false)
-      await locksApi.unlock(selectedLock.id)
+      await locksApi.unlock(selectedLock.id, unlockUser.trim())
       await loadLocks()
     } catch (err) {
       alert('Error unlocking service')
@@ -162,6 +162,7 @@ export interface EventAttributes {
   stakeHolders?: string[]
   notification?: boolean
   notifications?: string[]
+  releaseId?: string
 }
 
 export interface EventLinks {